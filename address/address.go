@@ -44,12 +44,6 @@ var (
 		"address: collectible asset amount not one",
 	)
 
-	// ErrInvalidAmountNormal is an error returned when we attempt to
-	// create a Taro address for a Normal asset with an amount of zero.
-	ErrInvalidAmountNormal = errors.New(
-		"address: normal asset amount of zero",
-	)
-
 	// ErrUnsupportedAssetType is an error returned when we attempt to
 	// create a Taro address for a non-standard asset type.
 	ErrUnsupportedAssetType = errors.New(
@@ -94,17 +88,20 @@ type Taro struct {
 	InternalKey btcec.PublicKey
 
 	// Amount is the number of asset units being requested by the receiver.
+	// An amount of zero means the address accepts any amount of the asset,
+	// which then needs to be chosen by the sender.
 	Amount uint64
 }
 
-// New creates an address for receiving a Taro asset.
+// New creates an address for receiving a Taro asset. An amount of zero creates
+// an amount-less address for a Normal asset.
 func New(genesis asset.Genesis, familyKey *btcec.PublicKey,
 	scriptKey btcec.PublicKey, internalKey btcec.PublicKey, amt uint64,
 	net *ChainParams) (*Taro, error) {
 
 	// Check for invalid combinations of asset type and amount.
-	// Collectible assets must have an amount of 1, and Normal assets must
-	// have a non-zero amount. We also reject invalid asset types.
+	// Collectible assets must have an amount of 1, and Normal assets can
+	// have any amount. We also reject invalid asset types.
 	switch genesis.Type {
 	case asset.Collectible:
 		if amt != 1 {
@@ -112,9 +109,6 @@ func New(genesis asset.Genesis, familyKey *btcec.PublicKey,
 		}
 
 	case asset.Normal:
-		if amt == 0 {
-			return nil, ErrInvalidAmountNormal
-		}
 
 	default:
 		return nil, ErrUnsupportedAssetType
@@ -148,6 +142,14 @@ func (a *Taro) Copy() *Taro {
 	return &addressCopy
 }
 
+// IsAmountless returns true if the address accepts any amount of the asset.
+// As the on-chain Taproot output key commits to the amount that is sent, the
+// output for an amount-less address can only be derived once the amount is
+// known.
+func (a *Taro) IsAmountless() bool {
+	return a.Amount == 0
+}
+
 // Net returns the ChainParams struct matching the Taro address network.
 func (a *Taro) Net() (*ChainParams, error) {
 	return Net(a.ChainParams.TaroHRP)
@@ -245,7 +247,11 @@ func (a *Taro) EncodeRecords() []tlv.Record {
 
 	records = append(records, newAddressScriptKeyRecord(&a.ScriptKey))
 	records = append(records, newAddressInternalKeyRecord(&a.InternalKey))
-	records = append(records, newAddressAmountRecord(&a.Amount))
+
+	// The amount record is omitted for amount-less addresses.
+	if !a.IsAmountless() {
+		records = append(records, newAddressAmountRecord(&a.Amount))
+	}
 
 	return records
 }
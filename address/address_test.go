@@ -124,7 +124,7 @@ func TestNewAddress(t *testing.T) {
 			err: nil,
 		},
 		{
-			name: "invalid normal asset value",
+			name: "amount-less normal address",
 			f: func() (*Taro, error) {
 				zeroAmt := uint64(0)
 				return randAddress(
@@ -132,7 +132,18 @@ func TestNewAddress(t *testing.T) {
 					asset.Normal,
 				)
 			},
-			err: ErrInvalidAmountNormal,
+			err: nil,
+		},
+		{
+			name: "amount-less collectible address",
+			f: func() (*Taro, error) {
+				zeroAmt := uint64(0)
+				return randAddress(
+					t, &TestNet3Taro, false, &zeroAmt,
+					asset.Collectible,
+				)
+			},
+			err: ErrInvalidAmountCollectible,
 		},
 		{
 			name: "invalid collectible asset value",
@@ -205,6 +216,27 @@ func TestAddressEncoding(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "amount-less address",
+			f: func() (*Taro, string, error) {
+				zeroAmt := uint64(0)
+				newAddr, err := randAddress(
+					t, &RegressionNetTaro, false, &zeroAmt,
+					asset.Normal,
+				)
+				require.NoError(t, err)
+				require.True(t, newAddr.IsAmountless())
+
+				// The placeholder output key of the address can
+				// still be derived.
+				_, err = newAddr.TaprootOutputKey(nil)
+				require.NoError(t, err)
+
+				encodedAddr, err := newAddr.EncodeAddress()
+				return newAddr, encodedAddr, err
+			},
+			err: nil,
+		},
 		{
 			name: "family collectible",
 			f: func() (*Taro, string, error) {
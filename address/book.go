@@ -2,6 +2,7 @@ package address
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -38,6 +39,29 @@ type AddrWithKeyInfo struct {
 	// ManagedAfter is the time at which the address was imported into the
 	// wallet.
 	ManagedAfter time.Time
+
+	// Expiry is the time after which the address no longer accepts
+	// incoming asset transfers. A zero value means the address never
+	// expires.
+	Expiry time.Time
+
+	// MaxReceives is the maximum number of incoming asset transfers the
+	// address accepts. A value of 0 means the address can be received to
+	// any number of times.
+	MaxReceives uint32
+
+	// Label is an optional user provided label of the address.
+	Label string
+
+	// Tags is an optional set of user provided key/value tags of the
+	// address.
+	Tags map[string]string
+}
+
+// IsExpired returns true if the address has an expiry that lies before the
+// given time.
+func (a *AddrWithKeyInfo) IsExpired(now time.Time) bool {
+	return !a.Expiry.IsZero() && now.After(a.Expiry)
 }
 
 // QueryParams holds the set of query params for the address book.
@@ -60,6 +84,28 @@ type QueryParams struct {
 	// UnmanagedOnly is a boolean pointer indicating whether only addresses
 	// should be returned that are not yet managed by the wallet.
 	UnmanagedOnly bool
+
+	// ExpiredOnly indicates whether only addresses should be returned that
+	// no longer accept incoming asset transfers, either because they
+	// expired or because they already received the maximum number of
+	// transfers.
+	ExpiredOnly bool
+
+	// ActiveOnly indicates whether only addresses should be returned that
+	// still accept incoming asset transfers.
+	ActiveOnly bool
+
+	// Label if set, only addresses with exactly this label will be
+	// returned.
+	Label string
+
+	// TagKey if set, only addresses that have a tag with this key will be
+	// returned.
+	TagKey string
+
+	// TagValue if set, only addresses that have a tag with the TagKey and
+	// this value will be returned. This is ignored if TagKey isn't set.
+	TagValue string
 }
 
 // Storage is the main storage interface for the address book.
@@ -115,8 +161,12 @@ type Book struct {
 	// address events, keyed by their subscription ID.
 	subscribers map[uint64]*chanutils.EventReceiver[*AddrWithKeyInfo]
 
-	// subscriberMtx guards the subscribers map and access to the
-	// subscriptionID.
+	// eventSubscribers is a map of components that want to be notified on
+	// status changes of address events, keyed by their subscription ID.
+	eventSubscribers map[uint64]*chanutils.EventReceiver[*Event]
+
+	// subscriberMtx guards the subscribers and eventSubscribers maps and
+	// access to the subscriptionID.
 	subscriberMtx sync.Mutex
 }
 
@@ -131,12 +181,45 @@ func NewBook(cfg BookConfig) *Book {
 		subscribers: make(
 			map[uint64]*chanutils.EventReceiver[*AddrWithKeyInfo],
 		),
+		eventSubscribers: make(
+			map[uint64]*chanutils.EventReceiver[*Event],
+		),
 	}
 }
 
-// NewAddress creates a new Taro address based on the input parameters.
+// NewAddress creates a new Taro address based on the input parameters. A
+// zero expiry creates an address that never expires, a maxReceives of 0 an
+// address that can be received to any number of times. Amount-less addresses
+// always accept a single receive only. The label and tags are optional
+// annotations that are only stored locally.
 func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
-	famKey *btcec.PublicKey, amount uint64) (*AddrWithKeyInfo, error) {
+	famKey *btcec.PublicKey, amount uint64, expiry time.Time,
+	maxReceives uint32, label string,
+	tags map[string]string) (*AddrWithKeyInfo, error) {
+
+	if !expiry.IsZero() && !expiry.After(time.Now()) {
+		return nil, fmt.Errorf("address expiry %v is not in the "+
+			"future", expiry)
+	}
+
+	// All transfers to an amount-less address are sent to the same script
+	// key, so neither the proof archive nor the proof courier could tell
+	// the proofs of multiple transfers apart. We therefore only allow a
+	// single receive for them.
+	if amount == 0 {
+		if maxReceives > 1 {
+			return nil, fmt.Errorf("amount-less address can only " +
+				"be received to once")
+		}
+		maxReceives = 1
+	}
+
+	for key := range tags {
+		if key == "" {
+			return nil, fmt.Errorf("address tag key cannot be " +
+				"empty")
+		}
+	}
 
 	rawScriptKeyDesc, err := b.cfg.KeyRing.DeriveNextTaroKey(ctx)
 	if err != nil {
@@ -173,6 +256,10 @@ func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     time.Now(),
+		Expiry:           expiry,
+		MaxReceives:      maxReceives,
+		Label:            label,
+		Tags:             tags,
 	}
 
 	if err := b.cfg.Store.InsertAddrs(ctx, addr); err != nil {
@@ -189,6 +276,62 @@ func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
 	return &addr, nil
 }
 
+// ImportAddrs imports the given addresses, for example from an address export
+// file of another node, into the address book. Addresses that are already known
+// are skipped. All imported addresses are delivered to the subscribers of the
+// address book, which then import them into the wallet. The newly imported
+// addresses are returned.
+func (b *Book) ImportAddrs(ctx context.Context,
+	addrs []AddrWithKeyInfo) ([]AddrWithKeyInfo, error) {
+
+	newAddrs := make([]AddrWithKeyInfo, 0, len(addrs))
+	for idx := range addrs {
+		addr := addrs[idx]
+
+		_, err := b.cfg.Store.AddrByTaprootOutput(
+			ctx, &addr.TaprootOutputKey,
+		)
+		switch {
+		case err == nil:
+			continue
+
+		case errors.Is(err, ErrNoAddr):
+
+		default:
+			return nil, fmt.Errorf("unable to look up addr: %w",
+				err)
+		}
+
+		// The address needs to be imported into our wallet again, so
+		// we make sure it isn't considered to be managed already.
+		addr.ManagedAfter = time.Time{}
+		if addr.CreationTime.IsZero() {
+			addr.CreationTime = time.Now()
+		}
+
+		newAddrs = append(newAddrs, addr)
+	}
+
+	if len(newAddrs) == 0 {
+		return nil, nil
+	}
+
+	if err := b.cfg.Store.InsertAddrs(ctx, newAddrs...); err != nil {
+		return nil, fmt.Errorf("unable to insert addrs: %w", err)
+	}
+
+	// Inform our subscribers about the imported addresses.
+	b.subscriberMtx.Lock()
+	for idx := range newAddrs {
+		for _, sub := range b.subscribers {
+			sub.NewItemCreated.ChanIn() <- &newAddrs[idx]
+		}
+	}
+	b.subscriberMtx.Unlock()
+
+	return newAddrs, nil
+}
+
 // ListAddrs lists a set of addresses based on the expressed query params.
 func (b *Book) ListAddrs(ctx context.Context,
 	params QueryParams) ([]AddrWithKeyInfo, error) {
@@ -219,9 +362,16 @@ func (b *Book) GetOrCreateEvent(ctx context.Context, status Status,
 	addr *AddrWithKeyInfo, walletTx *lndclient.Transaction,
 	outputIdx uint32, tapscriptSibling *chainhash.Hash) (*Event, error) {
 
-	return b.cfg.Store.GetOrCreateEvent(
+	event, err := b.cfg.Store.GetOrCreateEvent(
 		ctx, status, addr, walletTx, outputIdx, tapscriptSibling,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	b.publishEvent(event)
+
+	return event, nil
 }
 
 // GetPendingEvents returns all events that are not yet in status complete from
@@ -248,7 +398,50 @@ func (b *Book) QueryEvents(ctx context.Context,
 func (b *Book) CompleteEvent(ctx context.Context, event *Event,
 	status Status, anchorPoint wire.OutPoint) error {
 
-	return b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
+	err := b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	completedEvent := *event
+	completedEvent.Status = status
+	completedEvent.HasProof = true
+	b.publishEvent(&completedEvent)
+
+	return nil
+}
+
+// FailEvent updates an address event to the given failure status and records
+// the reason of the failure. Events in a failure status are final.
+func (b *Book) FailEvent(ctx context.Context, event *Event, status Status,
+	reason string) error {
+
+	if !status.IsFailure() {
+		return fmt.Errorf("status %v is not a failure status", status)
+	}
+
+	err := b.cfg.Store.FailEvent(ctx, event, status, reason)
+	if err != nil {
+		return err
+	}
+
+	failedEvent := *event
+	failedEvent.Status = status
+	failedEvent.FailureReason = reason
+	b.publishEvent(&failedEvent)
+
+	return nil
+}
+
+// publishEvent informs our event subscribers about the current state of an
+// address event.
+func (b *Book) publishEvent(event *Event) {
+	b.subscriberMtx.Lock()
+	defer b.subscriberMtx.Unlock()
+
+	for _, sub := range b.eventSubscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
 }
 
 // RegisterSubscriber adds a new subscriber for receiving events. The
@@ -309,3 +502,62 @@ func (b *Book) RemoveSubscriber(
 
 	return nil
 }
+
+// RegisterEventSubscriber adds a new subscriber for receiving status changes
+// of address events. Subscribers might be notified about the same state of an
+// event more than once, for example if a transaction is inspected again during
+// a rescan. The deliverExisting boolean indicates whether already existing
+// events that match the deliverFrom query should be sent to the NewItemCreated
+// channel when the subscription is started.
+func (b *Book) RegisterEventSubscriber(
+	receiver *chanutils.EventReceiver[*Event], deliverExisting bool,
+	deliverFrom EventQueryParams) error {
+
+	b.subscriberMtx.Lock()
+	defer b.subscriberMtx.Unlock()
+
+	b.eventSubscribers[receiver.ID()] = receiver
+
+	// No delivery of existing items requested, we're done here.
+	if !deliverExisting {
+		return nil
+	}
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), b.cfg.StoreTimeout,
+	)
+	defer cancel()
+
+	existingEvents, err := b.QueryEvents(ctxt, deliverFrom)
+	if err != nil {
+		return fmt.Errorf("error querying existing events: %w", err)
+	}
+
+	// Deliver each existing event to the new item queue of the
+	// subscriber.
+	for i := range existingEvents {
+		receiver.NewItemCreated.ChanIn() <- existingEvents[i]
+	}
+
+	return nil
+}
+
+// RemoveEventSubscriber removes the given address event subscriber and also
+// stops it from processing events.
+func (b *Book) RemoveEventSubscriber(
+	subscriber *chanutils.EventReceiver[*Event]) error {
+
+	b.subscriberMtx.Lock()
+	defer b.subscriberMtx.Unlock()
+
+	_, ok := b.eventSubscribers[subscriber.ID()]
+	if !ok {
+		return fmt.Errorf("event subscriber with ID %d not found",
+			subscriber.ID())
+	}
+
+	subscriber.Stop()
+	delete(b.eventSubscribers, subscriber.ID())
+
+	return nil
+}
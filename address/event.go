@@ -2,6 +2,7 @@ package address
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -33,8 +34,68 @@ const (
 	// successfully and the local node has taken over custody of the assets
 	// that were transferred.
 	StatusCompleted Status = 3
+
+	// StatusExpired denotes that an incoming asset transfer was detected
+	// for an address after the address expired. The custodian doesn't take
+	// custody of the assets of such a transfer.
+	StatusExpired Status = 4
+
+	// StatusMaxReceivesExceeded denotes that an incoming asset transfer was
+	// detected for an address that already received the maximum number of
+	// transfers it accepts. The custodian doesn't take custody of the
+	// assets of such a transfer.
+	StatusMaxReceivesExceeded Status = 5
+
+	// StatusProofInvalid denotes that the proof for an incoming asset
+	// transfer failed verification. The custodian doesn't take custody of
+	// the assets of such a transfer.
+	StatusProofInvalid Status = 6
+
+	// StatusAssetMismatch denotes that the proof for an incoming asset
+	// transfer is for a different asset or amount than the address
+	// expects. The custodian doesn't take custody of the assets of such a
+	// transfer.
+	StatusAssetMismatch Status = 7
+
+	// StatusTransactionFailed denotes that the transaction of an incoming
+	// asset transfer will never confirm, because it was double spent,
+	// replaced or reorged out of the chain.
+	StatusTransactionFailed Status = 8
 )
 
+// IsFailure returns true if the status is a terminal status of an incoming
+// asset transfer the custodian didn't take custody of. Events in a failure
+// status don't change their status anymore.
+func (s Status) IsFailure() bool {
+	return s >= StatusExpired && s <= StatusTransactionFailed
+}
+
+// String returns a human readable string for the address event status.
+func (s Status) String() string {
+	switch s {
+	case StatusTransactionDetected:
+		return "transaction_detected"
+	case StatusTransactionConfirmed:
+		return "transaction_confirmed"
+	case StatusProofReceived:
+		return "proof_received"
+	case StatusCompleted:
+		return "completed"
+	case StatusExpired:
+		return "expired"
+	case StatusMaxReceivesExceeded:
+		return "max_receives_exceeded"
+	case StatusProofInvalid:
+		return "proof_invalid"
+	case StatusAssetMismatch:
+		return "asset_mismatch"
+	case StatusTransactionFailed:
+		return "transaction_failed"
+	default:
+		return fmt.Sprintf("<unknown status %d>", uint8(s))
+	}
+}
+
 // EventQueryParams holds the set of query params for address events.
 type EventQueryParams struct {
 	// AddrTaprootOutputKey is the optional 32-byte x-only serialized
@@ -74,10 +135,14 @@ type Event struct {
 	Outpoint wire.OutPoint
 
 	// Amt is the amount of satoshis that were transferred in the Bitcoin
-	// on-chain transaction. This is independent of the asset amount, which
-	// can be looked up through the Addr field.
+	// on-chain transaction. This is independent of the asset amount.
 	Amt btcutil.Amount
 
+	// AssetAmount is the amount of asset units that were received. This is
+	// equal to the amount of the address, unless the address is
+	// amount-less, in which case it's the amount chosen by the sender.
+	AssetAmount uint64
+
 	// InternalKey is the key used as the internal key for the on-chain
 	// Taproot output. The internal key tweaked with the Taro commitment
 	// (when NO tapscript sibling if present) is equal to the
@@ -103,6 +168,11 @@ type Event struct {
 	// don't keep a reference to it in memory as the proof itself can be
 	// large. The proof can be fetched by the script key of the address.
 	HasProof bool
+
+	// FailureReason is a human readable description of why the incoming
+	// asset transfer failed. This is only set for events in a failure
+	// status that was set with FailEvent.
+	FailureReason string
 }
 
 // EventStorage is the interface that a component storing address events should
@@ -111,7 +181,9 @@ type EventStorage interface {
 	// GetOrCreateEvent creates a new address event for the given status,
 	// address and transaction. If an event for that address and transaction
 	// already exists, then the status and transaction information is
-	// updated instead.
+	// updated instead. The amount of the given address is recorded as the
+	// amount received with a new event, so for amount-less addresses it
+	// must be set to the amount actually received.
 	GetOrCreateEvent(ctx context.Context, status Status,
 		addr *AddrWithKeyInfo, walletTx *lndclient.Transaction,
 		outputIdx uint32, tapscriptSibling *chainhash.Hash) (*Event,
@@ -126,4 +198,9 @@ type EventStorage interface {
 	// with the proof and asset that was imported/created for it.
 	CompleteEvent(ctx context.Context, event *Event, status Status,
 		anchorPoint wire.OutPoint) error
+
+	// FailEvent updates an address event to the given failure status and
+	// records the reason of the failure.
+	FailEvent(ctx context.Context, event *Event, status Status,
+		reason string) error
 }
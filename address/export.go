@@ -0,0 +1,438 @@
+package address
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrUnknownExportVersion is returned when an address export file with
+	// an unknown version is decoded.
+	ErrUnknownExportVersion = errors.New(
+		"address: unknown export file version",
+	)
+
+	// ErrExportNetMismatch is returned when an address export file that
+	// was created for a different network is decoded.
+	ErrExportNetMismatch = errors.New(
+		"address: export file network mismatch",
+	)
+
+	// ErrExportKeyMismatch is returned when the key information of an
+	// exported address doesn't match the keys encoded in the address.
+	ErrExportKeyMismatch = errors.New(
+		"address: export key info doesn't match address",
+	)
+)
+
+// ExportVersion denotes the versioning scheme for address export files.
+type ExportVersion uint32
+
+const (
+	// ExportV0 is the first version of the address export file.
+	ExportV0 ExportVersion = 0
+)
+
+const (
+	// maxExportAddrs is the maximum number of addresses we decode from a
+	// single export file.
+	maxExportAddrs = 1 << 20
+
+	// maxExportRecordSize is the maximum size of a single encoded address
+	// within an export file.
+	maxExportRecordSize = 1 << 16
+)
+
+// exportTLVType represents the different TLV types of an address within an
+// address export file.
+type exportTLVType = tlv.Type
+
+const (
+	exportAddrType              exportTLVType = 0
+	exportScriptKeyFamilyType   exportTLVType = 2
+	exportScriptKeyIndexType    exportTLVType = 4
+	exportRawScriptKeyType      exportTLVType = 6
+	exportScriptKeyTweakType    exportTLVType = 8
+	exportInternalKeyFamilyType exportTLVType = 10
+	exportInternalKeyIndexType  exportTLVType = 12
+	exportCreationTimeType      exportTLVType = 14
+	exportExpiryType            exportTLVType = 16
+	exportMaxReceivesType       exportTLVType = 18
+	exportLabelType             exportTLVType = 20
+	exportTagsType              exportTLVType = 22
+)
+
+// exportedAddr is the flattened form of an address with its key information
+// that is written to an address export file.
+type exportedAddr struct {
+	addr              []byte
+	scriptKeyFamily   uint32
+	scriptKeyIndex    uint32
+	rawScriptKey      []byte
+	scriptKeyTweak    []byte
+	internalKeyFamily uint32
+	internalKeyIndex  uint32
+	creationTime      uint64
+	expiry            uint64
+	maxReceives       uint32
+	label             []byte
+	tags              map[string]string
+}
+
+// records returns the TLV records of the exported address.
+func (e *exportedAddr) records() []tlv.Record {
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(exportAddrType, &e.addr),
+		tlv.MakePrimitiveRecord(
+			exportScriptKeyFamilyType, &e.scriptKeyFamily,
+		),
+		tlv.MakePrimitiveRecord(
+			exportScriptKeyIndexType, &e.scriptKeyIndex,
+		),
+		tlv.MakePrimitiveRecord(
+			exportRawScriptKeyType, &e.rawScriptKey,
+		),
+		tlv.MakePrimitiveRecord(
+			exportScriptKeyTweakType, &e.scriptKeyTweak,
+		),
+		tlv.MakePrimitiveRecord(
+			exportInternalKeyFamilyType, &e.internalKeyFamily,
+		),
+		tlv.MakePrimitiveRecord(
+			exportInternalKeyIndexType, &e.internalKeyIndex,
+		),
+		tlv.MakePrimitiveRecord(
+			exportCreationTimeType, &e.creationTime,
+		),
+		tlv.MakePrimitiveRecord(exportExpiryType, &e.expiry),
+		tlv.MakePrimitiveRecord(exportMaxReceivesType, &e.maxReceives),
+		tlv.MakePrimitiveRecord(exportLabelType, &e.label),
+		newExportTagsRecord(&e.tags),
+	}
+}
+
+// newExportTagsRecord returns a TLV record that encodes the tags of an address
+// as a count followed by the length prefixed key and value of each tag.
+func newExportTagsRecord(tags *map[string]string) tlv.Record {
+	recordSize := func() uint64 {
+		var (
+			b   bytes.Buffer
+			buf [8]byte
+		)
+		if err := tagsEncoder(&b, tags, &buf); err != nil {
+			panic(err)
+		}
+		return uint64(len(b.Bytes()))
+	}
+	return tlv.MakeDynamicRecord(
+		exportTagsType, tags, recordSize, tagsEncoder, tagsDecoder,
+	)
+}
+
+func tagsEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*map[string]string); ok {
+		err := tlv.WriteVarInt(w, uint64(len(*t)), buf)
+		if err != nil {
+			return err
+		}
+
+		// We write the tags in a deterministic order, so the same set
+		// of addresses always results in the same export file.
+		keys := make([]string, 0, len(*t))
+		for key := range *t {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			key, value := []byte(key), []byte((*t)[key])
+			err := asset.VarBytesEncoder(w, &key, buf)
+			if err != nil {
+				return err
+			}
+			err = asset.VarBytesEncoder(w, &value, buf)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "map[string]string")
+}
+
+func tagsDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if typ, ok := val.(*map[string]string); ok {
+		numTags, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		// Each tag needs at least two bytes for the length prefixes of
+		// its key and value.
+		if numTags > l/2 {
+			return fmt.Errorf("too many tags: %v", numTags)
+		}
+
+		// Addresses without tags are represented by a nil map.
+		if numTags == 0 {
+			*typ = nil
+			return nil
+		}
+
+		tags := make(map[string]string, numTags)
+		for i := uint64(0); i < numTags; i++ {
+			var key, value []byte
+			err := asset.VarBytesDecoder(r, &key, buf, 0)
+			if err != nil {
+				return err
+			}
+			err = asset.VarBytesDecoder(r, &value, buf, 0)
+			if err != nil {
+				return err
+			}
+			tags[string(key)] = string(value)
+		}
+		*typ = tags
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "map[string]string", l, l)
+}
+
+// unixOrZero returns the unix timestamp of the given time, or zero if the time
+// isn't set.
+func unixOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+// timeOrZero is the inverse of unixOrZero.
+func timeOrZero(unix uint64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(unix), 0)
+}
+
+// EncodeExport writes the given addresses, including all the key information
+// needed to re-derive their keys in the wallet, to w as a versioned address
+// export file for the given network. The time at which an address was imported
+// into the wallet is not exported, as the address needs to be imported into the
+// wallet of the importing node again.
+func EncodeExport(w io.Writer, net *ChainParams,
+	addrs []AddrWithKeyInfo) error {
+
+	err := binary.Write(w, binary.BigEndian, uint32(ExportV0))
+	if err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	netName := []byte(net.Name)
+	if err := asset.VarBytesEncoder(w, &netName, &tlvBuf); err != nil {
+		return err
+	}
+
+	err = tlv.WriteVarInt(w, uint64(len(addrs)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for idx := range addrs {
+		addr := addrs[idx]
+
+		var addrBuf bytes.Buffer
+		if err := addr.Taro.Encode(&addrBuf); err != nil {
+			return fmt.Errorf("unable to encode addr: %w", err)
+		}
+
+		rawScriptKey := addr.ScriptKeyTweak.RawKey
+		exported := &exportedAddr{
+			addr:            addrBuf.Bytes(),
+			scriptKeyFamily: uint32(rawScriptKey.Family),
+			scriptKeyIndex:  rawScriptKey.Index,
+			rawScriptKey: rawScriptKey.PubKey.
+				SerializeCompressed(),
+			scriptKeyTweak: addr.ScriptKeyTweak.Tweak,
+			internalKeyFamily: uint32(
+				addr.InternalKeyDesc.Family,
+			),
+			internalKeyIndex: addr.InternalKeyDesc.Index,
+			creationTime:     unixOrZero(addr.CreationTime),
+			expiry:           unixOrZero(addr.Expiry),
+			maxReceives:      addr.MaxReceives,
+			label:            []byte(addr.Label),
+			tags:             addr.Tags,
+		}
+
+		stream, err := tlv.NewStream(exported.records()...)
+		if err != nil {
+			return err
+		}
+
+		var recordBuf bytes.Buffer
+		if err := stream.Encode(&recordBuf); err != nil {
+			return err
+		}
+
+		recordBytes := recordBuf.Bytes()
+		err = asset.VarBytesEncoder(w, &recordBytes, &tlvBuf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeExport reads a versioned address export file from r and returns the
+// addresses it contains. The export file must have been created for the given
+// network. The key information of each address is checked against the keys
+// encoded in the address itself.
+func DecodeExport(r io.Reader, net *ChainParams) ([]AddrWithKeyInfo, error) {
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return nil, err
+	}
+	if ExportVersion(version) != ExportV0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownExportVersion,
+			version)
+	}
+
+	var (
+		tlvBuf  [8]byte
+		netName []byte
+	)
+	if err := asset.VarBytesDecoder(r, &netName, &tlvBuf, 0); err != nil {
+		return nil, err
+	}
+	if string(netName) != net.Name {
+		return nil, fmt.Errorf("%w: file is for %s, expected %s",
+			ErrExportNetMismatch, netName, net.Name)
+	}
+
+	numAddrs, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+	if numAddrs > maxExportAddrs {
+		return nil, fmt.Errorf("too many addresses in export file: "+
+			"%v", numAddrs)
+	}
+
+	addrs := make([]AddrWithKeyInfo, 0, numAddrs)
+	for i := uint64(0); i < numAddrs; i++ {
+		var recordBytes []byte
+		err := asset.VarBytesDecoder(r, &recordBytes, &tlvBuf, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(recordBytes) > maxExportRecordSize {
+			return nil, fmt.Errorf("exported address too large: "+
+				"%v bytes", len(recordBytes))
+		}
+
+		var exported exportedAddr
+		stream, err := tlv.NewStream(exported.records()...)
+		if err != nil {
+			return nil, err
+		}
+		err = stream.Decode(bytes.NewReader(recordBytes))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode exported "+
+				"addr %d: %w", i, err)
+		}
+
+		addr, err := exported.toAddr(net)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exported addr %d: %w",
+				i, err)
+		}
+
+		addrs = append(addrs, *addr)
+	}
+
+	return addrs, nil
+}
+
+// toAddr turns the exported address back into an address with its key
+// information, making sure the key information matches the address.
+func (e *exportedAddr) toAddr(net *ChainParams) (*AddrWithKeyInfo, error) {
+	var taro Taro
+	if err := taro.Decode(bytes.NewReader(e.addr)); err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+	taro.ChainParams = net
+
+	rawScriptKey, err := btcec.ParsePubKey(e.rawScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse raw script key: %w",
+			err)
+	}
+
+	// The script key of the address must be derived from the exported
+	// raw script key, otherwise we wouldn't be able to spend the assets
+	// received to it.
+	var scriptKey *btcec.PublicKey
+	if len(e.scriptKeyTweak) == 0 {
+		scriptKey = txscript.ComputeTaprootKeyNoScript(rawScriptKey)
+	} else {
+		scriptKey = txscript.ComputeTaprootOutputKey(
+			rawScriptKey, e.scriptKeyTweak,
+		)
+	}
+	expectedScriptKey := schnorr.SerializePubKey(&taro.ScriptKey)
+	if !bytes.Equal(schnorr.SerializePubKey(scriptKey), expectedScriptKey) {
+		return nil, fmt.Errorf("%w: script key", ErrExportKeyMismatch)
+	}
+
+	taprootOutputKey, err := taro.TaprootOutputKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive Taproot output key: "+
+			"%w", err)
+	}
+
+	internalKey := taro.InternalKey
+	return &AddrWithKeyInfo{
+		Taro: &taro,
+		ScriptKeyTweak: asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(
+						e.scriptKeyFamily,
+					),
+					Index: e.scriptKeyIndex,
+				},
+				PubKey: rawScriptKey,
+			},
+			Tweak: e.scriptKeyTweak,
+		},
+		InternalKeyDesc: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(
+					e.internalKeyFamily,
+				),
+				Index: e.internalKeyIndex,
+			},
+			PubKey: &internalKey,
+		},
+		TaprootOutputKey: *taprootOutputKey,
+		CreationTime:     timeOrZero(e.creationTime),
+		Expiry:           timeOrZero(e.expiry),
+		MaxReceives:      e.maxReceives,
+		Label:            string(e.label),
+		Tags:             e.tags,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package address
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// randAddrWithKeyInfo creates a random address with consistent key
+// information for the given network.
+func randAddrWithKeyInfo(t *testing.T, net *ChainParams) AddrWithKeyInfo {
+	rawScriptKey := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(test.RandInt[uint32]()),
+			Index:  test.RandInt[uint32](),
+		},
+		PubKey: test.RandPubKey(t),
+	}
+	internalKey := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(test.RandInt[uint32]()),
+			Index:  test.RandInt[uint32](),
+		},
+		PubKey: test.RandPubKey(t),
+	}
+	scriptKey := asset.NewScriptKeyBIP0086(rawScriptKey)
+
+	taro, err := New(
+		asset.RandGenesis(t, asset.Normal), nil, *scriptKey.PubKey,
+		*internalKey.PubKey, 100, net,
+	)
+	require.NoError(t, err)
+
+	taprootOutputKey, err := taro.TaprootOutputKey(nil)
+	require.NoError(t, err)
+
+	return AddrWithKeyInfo{
+		Taro:             taro,
+		ScriptKeyTweak:   *scriptKey.TweakedScriptKey,
+		InternalKeyDesc:  internalKey,
+		TaprootOutputKey: *taprootOutputKey,
+		CreationTime:     time.Unix(time.Now().Unix(), 0),
+	}
+}
+
+// assertExportedAddrEqual makes sure an address decoded from an export file
+// matches the exported address.
+func assertExportedAddrEqual(t *testing.T, expected, actual AddrWithKeyInfo) {
+	t.Helper()
+
+	expectedAddr, err := expected.EncodeAddress()
+	require.NoError(t, err)
+	actualAddr, err := actual.EncodeAddress()
+	require.NoError(t, err)
+	require.Equal(t, expectedAddr, actualAddr)
+
+	require.Equal(
+		t, expected.ScriptKeyTweak.RawKey.KeyLocator,
+		actual.ScriptKeyTweak.RawKey.KeyLocator,
+	)
+	require.True(t, expected.ScriptKeyTweak.RawKey.PubKey.IsEqual(
+		actual.ScriptKeyTweak.RawKey.PubKey,
+	))
+	require.Equal(
+		t, expected.InternalKeyDesc.KeyLocator,
+		actual.InternalKeyDesc.KeyLocator,
+	)
+	require.Equal(
+		t, schnorr.SerializePubKey(&expected.TaprootOutputKey),
+		schnorr.SerializePubKey(&actual.TaprootOutputKey),
+	)
+	require.Equal(
+		t, expected.CreationTime.Unix(), actual.CreationTime.Unix(),
+	)
+	require.Equal(t, expected.Expiry.Unix(), actual.Expiry.Unix())
+	require.Equal(t, expected.MaxReceives, actual.MaxReceives)
+	require.Equal(t, expected.Label, actual.Label)
+	require.Equal(t, expected.Tags, actual.Tags)
+}
+
+// TestAddrExport tests that addresses can be exported to and imported from an
+// address export file.
+func TestAddrExport(t *testing.T) {
+	t.Parallel()
+
+	net := &TestNet3Taro
+
+	addrs := []AddrWithKeyInfo{
+		randAddrWithKeyInfo(t, net),
+		randAddrWithKeyInfo(t, net),
+		randAddrWithKeyInfo(t, net),
+	}
+	addrs[1].Expiry = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	addrs[1].MaxReceives = 1
+	addrs[2].Label = "invoice 1337"
+	addrs[2].Tags = map[string]string{
+		"customer": "alice",
+		"order":    "",
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, EncodeExport(&b, net, addrs))
+	exportFile := b.Bytes()
+
+	decoded, err := DecodeExport(bytes.NewReader(exportFile), net)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(addrs))
+	for idx := range addrs {
+		assertExportedAddrEqual(t, addrs[idx], decoded[idx])
+	}
+
+	// An export file for a different network can't be imported.
+	_, err = DecodeExport(bytes.NewReader(exportFile), &MainNetTaro)
+	require.ErrorIs(t, err, ErrExportNetMismatch)
+
+	// Neither can an export file with an unknown version.
+	unknownVersion := make([]byte, len(exportFile))
+	copy(unknownVersion, exportFile)
+	binary.BigEndian.PutUint32(unknownVersion, uint32(ExportV0)+1)
+	_, err = DecodeExport(bytes.NewReader(unknownVersion), net)
+	require.ErrorIs(t, err, ErrUnknownExportVersion)
+
+	// Finally, the key information of an address must match the address.
+	mismatched := randAddrWithKeyInfo(t, net)
+	mismatched.ScriptKeyTweak.RawKey.PubKey = test.RandPubKey(t)
+
+	b.Reset()
+	require.NoError(t, EncodeExport(&b, net, []AddrWithKeyInfo{mismatched}))
+	_, err = DecodeExport(&b, net)
+	require.ErrorIs(t, err, ErrExportKeyMismatch)
+}
@@ -43,19 +43,28 @@ var registerMtx sync.RWMutex
 
 // Register attempts to register a new taro ChainParams with the library. If a
 // set of parameters for the network has already been registered, then an error
-// is returned.
+// is returned. The registered parameters take precedence over the default
+// parameters of a network with the same name, which allows a custom signet to
+// replace the default signet for example.
 //
 // TODO(jhb): Resolve duplicate networks?
 func Register(params *ChainParams) error {
 	registerMtx.Lock()
 	defer registerMtx.Unlock()
 
+	if params.TaroHRP == "" {
+		return fmt.Errorf("missing Taro HRP for network %v",
+			params.Name)
+	}
+
 	err := chaincfg.Register(params.Params)
 	if err != nil {
 		return err
 	}
 
 	bech32TaroPrefixes[params.TaroHRP+"1"] = struct{}{}
+	registeredNets[params.Name] = params
+
 	return nil
 }
 
@@ -64,6 +73,10 @@ var (
 	// bech32m encoded addresses.
 	bech32TaroPrefixes = make(map[string]struct{})
 
+	// registeredNets holds the set of networks that were registered with
+	// Register, keyed by their name.
+	registeredNets = make(map[string]*ChainParams)
+
 	// MainNetTaro holds the chain params for mainnet.
 	MainNetTaro = ChainParams{
 		Params:  &chaincfg.MainNetParams,
@@ -112,8 +125,32 @@ func IsForNet(hrp string, net *ChainParams) bool {
 	return hrp == net.TaroHRP
 }
 
-// Net returns the ChainParams struct associated with a Taro HRP.
+// registeredNet returns the first registered network that matches the given
+// predicate, if there is one.
+func registeredNet(match func(*ChainParams) bool) (*ChainParams, bool) {
+	registerMtx.RLock()
+	defer registerMtx.RUnlock()
+
+	for _, params := range registeredNets {
+		if match(params) {
+			return params, true
+		}
+	}
+
+	return nil, false
+}
+
+// Net returns the ChainParams struct associated with a Taro HRP. As testnet and
+// signet share the same HRP, the testnet parameters are returned for it unless
+// a signet was registered.
 func Net(hrp string) (*ChainParams, error) {
+	params, ok := registeredNet(func(p *ChainParams) bool {
+		return p.TaroHRP == hrp
+	})
+	if ok {
+		return params, nil
+	}
+
 	switch hrp {
 	case MainNetTaro.TaroHRP:
 		return &MainNetTaro, nil
@@ -134,7 +171,7 @@ func Net(hrp string) (*ChainParams, error) {
 		simNet := SimNetTaro
 		simNet.HDCoinType = TestNet3Taro.HDCoinType
 
-		return &SimNetTaro, nil
+		return &simNet, nil
 
 	default:
 		return nil, ErrUnsupportedHRP
@@ -142,7 +179,15 @@ func Net(hrp string) (*ChainParams, error) {
 }
 
 // ParamsForChain returns the ChainParams for a given chain based on its name.
+// Networks registered with Register take precedence over the default networks.
 func ParamsForChain(name string) ChainParams {
+	params, ok := registeredNet(func(p *ChainParams) bool {
+		return p.Name == name
+	})
+	if ok {
+		return *params
+	}
+
 	switch name {
 	case chaincfg.MainNetParams.Name:
 		return MainNetTaro
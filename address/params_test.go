@@ -0,0 +1,63 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNetworkParams tests the mapping between network names, HRPs and the Taro
+// chain params, including registering a custom network.
+func TestNetworkParams(t *testing.T) {
+	// All default networks should be known by name.
+	for _, params := range []ChainParams{
+		MainNetTaro, TestNet3Taro, RegressionNetTaro, SimNetTaro,
+	} {
+		byName := ParamsForChain(params.Name)
+		require.Equal(t, params.TaroHRP, byName.TaroHRP)
+
+		net, err := Net(params.TaroHRP)
+		require.NoError(t, err)
+		require.Equal(t, params.Name, net.Name)
+		require.True(t, IsBech32MTaroPrefix(params.TaroHRP+"1"))
+	}
+
+	// lnd only knows the testnet coin type, so that is what we expect for
+	// simnet, regardless of how the params are looked up.
+	simNet, err := Net(SimNetHRP)
+	require.NoError(t, err)
+	require.Equal(t, TestNet3Taro.HDCoinType, simNet.HDCoinType)
+	require.Equal(
+		t, TestNet3Taro.HDCoinType,
+		ParamsForChain(chaincfg.SimNetParams.Name).HDCoinType,
+	)
+
+	// A custom network needs a Taro HRP.
+	customSigNet := chaincfg.CustomSignetParams([]byte{0x51}, nil)
+	err = Register(&ChainParams{Params: &customSigNet})
+	require.Error(t, err)
+
+	// Once a custom signet is registered, its params are used instead of
+	// the default signet params.
+	err = Register(&ChainParams{
+		Params:  &customSigNet,
+		TaroHRP: SigNetHRP,
+	})
+	require.NoError(t, err)
+
+	sigNet := ParamsForChain(chaincfg.SigNetParams.Name)
+	require.Equal(t, customSigNet.Net, sigNet.Net)
+	require.Equal(t, SigNetHRP, sigNet.TaroHRP)
+
+	net, err := Net(SigNetHRP)
+	require.NoError(t, err)
+	require.Equal(t, customSigNet.Net, net.Net)
+
+	// Registering the same network twice isn't allowed.
+	err = Register(&ChainParams{
+		Params:  &customSigNet,
+		TaroHRP: SigNetHRP,
+	})
+	require.ErrorIs(t, err, chaincfg.ErrDuplicateNet)
+}
@@ -98,9 +98,8 @@ type Genesis struct {
 	//
 	// NOTE: This is immutable for the lifetime of the asset.
 	//
-	// TODO: Would this usually be a JSON blob? It may be worth
-	// standardizing a schema subset for interoperability across wallets
-	// when displaying this metadata.
+	// NOTE: Metadata is either an opaque blob, or a typed metadata
+	// envelope that can be decoded with DecodeMeta.
 	Metadata []byte
 
 	// OutputIndex is the index of the output that carries the unique Taro
@@ -151,13 +150,43 @@ func (g Genesis) FamilyKeyTweak() []byte {
 	return keyFamBytes.Bytes()
 }
 
+// familySigDomain is the domain separation prefix of the message signed by a
+// family key, which makes sure a family key signature can't be mistaken for a
+// signature over any other kind of message.
+var familySigDomain = []byte("taro/family_sig")
+
+// FamilySigMessage returns the message that is signed by the family key of an
+// asset to link the asset's genesis to the family. The message commits to the
+// full genesis of the asset:
+//
+//	"taro/family_sig" || genesisOutPoint || sha256(tag) ||
+//	  sha256(metadata) || outputIndex || assetType
+func (g Genesis) FamilySigMessage() []byte {
+	tagHash := g.TagHash()
+	metadataHash := g.MetadataHash()
+
+	var msg bytes.Buffer
+	_, _ = msg.Write(familySigDomain)
+	_ = wire.WriteOutPoint(&msg, 0, 0, &g.FirstPrevOut)
+	_, _ = msg.Write(tagHash[:])
+	_, _ = msg.Write(metadataHash[:])
+	_ = binary.Write(&msg, binary.BigEndian, g.OutputIndex)
+	_ = binary.Write(&msg, binary.BigEndian, g.Type)
+	return msg.Bytes()
+}
+
+// FamilySigDigest returns the digest that is signed by the family key of an
+// asset, which is the SHA-256 hash of the FamilySigMessage.
+func (g Genesis) FamilySigDigest() [sha256.Size]byte {
+	return sha256.Sum256(g.FamilySigMessage())
+}
+
 // VerifySignature verifies the given signature that it is valid over the
-// asset's unique identifier with the given public key.
+// asset's full genesis with the given public key.
 func (g Genesis) VerifySignature(sig *schnorr.Signature,
 	pubKey *btcec.PublicKey) bool {
 
-	msg := g.ID()
-	digest := sha256.Sum256(msg[:])
+	digest := g.FamilySigDigest()
 	return sig.Verify(digest[:], pubKey)
 }
 
@@ -399,7 +428,8 @@ type FamilyKey struct {
 	//   familyInternalKey + sha256(familyInternalKey || genesisOutPoint) * G
 	FamKey btcec.PublicKey
 
-	// Sig is a signature over an asset's ID by `Key`.
+	// Sig is a signature over an asset's full genesis by FamKey, see
+	// Genesis.FamilySigMessage.
 	Sig schnorr.Signature
 }
 
@@ -425,6 +455,34 @@ func (f *FamilyKey) IsEqual(otherFamilyKey *FamilyKey) bool {
 		f.Sig.IsEqual(&otherFamilyKey.Sig)
 }
 
+// IsSameFamily returns true if this family key and the passed other family
+// key identify the same asset family. Unlike IsEqual, only the tweaked family
+// keys are compared, as assets of the same family that were issued separately
+// carry distinct signatures.
+func (f *FamilyKey) IsSameFamily(otherFamilyKey *FamilyKey) bool {
+	if f == nil || otherFamilyKey == nil {
+		return f == otherFamilyKey
+	}
+
+	return f.FamKey.IsEqual(&otherFamilyKey.FamKey)
+}
+
+// AssetFamily holds the information needed to issue additional assets into an
+// existing asset family.
+type AssetFamily struct {
+	// Genesis is the genesis of the first asset issued into the family.
+	// The family key is tweaked with this genesis, so it's needed to
+	// sign the genesis of any asset issued into the family later on.
+	Genesis Genesis
+
+	// RawKey is the raw family key before the tweak with the initial
+	// genesis has been applied.
+	RawKey keychain.KeyDescriptor
+
+	// FamKey is the tweaked family key shared by all assets of the family.
+	FamKey *btcec.PublicKey
+}
+
 // EqualKeyDescriptors returns true if the two key descriptors are equal.
 func EqualKeyDescriptors(a, o keychain.KeyDescriptor) bool {
 	if a.KeyLocator != o.KeyLocator {
@@ -500,9 +558,12 @@ func NewScriptKeyBIP0086(rawKey keychain.KeyDescriptor) ScriptKey {
 // for a given asset.
 type GenesisSigner interface {
 	// SignGenesis signs the passed Genesis description using the public
-	// key identified by the passed key descriptor. The final tweaked
-	// public key and the signature are returned.
-	SignGenesis(keychain.KeyDescriptor, Genesis) (*btcec.PublicKey,
+	// key identified by the passed key descriptor. The key is tweaked with
+	// the initial genesis of the asset family. If the current genesis is
+	// non-nil, then it is signed instead of the initial one, which is used
+	// to issue additional assets into an existing family. The final
+	// tweaked public key and the signature are returned.
+	SignGenesis(keychain.KeyDescriptor, Genesis, *Genesis) (*btcec.PublicKey,
 		*schnorr.Signature, error)
 }
 
@@ -521,29 +582,43 @@ func NewRawKeyGenesisSigner(priv *btcec.PrivateKey) *RawKeyGenesisSigner {
 }
 
 // SignGenesis signs the passed Genesis description using the public key
-// identified by the passed key descriptor. The final tweaked public key and
-// the signature are returned.
+// identified by the passed key descriptor. The key is tweaked with the initial
+// genesis, while the current genesis (if non-nil) is the one being signed.
+// The final tweaked public key and the signature are returned.
 func (r *RawKeyGenesisSigner) SignGenesis(keyDesc keychain.KeyDescriptor,
-	gen Genesis) (*btcec.PublicKey, *schnorr.Signature, error) {
+	initialGen Genesis, currentGen *Genesis) (*btcec.PublicKey,
+	*schnorr.Signature, error) {
 
 	if !keyDesc.PubKey.IsEqual(r.privKey.PubKey()) {
 		return nil, nil, fmt.Errorf("cannot sign with key")
 	}
 
 	tweakedPrivKey := txscript.TweakTaprootPrivKey(
-		r.privKey, gen.FamilyKeyTweak(),
+		*r.privKey, initialGen.FamilyKeyTweak(),
 	)
 
-	// TODO(roasbeef): this actually needs to sign the digest of the asset
-	// itself
-	id := gen.ID()
-	idHash := sha256.Sum256(id[:])
-	sig, err := schnorr.Sign(tweakedPrivKey, idHash[:])
+	gen := initialGen
+	if currentGen != nil {
+		gen = *currentGen
+	}
+
+	digest := gen.FamilySigDigest()
+	sig, err := schnorr.Sign(tweakedPrivKey, digest[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Family keys are only ever used in their x-only form on chain, so we
+	// always return the even variant of the tweaked key to keep the key
+	// we store and serialize independent of the parity of the tweak.
+	famKey, err := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedPrivKey.PubKey()),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return tweakedPrivKey.PubKey(), sig, nil
+	return famKey, sig, nil
 }
 
 // A compile-time assertion to ensure RawKeyGenesisSigner meets the
@@ -551,11 +626,14 @@ func (r *RawKeyGenesisSigner) SignGenesis(keyDesc keychain.KeyDescriptor,
 var _ GenesisSigner = (*RawKeyGenesisSigner)(nil)
 
 // DeriveFamilyKey derives an asset's family key based on an internal public
-// key descriptor key and an asset genesis.
+// key descriptor key and the initial genesis of the asset family. If the
+// current genesis is non-nil, then the resulting family key carries a
+// signature over that genesis instead, which links a newly issued asset to the
+// existing family.
 func DeriveFamilyKey(genSigner GenesisSigner, rawKey keychain.KeyDescriptor,
-	genesis Genesis) (*FamilyKey, error) {
+	initialGen Genesis, currentGen *Genesis) (*FamilyKey, error) {
 
-	famKey, sig, err := genSigner.SignGenesis(rawKey, genesis)
+	famKey, sig, err := genSigner.SignGenesis(rawKey, initialGen, currentGen)
 	if err != nil {
 		return nil, err
 	}
@@ -725,6 +803,72 @@ func (a *Asset) IsUnspendable() bool {
 		a.Amount == 0
 }
 
+// DeriveBurnKey derives a provably unspendable but unique script key by
+// tweaking the public NUMS key with a tap tweak that commits to the first
+// input of the asset being burned:
+//
+//	burnTweak = h_tapTweak(NUMSKey || outPoint || assetID || scriptKey)
+//	burnKey = NUMSKey + burnTweak*G
+//
+// As nobody knows the discrete log of the NUMS key, nobody can produce a valid
+// signature for the resulting key either.
+func DeriveBurnKey(firstPrevID PrevID) *btcec.PublicKey {
+	var b bytes.Buffer
+	_ = wire.WriteOutPoint(&b, 0, 0, &firstPrevID.OutPoint)
+	_, _ = b.Write(firstPrevID.ID[:])
+	_, _ = b.Write(firstPrevID.ScriptKey.SchnorrSerialized())
+
+	burnKey := txscript.ComputeTaprootOutputKey(NUMSPubKey, b.Bytes())
+
+	// Script keys are only ever encoded in their 32-byte x-only form, so
+	// we drop the parity information here to make sure the key matches
+	// the one found in a decoded asset.
+	burnKey, _ = schnorr.ParsePubKey(schnorr.SerializePubKey(burnKey))
+
+	return burnKey
+}
+
+// IsBurnKey returns true if the given script key is the burn key derived from
+// the previous input referenced by the given witness. If the witness is a
+// split commitment witness, then the first input of the split root asset is
+// used instead.
+func IsBurnKey(scriptKey *btcec.PublicKey, witness Witness) bool {
+	if scriptKey == nil {
+		return false
+	}
+
+	prevID := witness.PrevID
+	if witness.SplitCommitment != nil {
+		rootWitnesses := witness.SplitCommitment.RootAsset.PrevWitnesses
+		if len(rootWitnesses) == 0 {
+			return false
+		}
+
+		prevID = rootWitnesses[0].PrevID
+	}
+
+	// Genesis assets can't be burns, as there is no input to commit to.
+	if prevID == nil || *prevID == ZeroPrevID {
+		return false
+	}
+
+	return bytes.Equal(
+		schnorr.SerializePubKey(scriptKey),
+		schnorr.SerializePubKey(DeriveBurnKey(*prevID)),
+	)
+}
+
+// IsBurn returns true if an asset uses a burn script key that commits to the
+// first input of the asset, meaning the asset can provably never be spent
+// again.
+func (a *Asset) IsBurn() bool {
+	if len(a.PrevWitnesses) == 0 {
+		return false
+	}
+
+	return IsBurnKey(a.ScriptKey.PubKey, a.PrevWitnesses[0])
+}
+
 // Copy returns a deep copy of an Asset.
 func (a *Asset) Copy() *Asset {
 	assetCopy := *a
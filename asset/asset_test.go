@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"math"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -394,17 +395,142 @@ func TestAssetFamilyKey(t *testing.T) {
 	_ = wire.WriteOutPoint(&famBytes, 0, 0, &g.FirstPrevOut)
 	_, _ = famBytes.Write([]byte{0, 0, 0, 21, 1})
 
-	tweakedKey := txscript.TweakTaprootPrivKey(privKey, famBytes.Bytes())
+	tweakedKey := txscript.TweakTaprootPrivKey(*privKey, famBytes.Bytes())
 
-	// TweakTaprootPrivKey modifies the private key that is passed in! We
-	// need to provide a copy to arrive at the same result.
-	keyFam, err := DeriveFamilyKey(genSigner, fakeKeyDesc, g)
+	keyFam, err := DeriveFamilyKey(genSigner, fakeKeyDesc, g, nil)
 	require.NoError(t, err)
 
 	require.Equal(
 		t, schnorr.SerializePubKey(tweakedKey.PubKey()),
 		schnorr.SerializePubKey(&keyFam.FamKey),
 	)
+
+	// If we issue another asset into the same family, then the family key
+	// should stay the same, while the signature now commits to the genesis
+	// of the new asset.
+	newGen := g
+	newGen.FirstPrevOut.Index = 100
+	newGen.Tag = "normal asset 2"
+	newKeyFam, err := DeriveFamilyKey(genSigner, fakeKeyDesc, g, &newGen)
+	require.NoError(t, err)
+
+	require.True(t, keyFam.FamKey.IsEqual(&newKeyFam.FamKey))
+	require.True(t, newGen.VerifySignature(&newKeyFam.Sig, &keyFam.FamKey))
+	require.False(t, g.VerifySignature(&newKeyFam.Sig, &keyFam.FamKey))
+}
+
+// TestRawKeyGenesisSignerEvenFamilyKey tests that the raw key genesis signer
+// always returns the even variant of the tweaked family key, regardless of
+// the parity of the tweaked key, and that the signature is valid under it.
+func TestRawKeyGenesisSignerEvenFamilyKey(t *testing.T) {
+	t.Parallel()
+
+	g := Genesis{
+		FirstPrevOut: wire.OutPoint{
+			Hash:  hashBytes1,
+			Index: 99,
+		},
+		Tag:         "normal asset 1",
+		Metadata:    []byte{1, 2, 3},
+		OutputIndex: 21,
+		Type:        Normal,
+	}
+
+	// With random keys, the tweaked key will be odd about half of the
+	// time, so a handful of iterations cover both cases.
+	for i := 0; i < 20; i++ {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		genSigner := NewRawKeyGenesisSigner(privKey)
+		keyDesc := keychain.KeyDescriptor{
+			PubKey: privKey.PubKey(),
+		}
+
+		famKey, sig, err := genSigner.SignGenesis(keyDesc, g, nil)
+		require.NoError(t, err)
+
+		tweakedKey := txscript.ComputeTaprootOutputKey(
+			privKey.PubKey(), g.FamilyKeyTweak(),
+		)
+		require.Equal(
+			t, schnorr.SerializePubKey(tweakedKey),
+			schnorr.SerializePubKey(famKey),
+		)
+		require.Equal(
+			t, byte(0x02), famKey.SerializeCompressed()[0],
+		)
+		require.True(t, g.VerifySignature(sig, famKey))
+
+		// Signing again must not be affected by the first signature
+		// and yield the same family key.
+		famKey2, _, err := genSigner.SignGenesis(keyDesc, g, nil)
+		require.NoError(t, err)
+		require.True(t, famKey.IsEqual(famKey2))
+	}
+}
+
+// TestAssetBurnKey tests that the burn key is derived from the first input of
+// an asset and is only recognized for that input.
+func TestAssetBurnKey(t *testing.T) {
+	t.Parallel()
+
+	prevID := PrevID{
+		OutPoint: wire.OutPoint{
+			Hash:  hashBytes1,
+			Index: 1,
+		},
+		ID:        hashBytes2,
+		ScriptKey: ToSerialized(pubKey),
+	}
+	otherPrevID := prevID
+	otherPrevID.OutPoint.Index = 2
+
+	burnKey := DeriveBurnKey(prevID)
+	require.NotEqual(
+		t, schnorr.SerializePubKey(burnKey),
+		schnorr.SerializePubKey(NUMSPubKey),
+	)
+	require.NotEqual(
+		t, schnorr.SerializePubKey(burnKey),
+		schnorr.SerializePubKey(DeriveBurnKey(otherPrevID)),
+	)
+
+	genesis := Genesis{
+		FirstPrevOut: wire.OutPoint{
+			Hash:  hashBytes1,
+			Index: 99,
+		},
+		Tag:         "normal asset 1",
+		Metadata:    []byte{1, 2, 3},
+		OutputIndex: 21,
+		Type:        Normal,
+	}
+	burn, err := New(genesis, 10, 0, 0, NewScriptKey(burnKey), nil)
+	require.NoError(t, err)
+
+	// A genesis asset can never be a burn, as it has no input to commit
+	// to.
+	require.False(t, burn.IsBurn())
+
+	// Spending the input the key was derived from makes it a burn, any
+	// other input doesn't.
+	burn.PrevWitnesses[0].PrevID = &prevID
+	require.True(t, burn.IsBurn())
+
+	burn.PrevWitnesses[0].PrevID = &otherPrevID
+	require.False(t, burn.IsBurn())
+
+	// A split asset commits to the input of its split root.
+	rootAsset := burn.Copy()
+	rootAsset.PrevWitnesses[0].PrevID = &prevID
+	burn.PrevWitnesses[0] = Witness{
+		PrevID: &ZeroPrevID,
+		SplitCommitment: &SplitCommitment{
+			RootAsset: *rootAsset,
+		},
+	}
+	require.True(t, burn.IsBurn())
 }
 
 func FuzzAssetDecode(f *testing.F) {
@@ -416,3 +542,163 @@ func FuzzAssetDecode(f *testing.F) {
 		}
 	})
 }
+
+// TestAssetMeta tests that typed metadata survives an encode/decode round
+// trip and that metadata not matching its declared type is rejected.
+func TestAssetMeta(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		meta     Meta
+		validErr error
+	}{{
+		name: "opaque",
+		meta: Meta{
+			Type: MetaOpaque,
+			Data: []byte{1, 2, 3},
+		},
+	}, {
+		name: "json",
+		meta: Meta{
+			Type: MetaJSON,
+			Data: []byte(`{"name": "taro"}`),
+		},
+	}, {
+		name: "media",
+		meta: Meta{
+			Type:     MetaMedia,
+			MimeType: "image/png",
+			Data:     []byte{0x89, 0x50, 0x4e, 0x47},
+		},
+	}, {
+		name: "opaque with decimal display",
+		meta: Meta{
+			Type:           MetaOpaque,
+			Data:           []byte{1, 2, 3},
+			DecimalDisplay: 2,
+		},
+	}, {
+		name: "json with decimal display",
+		meta: Meta{
+			Type:           MetaJSON,
+			Data:           []byte(`{"name": "taro"}`),
+			DecimalDisplay: MaxDecimalDisplay,
+		},
+	}, {
+		name: "decimal display too large",
+		meta: Meta{
+			Type:           MetaOpaque,
+			DecimalDisplay: MaxDecimalDisplay + 1,
+		},
+		validErr: ErrInvalidDecimalDisplay,
+	}, {
+		name: "invalid json",
+		meta: Meta{
+			Type: MetaJSON,
+			Data: []byte(`{"name":`),
+		},
+		validErr: ErrInvalidMetaJSON,
+	}, {
+		name: "media without mime type",
+		meta: Meta{
+			Type: MetaMedia,
+			Data: []byte{1},
+		},
+		validErr: ErrInvalidMetaMime,
+	}, {
+		name: "media with invalid mime type",
+		meta: Meta{
+			Type:     MetaMedia,
+			MimeType: "image/",
+			Data:     []byte{1},
+		},
+		validErr: ErrInvalidMetaMime,
+	}, {
+		name: "empty media",
+		meta: Meta{
+			Type:     MetaMedia,
+			MimeType: "image/png",
+		},
+		validErr: ErrEmptyMetaMedia,
+	}, {
+		name: "json with mime type",
+		meta: Meta{
+			Type:     MetaJSON,
+			MimeType: "application/json",
+			Data:     []byte(`{}`),
+		},
+		validErr: ErrInvalidMetaMime,
+	}, {
+		name: "opaque with magic prefix",
+		meta: Meta{
+			Type: MetaOpaque,
+			Data: append([]byte("taro_meta"), 1, 2, 3),
+		},
+		validErr: ErrMetaMagicPrefix,
+	}, {
+		name: "unknown type",
+		meta: Meta{
+			Type: 3,
+			Data: []byte{1},
+		},
+		validErr: ErrInvalidMetaType,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.meta.Validate()
+			require.ErrorIs(t, err, testCase.validErr)
+			if testCase.validErr != nil {
+				return
+			}
+
+			metadata, err := testCase.meta.Encode()
+			require.NoError(t, err)
+
+			genesis := Genesis{Metadata: metadata}
+			require.Equal(t, &testCase.meta, genesis.Meta())
+		})
+	}
+
+	// Metadata that only looks like an envelope is treated as opaque, so
+	// it's rejected at mint time.
+	malformed := append([]byte("taro_meta"), 0xff)
+	meta := DecodeMeta(malformed)
+	require.Equal(t, MetaOpaque, meta.Type)
+	require.ErrorIs(t, meta.Validate(), ErrMetaMagicPrefix)
+}
+
+// TestDisplayAmount tests that raw asset amounts are formatted with the
+// declared number of decimal places.
+func TestDisplayAmount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		amt            uint64
+		decimalDisplay uint32
+		display        string
+	}{
+		{amt: 1234, decimalDisplay: 0, display: "1234"},
+		{amt: 1234, decimalDisplay: 2, display: "12.34"},
+		{amt: 1200, decimalDisplay: 2, display: "12.00"},
+		{amt: 1234, decimalDisplay: 4, display: "0.1234"},
+		{amt: 5, decimalDisplay: 3, display: "0.005"},
+		{amt: 0, decimalDisplay: 2, display: "0.00"},
+		{
+			amt:            math.MaxUint64,
+			decimalDisplay: MaxDecimalDisplay,
+			display:        "18.446744073709551615",
+		},
+	}
+
+	for _, testCase := range testCases {
+		require.Equal(
+			t, testCase.display, DisplayAmount(
+				testCase.amt, testCase.decimalDisplay,
+			),
+		)
+	}
+}
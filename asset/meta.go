@@ -0,0 +1,274 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// metaMagic is the prefix that marks an asset's metadata as a typed
+	// metadata envelope. Metadata that doesn't start with this prefix is
+	// treated as an opaque blob.
+	metaMagic = []byte("taro_meta")
+
+	// ErrInvalidMetaType is returned when metadata is declared with an
+	// unknown metadata type.
+	ErrInvalidMetaType = errors.New("meta: unknown metadata type")
+
+	// ErrInvalidMetaJSON is returned when metadata declared as JSON isn't
+	// a valid JSON document.
+	ErrInvalidMetaJSON = errors.New("meta: invalid JSON metadata")
+
+	// ErrInvalidMetaMime is returned when media metadata has a missing or
+	// malformed MIME type.
+	ErrInvalidMetaMime = errors.New("meta: invalid MIME type")
+
+	// ErrEmptyMetaMedia is returned when media metadata doesn't carry any
+	// data.
+	ErrEmptyMetaMedia = errors.New("meta: media metadata cannot be empty")
+
+	// ErrMetaMagicPrefix is returned when opaque metadata starts with the
+	// typed metadata prefix, which would make it ambiguous to decode.
+	ErrMetaMagicPrefix = errors.New("meta: opaque metadata cannot start " +
+		"with the typed metadata prefix")
+
+	// ErrInvalidDecimalDisplay is returned when metadata declares more
+	// decimal places than supported.
+	ErrInvalidDecimalDisplay = fmt.Errorf("meta: decimal display cannot "+
+		"exceed %d", MaxDecimalDisplay)
+)
+
+const (
+	// MaxDecimalDisplay is the maximum number of decimal places an asset
+	// can declare for displaying its amounts.
+	MaxDecimalDisplay = 18
+)
+
+// MetaType is the type of the metadata committed to in an asset's genesis.
+type MetaType uint8
+
+const (
+	// MetaOpaque is metadata without any known structure. This is the
+	// type of all metadata that isn't wrapped in a typed envelope.
+	MetaOpaque MetaType = 0
+
+	// MetaJSON is metadata that is a valid JSON document.
+	MetaJSON MetaType = 1
+
+	// MetaMedia is metadata that is a media file of the attached MIME
+	// type, for example an image.
+	MetaMedia MetaType = 2
+)
+
+// String returns a human readable string for the metadata type.
+func (t MetaType) String() string {
+	switch t {
+	case MetaOpaque:
+		return "opaque"
+	case MetaJSON:
+		return "json"
+	case MetaMedia:
+		return "media"
+	default:
+		return fmt.Sprintf("<unknown meta type %d>", uint8(t))
+	}
+}
+
+// MetaTlvType represents the different TLV types for the records of a typed
+// metadata envelope.
+type MetaTlvType = tlv.Type
+
+const (
+	MetaEnvelopeType           MetaTlvType = 0
+	MetaEnvelopeMimeType       MetaTlvType = 1
+	MetaEnvelopeData           MetaTlvType = 2
+	MetaEnvelopeDecimalDisplay MetaTlvType = 3
+)
+
+// Meta is the decoded form of an asset's metadata. Typed metadata is
+// serialized as a TLV envelope behind a magic prefix, while opaque metadata
+// is serialized as is, which keeps existing metadata valid.
+type Meta struct {
+	// Type is the type of the metadata.
+	Type MetaType
+
+	// MimeType is the MIME type of the data. This is only set for media
+	// metadata.
+	MimeType string
+
+	// Data is the raw metadata.
+	Data []byte
+
+	// DecimalDisplay is the number of decimal places the amounts of the
+	// asset should be displayed with. An asset with a decimal display of
+	// 2 and an amount of 1234 units is displayed as 12.34.
+	DecimalDisplay uint32
+}
+
+// Validate returns an error if the metadata doesn't match its declared type.
+func (m *Meta) Validate() error {
+	// Only media metadata carries a MIME type.
+	if m.Type != MetaMedia && m.MimeType != "" {
+		return fmt.Errorf("%w: MIME type set for %v metadata",
+			ErrInvalidMetaMime, m.Type)
+	}
+
+	if m.DecimalDisplay > MaxDecimalDisplay {
+		return ErrInvalidDecimalDisplay
+	}
+
+	switch m.Type {
+	case MetaOpaque:
+		if bytes.HasPrefix(m.Data, metaMagic) {
+			return ErrMetaMagicPrefix
+		}
+
+	case MetaJSON:
+		if !json.Valid(m.Data) {
+			return ErrInvalidMetaJSON
+		}
+
+	case MetaMedia:
+		if m.MimeType == "" {
+			return ErrInvalidMetaMime
+		}
+		if _, _, err := mime.ParseMediaType(m.MimeType); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidMetaMime, err)
+		}
+		if len(m.Data) == 0 {
+			return ErrEmptyMetaMedia
+		}
+
+	default:
+		return fmt.Errorf("%w: %d", ErrInvalidMetaType, uint8(m.Type))
+	}
+
+	return nil
+}
+
+// Encode serializes the metadata into the form that's committed to in an
+// asset's genesis. Opaque metadata is only wrapped in an envelope if it needs
+// to commit to a decimal display.
+func (m *Meta) Encode() ([]byte, error) {
+	switch m.Type {
+	case MetaOpaque:
+		if m.DecimalDisplay == 0 {
+			return m.Data, nil
+		}
+
+	case MetaJSON, MetaMedia:
+
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMetaType,
+			uint8(m.Type))
+	}
+
+	metaType := uint8(m.Type)
+	mimeType := []byte(m.MimeType)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(MetaEnvelopeType, &metaType),
+	}
+	if len(mimeType) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			MetaEnvelopeMimeType, &mimeType,
+		))
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		MetaEnvelopeData, &m.Data,
+	))
+	if m.DecimalDisplay > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			MetaEnvelopeDecimalDisplay, &m.DecimalDisplay,
+		))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Write(metaMagic)
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeMeta decodes the metadata of an asset genesis. Metadata that isn't a
+// well formed typed envelope is returned as opaque metadata.
+func DecodeMeta(metadata []byte) *Meta {
+	opaque := &Meta{
+		Type: MetaOpaque,
+		Data: metadata,
+	}
+	if !bytes.HasPrefix(metadata, metaMagic) {
+		return opaque
+	}
+
+	var (
+		metaType       uint8
+		mimeType       []byte
+		data           []byte
+		decimalDisplay uint32
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(MetaEnvelopeType, &metaType),
+		tlv.MakePrimitiveRecord(MetaEnvelopeMimeType, &mimeType),
+		tlv.MakePrimitiveRecord(MetaEnvelopeData, &data),
+		tlv.MakePrimitiveRecord(
+			MetaEnvelopeDecimalDisplay, &decimalDisplay,
+		),
+	)
+	if err != nil {
+		return opaque
+	}
+
+	r := bytes.NewReader(metadata[len(metaMagic):])
+	if err := stream.Decode(r); err != nil {
+		return opaque
+	}
+
+	meta := &Meta{
+		Type:           MetaType(metaType),
+		MimeType:       string(mimeType),
+		Data:           data,
+		DecimalDisplay: decimalDisplay,
+	}
+	if meta.Validate() != nil {
+		return opaque
+	}
+
+	return meta
+}
+
+// Meta returns the decoded metadata of the genesis.
+func (g Genesis) Meta() *Meta {
+	return DecodeMeta(g.Metadata)
+}
+
+// DisplayAmount formats the passed amount of raw asset units as a decimal
+// string with the given number of decimal places.
+func DisplayAmount(amt uint64, decimalDisplay uint32) string {
+	units := strconv.FormatUint(amt, 10)
+	if decimalDisplay == 0 {
+		return units
+	}
+
+	// Pad the units with leading zeros so there's always at least one
+	// digit in front of the decimal point.
+	places := int(decimalDisplay)
+	if len(units) <= places {
+		units = strings.Repeat("0", places-len(units)+1) + units
+	}
+
+	return units[:len(units)-places] + "." + units[len(units)-places:]
+}
@@ -36,6 +36,7 @@ func RandFamilyKey(t testing.TB, genesis *Genesis) *FamilyKey {
 
 	familyKey, err := DeriveFamilyKey(
 		genSigner, test.PubToKeyDesc(privateKey.PubKey()), *genesis,
+		nil,
 	)
 	require.NoError(t, err)
 	return familyKey
@@ -55,6 +55,14 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}, errChan, nil
 }
 
+// RegisterBlockEpochNtfn registers an intent to be notified of the height of
+// each new block that is connected to the main chain.
+func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
+	ctx context.Context) (chan int32, chan error, error) {
+
+	return l.lnd.ChainNotifier.RegisterBlockEpochNtfn(ctx)
+}
+
 // CurrentHeight return the current height of the main chain.
 func (l *LndRpcChainBridge) CurrentHeight(ctx context.Context) (uint32, error) {
 	info, err := l.lnd.Client.GetInfo(ctx)
@@ -3,10 +3,13 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
 )
 
@@ -21,6 +24,10 @@ var addrCommands = []cli.Command{
 			queryAddrsCommand,
 			decodeAddrCommand,
 			receivesAddrCommand,
+			subscribeReceivesCommand,
+			exportAddrsCommand,
+			importAddrsCommand,
+			rescanAddrsCommand,
 		},
 	},
 }
@@ -31,6 +38,20 @@ const (
 	keyFamName = "key_fam"
 
 	amtName = "amt"
+
+	expiryName = "expiry"
+
+	maxReceivesName = "max_receives"
+
+	labelName = "label"
+
+	tagName = "tag"
+
+	failedOnlyName = "failed_only"
+
+	statusName = "status"
+
+	includeExistingName = "include_existing"
 )
 
 var newAddrCommand = cli.Command{
@@ -49,8 +70,29 @@ var newAddrCommand = cli.Command{
 			Usage: "optional, the key family of the asset to receive",
 		},
 		cli.Uint64Flag{
-			Name:  amtName,
-			Usage: "the amt of the asset to receive",
+			Name: amtName,
+			Usage: "the amt of the asset to receive, leave empty " +
+				"for an addr that accepts any amt",
+		},
+		cli.StringFlag{
+			Name: expiryName,
+			Usage: "optional, a duration short hand (1h, 24h, etc) " +
+				"after which the address no longer accepts " +
+				"transfers",
+		},
+		cli.Uint64Flag{
+			Name: maxReceivesName,
+			Usage: "optional, the max number of transfers the " +
+				"address accepts, 1 for a single-use address",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "optional, a label that is only stored locally",
+		},
+		cli.StringSliceFlag{
+			Name: tagName,
+			Usage: "optional, a tag in the form key=value that is " +
+				"only stored locally, can be set multiple times",
 		},
 	},
 	Action: newAddr,
@@ -77,10 +119,33 @@ func newAddr(ctx *cli.Context) error {
 		return fmt.Errorf("unable to decode key fam: %w", err)
 	}
 
+	var expiry int64
+	if ctx.IsSet(expiryName) {
+		expiryOffset, err := time.ParseDuration(ctx.String(expiryName))
+		if err != nil {
+			return fmt.Errorf("unable to parse expiry: %w", err)
+		}
+		expiry = time.Now().Add(expiryOffset).Unix()
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range ctx.StringSlice(tagName) {
+		key, value := parseTag(tag)
+		if key == "" {
+			return fmt.Errorf("invalid tag %q, must be in the "+
+				"form key=value", tag)
+		}
+		tags[key] = value
+	}
+
 	addr, err := client.NewAddr(ctxc, &tarorpc.NewAddrRequest{
 		GenesisBootstrapInfo: genInfo,
 		FamKey:               keyFam,
 		Amt:                  ctx.Int64(amtName),
+		Expiry:               expiry,
+		MaxReceives:          uint32(ctx.Uint64(maxReceivesName)),
+		Label:                ctx.String(labelName),
+		Tags:                 tags,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addr: %w", err)
@@ -90,6 +155,17 @@ func newAddr(ctx *cli.Context) error {
 	return nil
 }
 
+// parseTag splits a tag of the form key=value into its key and value. If the
+// tag doesn't contain a value, the whole tag is returned as the key.
+func parseTag(tag string) (string, string) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
 const (
 	createdAfterName = "created_after"
 
@@ -98,6 +174,10 @@ const (
 	limitName = "limit"
 
 	offsetName = "offset"
+
+	expiredOnlyName = "expired_only"
+
+	activeOnlyName = "active_only"
 )
 
 var queryAddrsCommand = cli.Command{
@@ -122,6 +202,24 @@ var queryAddrsCommand = cli.Command{
 			Name:  offsetName,
 			Usage: "the number of addrs to skip before returning the first addr",
 		},
+		cli.BoolFlag{
+			Name: expiredOnlyName,
+			Usage: "only return addrs that no longer accept " +
+				"transfers",
+		},
+		cli.BoolFlag{
+			Name:  activeOnlyName,
+			Usage: "only return addrs that still accept transfers",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "only return addrs with this label",
+		},
+		cli.StringFlag{
+			Name: tagName,
+			Usage: "only return addrs with this tag, either in " +
+				"the form key to match any value or key=value",
+		},
 	},
 	Action: queryAddr,
 }
@@ -152,11 +250,18 @@ func queryAddr(ctx *cli.Context) error {
 		start = endTime.Unix()
 	}
 
+	tagKey, tagValue := parseTag(ctx.String(tagName))
+
 	addrs, err := client.QueryAddrs(ctxc, &tarorpc.QueryAddrRequest{
 		CreatedAfter:  start,
 		CreatedBefore: int64(end),
 		Limit:         int32(ctx.Int64(limitName)),
 		Offset:        int32(ctx.Int64(offsetName)),
+		ExpiredOnly:   ctx.Bool(expiredOnlyName),
+		Label:         ctx.String(labelName),
+		TagKey:        tagKey,
+		TagValue:      tagValue,
+		ActiveOnly:    ctx.Bool(activeOnlyName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addrs: %v", err)
@@ -221,6 +326,10 @@ var receivesAddrCommand = cli.Command{
 			Name:  addrName,
 			Usage: "show transfers of a single address only",
 		},
+		cli.BoolFlag{
+			Name:  failedOnlyName,
+			Usage: "show failed transfers only",
+		},
 	},
 	Action: addrReceives,
 }
@@ -241,6 +350,7 @@ func addrReceives(ctx *cli.Context) error {
 
 	resp, err := client.AddrReceives(ctxc, &tarorpc.AddrReceivesRequest{
 		FilterAddr: addr,
+		FailedOnly: ctx.Bool(failedOnlyName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to query addr receives: %w", err)
@@ -249,3 +359,202 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var subscribeReceivesCommand = cli.Command{
+	Name:      "subscribe",
+	ShortName: "s",
+	Usage:     "Subscribe to inbound asset transfers",
+	Description: "Stream status changes of inbound asset transfers as " +
+		"they happen, the same state of a transfer might be shown " +
+		"more than once",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "show transfers of a single address only",
+		},
+		cli.StringFlag{
+			Name: statusName,
+			Usage: "show transfers with this status only, for " +
+				"example 'completed' or 'proof_invalid'",
+		},
+		cli.BoolFlag{
+			Name: includeExistingName,
+			Usage: "also show all existing transfers that match " +
+				"the filters",
+		},
+	},
+	Action: subscribeReceives,
+}
+
+func subscribeReceives(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.SubscribeReceiveEventsRequest{
+		FilterAddr:      ctx.String(addrName),
+		IncludeExisting: ctx.Bool(includeExistingName),
+	}
+	if ctx.IsSet(statusName) {
+		statusStr := "ADDR_EVENT_STATUS_" + strings.ToUpper(
+			ctx.String(statusName),
+		)
+		status, ok := tarorpc.AddrEventStatus_value[statusStr]
+		if !ok {
+			return fmt.Errorf("unknown status: %v",
+				ctx.String(statusName))
+		}
+		req.FilterStatus = tarorpc.AddrEventStatus(status)
+	}
+
+	stream, err := client.SubscribeReceiveEvents(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to receives: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to receive event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+const (
+	addrFileName = "addr_file"
+
+	startHeightName = "start_height"
+)
+
+var exportAddrsCommand = cli.Command{
+	Name:      "export",
+	ShortName: "e",
+	Usage:     "Export all Taro addresses to a file",
+	Description: "Export all Taro addresses, including the key " +
+		"information needed to re-derive their keys, to an address " +
+		"export file that can be imported into another tarod instance " +
+		"using the same lnd seed",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: addrFileName,
+			Usage: "the file to write the address export file " +
+				"to; use the dash character (-) to write the " +
+				"raw binary file to stdout",
+		},
+	},
+	Action: exportAddrs,
+}
+
+func exportAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(addrFileName) == "":
+		_ = cli.ShowCommandHelp(ctx, "export")
+		return nil
+	}
+
+	resp, err := client.ExportAddrs(ctxc, &tarorpc.ExportAddrsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to export addrs: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(addrFileName))
+	return writeToFile(filePath, resp.AddrFile)
+}
+
+var importAddrsCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "Import Taro addresses from a file",
+	Description: "Import the Taro addresses of an address export file " +
+		"and watch them for incoming asset transfers, addresses " +
+		"that are already known are skipped",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: addrFileName,
+			Usage: "the path to the address export file on disk; " +
+				"use the dash character (-) to read from " +
+				"stdin instead",
+		},
+	},
+	Action: importAddrs,
+}
+
+func importAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(addrFileName) == "":
+		_ = cli.ShowCommandHelp(ctx, "import")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(addrFileName))
+	addrFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read file: %w", err)
+	}
+
+	resp, err := client.ImportAddrs(ctxc, &tarorpc.ImportAddrsRequest{
+		AddrFile: addrFile,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import addrs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rescanAddrsCommand = cli.Command{
+	Name:  "rescan",
+	Usage: "Rescan the wallet for inbound asset transfers",
+	Description: "Replay all wallet transactions starting at the given " +
+		"block height and look for inbound asset transfers to our " +
+		"Taro addresses that were missed before, transfers that " +
+		"are already known are left untouched",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  startHeightName,
+			Usage: "the block height to start the rescan at",
+		},
+	},
+	Action: rescanAddrs,
+}
+
+func rescanAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.RescanAddrs(ctxc, &tarorpc.RescanAddrsRequest{
+		StartHeight: uint32(ctx.Uint64(startHeightName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rescan addrs: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to rescan addrs: %w", err)
+		}
+
+		printRespJSON(resp)
+	}
+}
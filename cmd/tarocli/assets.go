@@ -1,8 +1,17 @@
 package main
 
 import (
+	"encoding/csv"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/lightninglabs/taro/tarorpc"
 	"github.com/urfave/cli"
@@ -16,23 +25,54 @@ var assetsCommands = []cli.Command{
 		Category:  "Assets",
 		Subcommands: []cli.Command{
 			mintAssetCommand,
+			mintBatchCommand,
+			listBatchesCommand,
+			listSeedlingsCommand,
+			cancelSeedlingCommand,
+			cancelBatchCommand,
+			finalizeBatchCommand,
 			listAssetsCommand,
+			listUtxosCommand,
+			listLeasesCommand,
+			releaseLeaseCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			estimateSendCommand,
 			listTransfersCommand,
+			burnAssetsCommand,
+			listBurnsCommand,
+			assetHistoryCommand,
+			fetchMetaCommand,
 		},
 	},
 }
 
 var (
-	assetTypeName     = "type"
-	assetTagName      = "name"
-	assetSupplyName   = "supply"
-	assetMetaName     = "meta"
-	assetEmissionName = "enable_emission"
-	skipBatchName     = "skip_batch"
-	groupByFamilyName = "by_family"
-	assetIDName       = "asset_id"
+	assetTypeName      = "type"
+	assetTagName       = "name"
+	assetSupplyName    = "supply"
+	assetMetaName      = "meta"
+	assetMetaFileName  = "meta_file"
+	assetMetaTypeName  = "meta_type"
+	assetMimeTypeName  = "mime_type"
+	decimalDisplayName = "decimal_display"
+	metaHashName       = "meta_hash"
+	assetEmissionName  = "enable_emission"
+	skipBatchName      = "skip_batch"
+	groupByFamilyName  = "by_family"
+	assetIDName        = "asset_id"
+	burnAmountName     = "amount"
+	anchorOutpointName = "anchor_outpoint"
+	confirmedOnlyName  = "confirmed_only"
+	unconfOnlyName     = "unconfirmed_only"
+	minAmountName      = "min_amount"
+	includeSpentName   = "include_spent"
+	coinSelectName     = "coin_select"
+	inputOutpointName  = "input_outpoint"
+	outpointName       = "outpoint"
+	batchKeyName       = "batch_key"
+	feeRateName        = "fee_rate"
+	manifestFileName   = "file"
 )
 
 var mintAssetCommand = cli.Command{
@@ -57,6 +97,27 @@ var mintAssetCommand = cli.Command{
 			Name:  assetMetaName,
 			Usage: "the metadata associated with the asset",
 		},
+		cli.StringFlag{
+			Name: assetMetaFileName,
+			Usage: "the path to a file that contains the metadata " +
+				"associated with the asset, replaces --meta",
+		},
+		cli.StringFlag{
+			Name: assetMetaTypeName,
+			Usage: "the type of the metadata, must either be: " +
+				"opaque, json, or media",
+			Value: "opaque",
+		},
+		cli.StringFlag{
+			Name: assetMimeTypeName,
+			Usage: "the MIME type of the metadata, required for " +
+				"media metadata",
+		},
+		cli.Uint64Flag{
+			Name: decimalDisplayName,
+			Usage: "the number of decimal places the amounts of " +
+				"the asset should be displayed with",
+		},
 		cli.BoolFlag{
 			Name: assetEmissionName,
 			Usage: "if true, then the asset supports on going " +
@@ -66,19 +127,37 @@ var mintAssetCommand = cli.Command{
 			Name:  skipBatchName,
 			Usage: "if true, then the asset will be minted immediately",
 		},
+		cli.StringFlag{
+			Name: keyFamName,
+			Usage: "the family key of an existing asset family to " +
+				"issue the new asset into",
+		},
 	},
 	Action: mintAsset,
 }
 
-func parseAssetType(ctx *cli.Context) tarorpc.AssetType {
+func parseAssetType(assetTypeStr string) tarorpc.AssetType {
 	assetType := tarorpc.AssetType_NORMAL
-	if ctx.String(assetTypeName) == "collectible" {
+	if assetTypeStr == "collectible" {
 		assetType = tarorpc.AssetType_COLLECTIBLE
 	}
 
 	return assetType
 }
 
+func parseMetaType(metaTypeStr string) (tarorpc.AssetMetaType, error) {
+	switch metaTypeStr {
+	case "opaque":
+		return tarorpc.AssetMetaType_META_TYPE_OPAQUE, nil
+	case "json":
+		return tarorpc.AssetMetaType_META_TYPE_JSON, nil
+	case "media":
+		return tarorpc.AssetMetaType_META_TYPE_MEDIA, nil
+	default:
+		return 0, fmt.Errorf("unknown meta type: %v", metaTypeStr)
+	}
+}
+
 func mintAsset(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
@@ -92,13 +171,35 @@ func mintAsset(ctx *cli.Context) error {
 		return nil
 	}
 
+	famKey, err := hex.DecodeString(ctx.String(keyFamName))
+	if err != nil {
+		return fmt.Errorf("invalid family key: %w", err)
+	}
+
+	metaType, err := parseMetaType(ctx.String(assetMetaTypeName))
+	if err != nil {
+		return err
+	}
+
+	metaData := []byte(ctx.String(assetMetaName))
+	if ctx.IsSet(assetMetaFileName) {
+		metaData, err = ioutil.ReadFile(ctx.String(assetMetaFileName))
+		if err != nil {
+			return fmt.Errorf("unable to read meta file: %w", err)
+		}
+	}
+
 	resp, err := client.MintAsset(ctxc, &tarorpc.MintAssetRequest{
-		AssetType:      parseAssetType(ctx),
+		AssetType:      parseAssetType(ctx.String(assetTypeName)),
 		Name:           ctx.String(assetTagName),
-		MetaData:       []byte(ctx.String(assetMetaName)),
+		MetaData:       metaData,
 		Amount:         ctx.Int64(assetSupplyName),
 		EnableEmission: ctx.Bool(assetEmissionName),
 		SkipBatch:      ctx.Bool(skipBatchName),
+		FamilyKey:      famKey,
+		MetaType:       metaType,
+		MetaMimeType:   ctx.String(assetMimeTypeName),
+		DecimalDisplay: uint32(ctx.Uint64(decimalDisplayName)),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -108,12 +209,445 @@ func mintAsset(ctx *cli.Context) error {
 	return nil
 }
 
+var mintBatchCommand = cli.Command{
+	Name:  "mint-batch",
+	Usage: "mint a set of new assets from a manifest file",
+	Description: `
+	Atomically queue a set of new assets to be minted in the pending
+	batch. The assets are read from a manifest file, which is either a
+	JSON file containing a list of assets, or a CSV file with a header
+	row. Each asset supports the following fields, named after the
+	flags of the mint command:
+
+		type, name, supply, meta, meta_type, mime_type,
+		decimal_display, enable_emission, skip_batch, key_fam
+
+	All assets are validated first, if any of them is invalid then none
+	of them are queued and the reason for each invalid asset is shown.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: manifestFileName,
+			Usage: "the path to the manifest file, must have " +
+				"either a .json or a .csv extension",
+		},
+	},
+	Action: mintBatch,
+}
+
+// mintManifestAsset is a single asset of a minting manifest file.
+type mintManifestAsset struct {
+	Type           string `json:"type"`
+	Name           string `json:"name"`
+	Supply         int64  `json:"supply"`
+	Meta           string `json:"meta"`
+	MetaType       string `json:"meta_type"`
+	MimeType       string `json:"mime_type"`
+	DecimalDisplay uint32 `json:"decimal_display"`
+	EnableEmission bool   `json:"enable_emission"`
+	SkipBatch      bool   `json:"skip_batch"`
+	FamilyKey      string `json:"key_fam"`
+}
+
+// parseCSVManifest parses the assets of a CSV manifest file. The first row of
+// the file is a header that names the field of each column.
+func parseCSVManifest(r io.Reader) ([]*mintManifestAsset, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row")
+	}
+
+	header := records[0]
+	assets := make([]*mintManifestAsset, 0, len(records)-1)
+	for i, record := range records[1:] {
+		var a mintManifestAsset
+		for j, value := range record {
+			err := setManifestField(&a, header[j], value)
+			if err != nil {
+				// The first record is the header, so the
+				// asset on row i is on line i+2.
+				return nil, fmt.Errorf("line %d: %w", i+2, err)
+			}
+		}
+
+		assets = append(assets, &a)
+	}
+
+	return assets, nil
+}
+
+// setManifestField sets the field of the manifest asset with the given name.
+func setManifestField(a *mintManifestAsset, field, value string) error {
+	var err error
+	switch field {
+	case assetTypeName:
+		a.Type = value
+	case assetTagName:
+		a.Name = value
+	case assetSupplyName:
+		a.Supply, err = strconv.ParseInt(value, 10, 64)
+	case assetMetaName:
+		a.Meta = value
+	case assetMetaTypeName:
+		a.MetaType = value
+	case assetMimeTypeName:
+		a.MimeType = value
+	case decimalDisplayName:
+		var decimalDisplay uint64
+		decimalDisplay, err = strconv.ParseUint(value, 10, 32)
+		a.DecimalDisplay = uint32(decimalDisplay)
+	case assetEmissionName:
+		a.EnableEmission, err = parseManifestBool(value)
+	case skipBatchName:
+		a.SkipBatch, err = parseManifestBool(value)
+	case keyFamName:
+		a.FamilyKey = value
+	default:
+		return fmt.Errorf("unknown field: %v", field)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %v: %w", field, err)
+	}
+
+	return nil
+}
+
+// parseManifestBool parses a boolean field of a CSV manifest, an empty field
+// is treated as false.
+func parseManifestBool(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+// parseMintManifest reads the assets of the manifest file at the given path,
+// the format of the file is determined by its extension.
+func parseMintManifest(path string) ([]*mintManifestAsset, error) {
+	manifestFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer manifestFile.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var assets []*mintManifestAsset
+		err := json.NewDecoder(manifestFile).Decode(&assets)
+		if err != nil {
+			return nil, err
+		}
+
+		return assets, nil
+
+	case ".csv":
+		return parseCSVManifest(manifestFile)
+
+	default:
+		return nil, fmt.Errorf("unknown manifest file extension: %v",
+			filepath.Ext(path))
+	}
+}
+
+// marshalManifestAsset converts a manifest asset into a mint request.
+func marshalManifestAsset(
+	a *mintManifestAsset) (*tarorpc.MintAssetRequest, error) {
+
+	famKey, err := hex.DecodeString(a.FamilyKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid family key: %w", err)
+	}
+
+	// Just like for the mint command, we default to opaque metadata.
+	metaTypeStr := a.MetaType
+	if metaTypeStr == "" {
+		metaTypeStr = "opaque"
+	}
+	metaType, err := parseMetaType(metaTypeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tarorpc.MintAssetRequest{
+		AssetType:      parseAssetType(a.Type),
+		Name:           a.Name,
+		MetaData:       []byte(a.Meta),
+		Amount:         a.Supply,
+		EnableEmission: a.EnableEmission,
+		SkipBatch:      a.SkipBatch,
+		FamilyKey:      famKey,
+		MetaType:       metaType,
+		MetaMimeType:   a.MimeType,
+		DecimalDisplay: a.DecimalDisplay,
+	}, nil
+}
+
+func mintBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(manifestFileName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "mint-batch")
+		return nil
+	}
+
+	manifestAssets, err := parseMintManifest(ctx.String(manifestFileName))
+	if err != nil {
+		return fmt.Errorf("unable to parse manifest: %w", err)
+	}
+
+	req := &tarorpc.MintAssetBatchRequest{
+		Assets: make([]*tarorpc.MintAssetRequest, len(manifestAssets)),
+	}
+	for i, manifestAsset := range manifestAssets {
+		req.Assets[i], err = marshalManifestAsset(manifestAsset)
+		if err != nil {
+			return fmt.Errorf("invalid asset %d (%v): %w", i,
+				manifestAsset.Name, err)
+		}
+	}
+
+	resp, err := client.MintAssetBatch(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to mint assets: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	if len(resp.Errors) != 0 {
+		return fmt.Errorf("%d invalid asset(s), no assets were queued",
+			len(resp.Errors))
+	}
+
+	return nil
+}
+
+var listBatchesCommand = cli.Command{
+	Name:  "batches",
+	Usage: "list minting batches",
+	Description: "list the minting batches of the daemon, including " +
+		"the assets they create, their state and their genesis " +
+		"transaction",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "if set, only the batch with this key is listed",
+		},
+	},
+	Action: listBatches,
+}
+
+func listBatches(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	resp, err := client.ListBatches(ctxc, &tarorpc.ListBatchesRequest{
+		BatchKey: batchKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listSeedlingsCommand = cli.Command{
+	Name:  "seedlings",
+	Usage: "list queued asset seedlings",
+	Description: "list the asset seedlings that are queued for minting, " +
+		"but aren't yet part of a funded genesis transaction",
+	Action: listSeedlings,
+}
+
+func listSeedlings(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.ListSeedlingsRequest{}
+	resp, err := client.ListSeedlings(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list seedlings: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelSeedlingCommand = cli.Command{
+	Name:  "cancelseedling",
+	Usage: "cancel a queued asset seedling",
+	Description: "remove a seedling from the pending minting batch, if " +
+		"it's the last seedling of the batch, the whole batch is " +
+		"cancelled",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name of the seedling to cancel",
+		},
+	},
+	Action: cancelSeedling,
+}
+
+func cancelSeedling(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(assetTagName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "cancelseedling")
+		return nil
+	}
+
+	resp, err := client.CancelSeedling(ctxc, &tarorpc.CancelSeedlingRequest{
+		Name: ctx.String(assetTagName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel seedling: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelBatchCommand = cli.Command{
+	Name:  "cancelbatch",
+	Usage: "cancel a minting batch",
+	Description: "cancel a minting batch whose genesis transaction " +
+		"hasn't been broadcast yet, and release the wallet inputs " +
+		"locked for it",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the key of the batch to cancel, if not " +
+				"set the pending batch is cancelled",
+		},
+	},
+	Action: cancelBatch,
+}
+
+func cancelBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	resp, err := client.CancelBatch(ctxc, &tarorpc.CancelBatchRequest{
+		BatchKey: batchKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var finalizeBatchCommand = cli.Command{
+	Name:  "finalizebatch",
+	Usage: "finalize the pending minting batch",
+	Description: "freeze the pending minting batch immediately, without " +
+		"waiting for the next batch tick, and start minting its " +
+		"assets",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the fee rate in sat/kw to fund the genesis " +
+				"transaction with, if not set the fee rate " +
+				"is estimated",
+		},
+	},
+	Action: finalizeBatch,
+}
+
+func finalizeBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeRate := ctx.Uint64(feeRateName)
+	if feeRate > math.MaxUint32 {
+		return fmt.Errorf("fee rate %v too large", feeRate)
+	}
+
+	resp, err := client.FinalizeBatch(ctxc, &tarorpc.FinalizeBatchRequest{
+		FeeRate: uint32(feeRate),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to finalize batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listAssetsCommand = cli.Command{
 	Name:        "list",
 	ShortName:   "l",
 	Usage:       "list all assets",
 	Description: "list all pending and mined assets",
-	Action:      listAssets,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "only list assets with this asset ID",
+		},
+		cli.StringFlag{
+			Name:  keyFamName,
+			Usage: "only list assets of the family with this key",
+		},
+		cli.StringFlag{
+			Name: assetTypeName,
+			Usage: "only list assets of this type, must either be: " +
+				"normal, or collectible",
+		},
+		cli.StringFlag{
+			Name: anchorOutpointName,
+			Usage: "only list assets anchored at this outpoint " +
+				"(txid:vout)",
+		},
+		cli.BoolFlag{
+			Name:  confirmedOnlyName,
+			Usage: "only list assets that are confirmed on chain",
+		},
+		cli.BoolFlag{
+			Name:  unconfOnlyName,
+			Usage: "only list assets that are not yet confirmed",
+		},
+		cli.Int64Flag{
+			Name:  minAmountName,
+			Usage: "only list assets with at least this amount",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of assets to skip",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of assets to list",
+		},
+		cli.BoolFlag{
+			Name: includeSpentName,
+			Usage: "also list the prior states of assets that " +
+				"were spent",
+		},
+	},
+	Action: listAssets,
 }
 
 func listAssets(ctx *cli.Context) error {
@@ -123,7 +657,33 @@ func listAssets(ctx *cli.Context) error {
 
 	// TODO(roasbeef): need to reverse txid
 
-	resp, err := client.ListAssets(ctxc, &tarorpc.ListAssetRequest{})
+	req := &tarorpc.ListAssetRequest{
+		AnchorOutpoint:  ctx.String(anchorOutpointName),
+		ConfirmedOnly:   ctx.Bool(confirmedOnlyName),
+		UnconfirmedOnly: ctx.Bool(unconfOnlyName),
+		MinAmount:       ctx.Int64(minAmountName),
+		Offset:          int32(ctx.Int64(offsetName)),
+		Limit:           int32(ctx.Int64(limitName)),
+		IncludeSpent:    ctx.Bool(includeSpentName),
+	}
+
+	var err error
+	req.AssetId, err = hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+	req.FamilyKey, err = hex.DecodeString(ctx.String(keyFamName))
+	if err != nil {
+		return fmt.Errorf("invalid family key: %w", err)
+	}
+
+	if ctx.IsSet(assetTypeName) {
+		req.TypeFilter = &tarorpc.ListAssetRequest_AssetType{
+			AssetType: parseAssetType(ctx.String(assetTypeName)),
+		}
+	}
+
+	resp, err := client.ListAssets(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to list assets: %w", err)
 	}
@@ -131,6 +691,87 @@ func listAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var listUtxosCommand = cli.Command{
+	Name:      "utxos",
+	ShortName: "u",
+	Usage:     "list all utxos managed by the daemon",
+	Description: "list all BTC utxos that anchor Taro commitments along " +
+		"with the assets they hold",
+	Action: listUtxos,
+}
+
+func listUtxos(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.ListUtxosRequest{}
+	resp, err := client.ListUtxos(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list utxos: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var listLeasesCommand = cli.Command{
+	Name:  "leases",
+	Usage: "list all leased utxos managed by the daemon",
+	Description: "list all BTC utxos that anchor Taro commitments and " +
+		"are currently leased, so the assets they hold can't be " +
+		"selected as the input of a new send",
+	Action: listLeases,
+}
+
+func listLeases(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.ListLeasesRequest{}
+	resp, err := client.ListLeases(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list leases: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var releaseLeaseCommand = cli.Command{
+	Name:  "releaselease",
+	Usage: "release the lease on a utxo managed by the daemon",
+	Description: "release the lease on a BTC utxo that anchors Taro " +
+		"commitments, so the assets it holds can be selected as the " +
+		"input of a new send again",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  outpointName,
+			Usage: "the outpoint of the utxo, in the form txid:vout",
+		},
+	},
+	Action: releaseLease,
+}
+
+func releaseLease(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(outpointName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "releaselease")
+		return nil
+	}
+
+	resp, err := client.ReleaseLease(ctxc, &tarorpc.ReleaseLeaseRequest{
+		OutPoint: ctx.String(outpointName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to release lease: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
 var listAssetBalancesCommand = cli.Command{
 	Name:        "balance",
 	ShortName:   "b",
@@ -202,22 +843,78 @@ func listAssetBalances(ctx *cli.Context) error {
 	return nil
 }
 
+// sendAssetsFlags are the flags shared by the commands that send assets, or
+// estimate a send.
+var sendAssetsFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  addrName,
+		Usage: "addr to send to",
+	},
+	cli.StringFlag{
+		Name: coinSelectName,
+		Usage: "the strategy used to select the asset input, " +
+			"must be one of: default, smallest_sufficient, " +
+			"largest_first, oldest_first, privacy, " +
+			"minimize_split",
+		Value: "default",
+	},
+	cli.StringSliceFlag{
+		Name: inputOutpointName,
+		Usage: "an anchor outpoint in the form txid:vout the " +
+			"asset input may be selected from, can be " +
+			"specified multiple times",
+	},
+	cli.Uint64Flag{
+		Name: amtName,
+		Usage: "the amt of the asset to send, only required for " +
+			"amount-less addrs",
+	},
+}
+
 var sendAssetsCommand = cli.Command{
 	Name:        "send",
 	ShortName:   "s",
 	Usage:       "send an asset",
 	Description: "send asset w/ a taro addr",
-	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name:  addrName,
-			Usage: "addr to send to",
-		},
-		// TODO(roasbeef): add arg for file name to write sender proof
-		// blob
-	},
+	// TODO(roasbeef): add arg for file name to write sender proof blob
+	Flags:  sendAssetsFlags,
 	Action: sendAssets,
 }
 
+var estimateSendCommand = cli.Command{
+	Name:  "estimatesend",
+	Usage: "estimate the fee of an asset send",
+	Description: "do a dry run of an asset send w/ a taro addr, which " +
+		"shows the asset input that would be spent, the resulting " +
+		"outputs and the estimated on-chain fee, without sending " +
+		"anything",
+	Flags:  sendAssetsFlags,
+	Action: estimateSend,
+}
+
+func parseCoinSelectStrategy(
+	ctx *cli.Context) (tarorpc.CoinSelectStrategy, error) {
+
+	switch ctx.String(coinSelectName) {
+	case "default":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_DEFAULT, nil
+	case "smallest_sufficient":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_SUFFICIENT,
+			nil
+	case "largest_first":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST, nil
+	case "oldest_first":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_OLDEST_FIRST, nil
+	case "privacy":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_PRIVACY, nil
+	case "minimize_split":
+		return tarorpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_SPLIT, nil
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			ctx.String(coinSelectName))
+	}
+}
+
 func sendAssets(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
@@ -229,8 +926,16 @@ func sendAssets(ctx *cli.Context) error {
 		return nil
 	}
 
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.SendAsset(ctxc, &tarorpc.SendAssetRequest{
-		TaroAddr: ctx.String(addrName),
+		TaroAddr:           ctx.String(addrName),
+		CoinSelectStrategy: strategy,
+		InputOutpoints:     ctx.StringSlice(inputOutpointName),
+		Amt:                int64(ctx.Uint64(amtName)),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -240,6 +945,35 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
+func estimateSend(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(addrName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "estimatesend")
+		return nil
+	}
+
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.EstimateSend(ctxc, &tarorpc.EstimateSendRequest{
+		TaroAddr:           ctx.String(addrName),
+		CoinSelectStrategy: strategy,
+		InputOutpoints:     ctx.StringSlice(inputOutpointName),
+		Amt:                int64(ctx.Uint64(amtName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to estimate send: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
@@ -270,3 +1004,222 @@ func listTransfers(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var burnAssetsCommand = cli.Command{
+	Name:  "burn",
+	Usage: "burn an asset",
+	Description: "burn a number of units of an asset by sending them to " +
+		"a provably unspendable script key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to burn",
+		},
+		cli.Uint64Flag{
+			Name:  burnAmountName,
+			Usage: "the number of asset units to burn",
+		},
+	},
+	Action: burnAssets,
+}
+
+func burnAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(assetIDName) == "":
+		fallthrough
+	case ctx.Uint64(burnAmountName) == 0:
+		_ = cli.ShowCommandHelp(ctx, "burn")
+		return nil
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	resp, err := client.BurnAsset(ctxc, &tarorpc.BurnAssetRequest{
+		AssetId:      assetID,
+		AmountToBurn: int64(ctx.Uint64(burnAmountName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to burn assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listBurnsCommand = cli.Command{
+	Name:  "listburns",
+	Usage: "list asset burns",
+	Description: "list the burns of all assets or a selected asset that " +
+		"were initiated by this node",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "A specific asset ID to list burns for",
+		},
+	},
+	Action: listBurns,
+}
+
+func listBurns(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.ListBurnsRequest{}
+	if ctx.IsSet(assetIDName) {
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		req.AssetId = assetID
+	}
+
+	resp, err := client.ListBurns(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list asset burns: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var assetHistoryCommand = cli.Command{
+	Name:  "history",
+	Usage: "list the history of an asset",
+	Description: "list the mints, receives, sends and burns of all " +
+		"assets, or of a selected asset or asset family, in the " +
+		"order they happened",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "A specific asset ID to list the history of",
+		},
+		cli.StringFlag{
+			Name:  keyFamName,
+			Usage: "A specific asset family key to list the history of",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of history entries to skip",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of history entries to return",
+		},
+	},
+	Action: assetHistory,
+}
+
+func assetHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.AssetHistoryRequest{
+		Offset: int32(ctx.Int64(offsetName)),
+		Limit:  int32(ctx.Int64(limitName)),
+	}
+
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(keyFamName):
+		return fmt.Errorf("only one of --%v and --%v can be set",
+			assetIDName, keyFamName)
+
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+		req.Filter = &tarorpc.AssetHistoryRequest_AssetId{
+			AssetId: assetID,
+		}
+
+	case ctx.IsSet(keyFamName):
+		famKey, err := hex.DecodeString(ctx.String(keyFamName))
+		if err != nil {
+			return fmt.Errorf("invalid family key: %w", err)
+		}
+		req.Filter = &tarorpc.AssetHistoryRequest_FamilyKey{
+			FamilyKey: famKey,
+		}
+	}
+
+	resp, err := client.AssetHistory(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list asset history: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fetchMetaCommand = cli.Command{
+	Name:  "meta",
+	Usage: "fetch asset meta",
+	Description: "fetch the metadata committed to in the genesis of an " +
+		"asset, either by its asset ID or by the hash of the metadata",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to fetch the metadata for",
+		},
+		cli.StringFlag{
+			Name:  metaHashName,
+			Usage: "the hash of the metadata to fetch the preimage for",
+		},
+	},
+	Action: fetchMeta,
+}
+
+func fetchMeta(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &tarorpc.FetchAssetMetaRequest{}
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(metaHashName):
+		return fmt.Errorf("only one of --%v and --%v can be set",
+			assetIDName, metaHashName)
+
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		req.Asset = &tarorpc.FetchAssetMetaRequest_AssetId{
+			AssetId: assetID,
+		}
+
+	case ctx.IsSet(metaHashName):
+		metaHash, err := hex.DecodeString(ctx.String(metaHashName))
+		if err != nil {
+			return fmt.Errorf("invalid meta hash: %w", err)
+		}
+
+		req.Asset = &tarorpc.FetchAssetMetaRequest_MetaHash{
+			MetaHash: metaHash,
+		}
+
+	default:
+		_ = cli.ShowCommandHelp(ctx, "meta")
+		return nil
+	}
+
+	resp, err := client.FetchAssetMeta(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to fetch asset meta: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -22,6 +22,7 @@ var proofCommands = []cli.Command{
 			verifyProofCommand,
 			exportProofCommand,
 			importProofCommand,
+			debugProofCommand,
 		},
 	},
 }
@@ -185,6 +186,63 @@ func importProof(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	proofIndexName = "proof_index"
+)
+
+var debugProofCommand = cli.Command{
+	Name:      "debug",
+	ShortName: "d",
+	Description: "replay a single state transition of a taro proof file " +
+		"and show each step taken by the Taro VM",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.IntFlag{
+			Name: proofIndexName,
+			Usage: "the index of the proof within the file to " +
+				"replay; if negative, the last proof is " +
+				"replayed",
+			Value: -1,
+		},
+	},
+	Action: debugProof,
+}
+
+func debugProof(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(proofPathName) == "":
+		_ = cli.ShowCommandHelp(ctx, "debug")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	resp, err := client.DebugVerifyTransition(
+		ctxc, &tarorpc.DebugVerifyTransitionRequest{
+			RawProof:   rawFile,
+			ProofIndex: int32(ctx.Int(proofIndexName)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to debug proof: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // readFile attempts to read a file from disk. If the passed fileName is equal
 // to the dash character, then this function reads from stdin instead.
 func readFile(fileName string) ([]byte, error) {
@@ -202,7 +260,7 @@ func writeToFile(fileName string, content []byte) error {
 	if fileName == "-" {
 		_, err := os.Stdout.Write(content)
 		if err != nil {
-			return fmt.Errorf("error writing raw proof to stdout: "+
+			return fmt.Errorf("error writing raw data to stdout: "+
 				"%v", err)
 		}
 
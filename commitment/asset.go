@@ -97,7 +97,7 @@ func parseCommon(assets ...*asset.Asset) (*AssetCommitment, error) {
 	assetsMap := make(CommittedAssets, len(assets))
 	for _, asset := range assets {
 		switch {
-		case !assetFamilyKey.IsEqual(asset.FamilyKey):
+		case !assetFamilyKey.IsSameFamily(asset.FamilyKey):
 			return nil, ErrAssetFamilyKeyMismatch
 
 		case assetFamilyKey == nil:
@@ -106,10 +106,12 @@ func parseCommon(assets ...*asset.Asset) (*AssetCommitment, error) {
 			}
 
 		case assetFamilyKey != nil:
-			// There should be a valid Schnorr sig over the asset ID
-			// in the family key struct.
+			// There should be a valid Schnorr sig over the asset's
+			// genesis in its family key struct. Assets of the
+			// same family may have been issued separately, so each
+			// asset carries its own signature.
 			validSig := asset.Genesis.VerifySignature(
-				&assetFamilyKey.Sig, &assetFamilyKey.FamKey,
+				&asset.FamilyKey.Sig, &asset.FamilyKey.FamKey,
 			)
 			if !validSig {
 				return nil, ErrAssetGenesisInvalidSig
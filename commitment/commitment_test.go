@@ -39,7 +39,9 @@ func randFamilyKey(t *testing.T, genesis asset.Genesis) *asset.FamilyKey {
 	fakeKeyDesc := keychain.KeyDescriptor{
 		PubKey: privKey.PubKey(),
 	}
-	familyKey, err := asset.DeriveFamilyKey(genSigner, fakeKeyDesc, genesis)
+	familyKey, err := asset.DeriveFamilyKey(
+		genSigner, fakeKeyDesc, genesis, nil,
+	)
 	require.NoError(t, err)
 	return familyKey
 }
@@ -104,6 +106,23 @@ func TestNewAssetCommitment(t *testing.T) {
 		Sig:    familyKey1Collectible.Sig,
 	}
 
+	// We'll also issue another asset into the family of the first asset,
+	// which results in a family key with a distinct signature.
+	famPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	famGenSigner := asset.NewRawKeyGenesisSigner(famPrivKey)
+	famKeyDesc := keychain.KeyDescriptor{
+		PubKey: famPrivKey.PubKey(),
+	}
+	familyKey3, err := asset.DeriveFamilyKey(
+		famGenSigner, famKeyDesc, genesis1, nil,
+	)
+	require.NoError(t, err)
+	familyKey3Reissued, err := asset.DeriveFamilyKey(
+		famGenSigner, famKeyDesc, genesis1, &genesis2,
+	)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name string
 		f    func() []*asset.Asset
@@ -159,6 +178,19 @@ func TestNewAssetCommitment(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "valid normal asset commitment with family key " +
+				"and distinct genesis",
+			f: func() []*asset.Asset {
+				return []*asset.Asset{
+					randAsset(t, genesis1, familyKey3),
+					randAsset(
+						t, genesis2, familyKey3Reissued,
+					),
+				}
+			},
+			err: nil,
+		},
 		{
 			name: "valid collectible asset commitment with " +
 				"family key",
@@ -71,6 +71,8 @@ type Config struct {
 
 	ProofArchive proof.Archiver
 
+	ReorgWatcher *tarogarden.ReorgWatcher
+
 	ChainPorter tarofreighter.Porter
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -27,19 +27,28 @@ func NewLndRpcGenSigner(lnd *lndclient.LndServices) *LndRpcGenSigner {
 }
 
 // SignGenesis signs the passed Genesis description using the public key
-// identified by the passed key descriptor. The final tweaked public key and
-// the signature are returned.
+// identified by the passed key descriptor. The key is tweaked with the initial
+// genesis, while the current genesis (if non-nil) is the one being signed.
+// The final tweaked public key and the signature are returned.
 func (l *LndRpcGenSigner) SignGenesis(keyDesc keychain.KeyDescriptor,
-	assetGen asset.Genesis) (*btcec.PublicKey, *schnorr.Signature, error) {
+	initialGen asset.Genesis, currentGen *asset.Genesis) (*btcec.PublicKey,
+	*schnorr.Signature, error) {
 
 	tweakedPubKey := txscript.ComputeTaprootOutputKey(
-		keyDesc.PubKey, assetGen.FamilyKeyTweak(),
+		keyDesc.PubKey, initialGen.FamilyKeyTweak(),
 	)
 
-	id := assetGen.ID()
+	assetGen := initialGen
+	if currentGen != nil {
+		assetGen = *currentGen
+	}
+
+	// The signer hashes the message with SHA-256 before signing it, so the
+	// resulting signature is over the genesis' FamilySigDigest.
 	sig, err := l.lnd.Signer.SignMessage(
-		context.Background(), id[:], keyDesc.KeyLocator,
-		lndclient.SignSchnorr(assetGen.FamilyKeyTweak()),
+		context.Background(), assetGen.FamilySigMessage(),
+		keyDesc.KeyLocator,
+		lndclient.SignSchnorr(initialGen.FamilyKeyTweak()),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -26,7 +26,7 @@ func testBasicSend(t *harnessTest) {
 	// Now that we have the asset created, we'll make a new node that'll
 	// serve as the node which'll receive the assets.
 	secondTarod := setupTarodHarness(
-		t.t, t, t.lndHarness.BackendCfg, t.lndHarness.Bob, t.universeServer,
+		t.t, t, t.lndHarness.Bob, t.universeServer,
 	)
 	defer func() {
 		require.NoError(t.t, secondTarod.stop(true))
@@ -53,6 +53,27 @@ func testBasicSend(t *harnessTest) {
 
 		assertAddrCreated(t.t, secondTarod, rpcAssets[0], bobAddr)
 
+		// Before we send, we'll do a dry run of the send, which
+		// should show the split we're about to create without
+		// committing to anything.
+		estimateResp, err := t.tarod.EstimateSend(
+			ctxb, &tarorpc.EstimateSendRequest{
+				TaroAddr: bobAddr.Encoded,
+			},
+		)
+		require.NoError(t.t, err)
+		require.True(t.t, estimateResp.NeedsSplit)
+		require.Len(t.t, estimateResp.Outputs, 2)
+		require.Greater(t.t, estimateResp.TotalFeeSats, int64(0))
+
+		for _, o := range estimateResp.Outputs {
+			expectedAmt := int64(numUnits)
+			if o.IsChange {
+				expectedAmt = int64(currentUnits)
+			}
+			require.Equal(t.t, expectedAmt, o.Amount)
+		}
+
 		sendResp := sendAssetsToAddr(t, t.tarod, bobAddr)
 
 		// Check that we now have two new outputs, and that they differ
@@ -85,13 +85,17 @@ func (s *serverHarness) start() error {
 func genCertPair(certFile, keyFile string) (credentials.TransportCredentials,
 	error) {
 
-	err := cert.GenCertPair(
-		"itest autogenerated cert", certFile, keyFile, nil, nil,
-		false, DefaultAutogenValidity,
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"itest autogenerated cert", nil, nil, false,
+		DefaultAutogenValidity,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate cert pair: %v", err)
 	}
+	err = cert.WriteCertPair(certFile, keyFile, certBytes, keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write cert pair: %v", err)
+	}
 
 	creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
 	if err != nil {
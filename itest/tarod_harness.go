@@ -14,7 +14,7 @@ import (
 	"github.com/lightninglabs/taro/tarocfg"
 	"github.com/lightninglabs/taro/tarorpc"
 	"github.com/lightningnetwork/lnd/lnrpc"
-	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/node"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc"
@@ -40,9 +40,8 @@ type tarodHarness struct {
 // server.
 type tarodConfig struct {
 	UniverseServer string
-	BackendCfg     lntest.BackendConfig
 	ServerTLSPath  string
-	LndNode        *lntest.HarnessNode
+	LndNode        *node.HarnessNode
 	NetParams      *chaincfg.Params
 	BaseDir        string
 }
@@ -117,6 +117,55 @@ func (p *Proof) UpdateTransitionProof(params *BaseProofParams) error {
 	return nil
 }
 
+// ReanchorLastProof re-anchors the last proof of the given encoded proof file
+// in the block of the given proof parameters. This is needed once the anchor
+// transaction of the last proof was re-confirmed in a different block after a
+// chain reorganization. This method returns both the encoded full provenance
+// (proof chain) and the updated last proof.
+func ReanchorLastProof(blob Blob, params *BaseProofParams) (Blob, *Proof,
+	error) {
+
+	if params.Block == nil || params.Tx == nil {
+		return nil, nil, fmt.Errorf("missing block or TX to " +
+			"re-anchor proof")
+	}
+
+	f := NewEmptyFile(V0)
+	if err := f.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, nil, fmt.Errorf("error decoding proof file: %w",
+			err)
+	}
+
+	lastProof, err := f.LastProof()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching last proof: %w",
+			err)
+	}
+
+	// The anchor transaction itself can't change, only the block it was
+	// confirmed in.
+	if lastProof.AnchorTx.TxHash() != params.Tx.TxHash() {
+		return nil, nil, fmt.Errorf("anchor tx mismatch, expected %v "+
+			"got %v", lastProof.AnchorTx.TxHash(),
+			params.Tx.TxHash())
+	}
+
+	if err := lastProof.UpdateTransitionProof(params); err != nil {
+		return nil, nil, fmt.Errorf("error updating proof: %w", err)
+	}
+	if err := f.ReplaceLastProof(*lastProof); err != nil {
+		return nil, nil, fmt.Errorf("error replacing proof: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := f.Encode(&buf); err != nil {
+		return nil, nil, fmt.Errorf("error encoding proof file: %w",
+			err)
+	}
+
+	return buf.Bytes(), lastProof, nil
+}
+
 // CreateTransitionProof creates a proof for an asset transition, based on the
 // last proof of the last asset state and the new asset in the params.
 func CreateTransitionProof(prevOut wire.OutPoint,
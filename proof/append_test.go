@@ -15,23 +15,22 @@ import (
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/taroscript"
+	"github.com/lightninglabs/taro/vm"
 	"github.com/stretchr/testify/require"
 )
 
 func genTaprootKeySpend(t testing.TB, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32) wire.TxWitness {
+	virtualTx *wire.MsgTx, prevID *asset.PrevID, input *asset.Asset,
+	idx uint32) wire.TxWitness {
 
 	t.Helper()
 
-	virtualTxCopy := taroscript.VirtualTxWithInput(
-		virtualTx, input, idx, nil,
-	)
 	sigHash, err := taroscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx,
+		virtualTx, *prevID, input, idx, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 
-	taprootPrivKey := txscript.TweakTaprootPrivKey(&privKey, nil)
+	taprootPrivKey := txscript.TweakTaprootPrivKey(privKey, nil)
 	sig, err := schnorr.Sign(taprootPrivKey, sigHash)
 	require.NoError(t, err)
 
@@ -49,6 +48,7 @@ func TestAppendTransition(t *testing.T) {
 		amt             uint64
 		withBip86Change bool
 		withSplit       bool
+		withBurn        bool
 	}{{
 		name:      "normal",
 		assetType: asset.Normal,
@@ -63,6 +63,12 @@ func TestAppendTransition(t *testing.T) {
 		assetType: asset.Normal,
 		amt:       100,
 		withSplit: true,
+	}, {
+		name:      "normal with burn",
+		assetType: asset.Normal,
+		amt:       100,
+		withSplit: true,
+		withBurn:  true,
 	}, {
 		name:      "collectible",
 		assetType: asset.Collectible,
@@ -78,7 +84,7 @@ func TestAppendTransition(t *testing.T) {
 		t.Run(tc.name, func(tt *testing.T) {
 			runAppendTransitionTest(
 				tt, tc.assetType, tc.amt, tc.withBip86Change,
-				tc.withSplit,
+				tc.withSplit, tc.withBurn,
 			)
 		})
 	}
@@ -87,7 +93,7 @@ func TestAppendTransition(t *testing.T) {
 // runAppendTransitionTest runs the test that makes sure a proof can be appended
 // to an existing proof for an asset transition of the given type and amount.
 func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
-	withBip86Change, withSplit bool) {
+	withBip86Change, withSplit, withBurn bool) {
 
 	// Start with a minted genesis asset.
 	genesisProof, senderPrivKey := genRandomGenesisWithProof(
@@ -217,6 +223,19 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 		ScriptKey:   asset.ToSerialized(split2PrivKey.PubKey()),
 		Amount:      50,
 	}
+
+	// If we want to burn the second split, we send it to the burn key that
+	// commits to the input being spent instead.
+	if withBurn {
+		burnKey := asset.DeriveBurnKey(asset.PrevID{
+			OutPoint: transitionOutpoint,
+			ID:       newAsset.ID(),
+			ScriptKey: asset.ToSerialized(
+				newAsset.ScriptKey.PubKey,
+			),
+		})
+		split2Locator.ScriptKey = asset.ToSerialized(burnKey)
+	}
 	splitCommitment, err := commitment.NewSplitCommitment(
 		&newAsset, transitionOutpoint, rootLocator, split2Locator,
 	)
@@ -328,6 +347,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 	require.Equal(t, splitTxMerkleProof, &split1Proof.TxMerkleProof)
 	split1Snapshot := verifyBlob(t, split1Blob)
 	require.False(t, split1Snapshot.SplitAsset)
+	require.False(t, split1Snapshot.IsBurn)
 
 	// And now for the second split (the recipient output).
 	split2Params := &TransitionParams{
@@ -364,6 +384,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 	split2Snapshot := verifyBlob(t, split2Blob)
 
 	require.True(t, split2Snapshot.SplitAsset)
+	require.Equal(t, withBurn, split2Snapshot.IsBurn)
 }
 
 // signAssetTransfer creates a virtual transaction for an asset transfer and
@@ -393,7 +414,7 @@ func signAssetTransfer(t testing.TB, prevProof *Proof, newAsset *asset.Asset,
 	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *senderPrivKey, virtualTx, &prevProof.Asset, 0,
+		t, *senderPrivKey, virtualTx, prevID, &prevProof.Asset, 0,
 	)
 	require.NoError(t, err)
 	newAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -418,5 +439,19 @@ func verifyBlob(t testing.TB, blob Blob) *AssetSnapshot {
 	finalSnapshot, err := f.Verify(context.Background())
 	require.NoError(t, err)
 
+	// Replaying the last transition with a tracer should result in the
+	// same snapshot, with all the VM steps recorded.
+	lastIndex := uint32(f.NumProofs() - 1)
+	recorder := vm.NewStepRecorder()
+	replaySnapshot, err := f.VerifyTransition(
+		context.Background(), lastIndex, recorder,
+	)
+	require.NoError(t, err)
+	require.Equal(t, finalSnapshot, replaySnapshot)
+	require.NotEmpty(t, recorder.Steps)
+	for _, step := range recorder.Steps {
+		require.NoError(t, step.Err)
+	}
+
 	return finalSnapshot
 }
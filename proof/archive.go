@@ -39,6 +39,10 @@ var (
 	// ErrInvalidLocatorKey is returned when a specified locator script key
 	// is invalid.
 	ErrInvalidLocatorKey = fmt.Errorf("invalid script key locator")
+
+	// ErrProofInvalid is returned when a proof that is being imported
+	// fails verification.
+	ErrProofInvalid = fmt.Errorf("invalid proof")
 )
 
 // Locator is able to uniquely identify a proof in the extended Taro Universe
@@ -278,7 +282,8 @@ func (m *MultiArchiver) ImportProofs(ctx context.Context,
 			ctx, bytes.NewReader(proof.Blob),
 		)
 		if err != nil {
-			return fmt.Errorf("unable to verify proof: %w", err)
+			return fmt.Errorf("unable to verify proof: %w: %v",
+				ErrProofInvalid, err)
 		}
 
 		proof.AssetSnapshot = finalStateTransition
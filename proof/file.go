@@ -340,4 +340,9 @@ type AssetSnapshot struct {
 	// resulted from splitting an asset. If this is true then the root asset
 	// of the split can be found in the asset witness' split commitment.
 	SplitAsset bool
+
+	// IsBurn is true if the asset in the snapshot was sent to a provably
+	// unspendable burn key that commits to the asset's input, meaning the
+	// asset was destroyed in this state transition.
+	IsBurn bool
 }
@@ -1,6 +1,8 @@
 package proof
 
 import (
+	"bytes"
+	"context"
 	"testing"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -74,7 +76,7 @@ func TestNewMintingBlobs(t *testing.T) {
 
 	// The NewMintingBlobs will return an error if the generated proof is
 	// invalid.
-	_, err = NewMintingBlobs(&MintParams{
+	mintingBlobs, err := NewMintingBlobs(&MintParams{
 		BaseProofParams: BaseProofParams{
 			Block: &wire.MsgBlock{
 				Header:       *blockHeader,
@@ -96,4 +98,60 @@ func TestNewMintingBlobs(t *testing.T) {
 		GenesisPoint: genesisTx.TxIn[0].PreviousOutPoint,
 	})
 	require.NoError(t, err)
+
+	// If the genesis transaction is re-confirmed in a different block
+	// after a reorg, we should be able to re-anchor the proof in the new
+	// block, at a different index.
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	reorgTxns := []*wire.MsgTx{otherTx, genesisTx}
+	reorgBlockTxns := []*btcutil.Tx{
+		btcutil.NewTx(otherTx), btcutil.NewTx(genesisTx),
+	}
+	reorgMerkleTree := blockchain.BuildMerkleTreeStore(
+		reorgBlockTxns, false,
+	)
+	reorgHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash,
+		reorgMerkleTree[len(reorgMerkleTree)-1], 0, 1,
+	)
+	reorgBlock := &wire.MsgBlock{
+		Header:       *reorgHeader,
+		Transactions: reorgTxns,
+	}
+
+	require.Len(t, mintingBlobs, 1)
+	var mintingBlob Blob
+	for _, blob := range mintingBlobs {
+		mintingBlob = blob
+	}
+
+	reanchoredBlob, reanchoredProof, err := ReanchorLastProof(
+		mintingBlob, &BaseProofParams{
+			Block:   reorgBlock,
+			Tx:      genesisTx,
+			TxIndex: 1,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, *reorgHeader, reanchoredProof.BlockHeader)
+
+	reanchoredFile := NewEmptyFile(V0)
+	require.NoError(t, reanchoredFile.Decode(
+		bytes.NewReader(reanchoredBlob),
+	))
+	_, err = reanchoredFile.Verify(context.Background())
+	require.NoError(t, err)
+
+	// A proof can't be re-anchored to a different transaction.
+	_, _, err = ReanchorLastProof(
+		mintingBlob, &BaseProofParams{
+			Block:   reorgBlock,
+			Tx:      otherTx,
+			TxIndex: 0,
+		},
+	)
+	require.ErrorContains(t, err, "anchor tx mismatch")
 }
@@ -155,9 +155,10 @@ func (p *Proof) verifyExclusionProofs() error {
 
 // verifyAssetStateTransition verifies an asset's witnesses resulting from a
 // state transition. This method returns the split asset information if this
-// state transition represents an asset split.
+// state transition represents an asset split. If a tracer is passed, it is
+// notified of each step taken by the VM.
 func (p *Proof) verifyAssetStateTransition(ctx context.Context,
-	prev *AssetSnapshot) (*commitment.SplitAsset, error) {
+	prev *AssetSnapshot, tracer vm.Tracer) (*commitment.SplitAsset, error) {
 
 	// Determine whether we have an asset split based on the resulting
 	// asset's witness. If so, extract the root asset from the split asset.
@@ -225,7 +226,9 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	}
 
 	// Spawn a new VM instance to verify the asset's state transition.
-	engine, err := vm.New(newAsset, splitAsset, prevAssets)
+	engine, err := vm.NewWithTracer(
+		newAsset, splitAsset, prevAssets, tracer,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -245,6 +248,15 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 func (p *Proof) Verify(ctx context.Context,
 	prev *AssetSnapshot) (*AssetSnapshot, error) {
 
+	return p.VerifyWithTracer(ctx, prev, nil)
+}
+
+// VerifyWithTracer verifies the proof in the same way as Verify, but also
+// notifies the passed tracer of each step the VM takes while validating the
+// asset state transition. A nil tracer disables tracing.
+func (p *Proof) VerifyWithTracer(ctx context.Context, prev *AssetSnapshot,
+	tracer vm.Tracer) (*AssetSnapshot, error) {
+
 	// 1. A transaction that spends the previous asset output has a valid
 	// merkle proof within a block in the chain.
 	if prev != nil && p.PrevOut != prev.OutPoint {
@@ -284,7 +296,7 @@ func (p *Proof) Verify(ctx context.Context,
 
 	// 5. A set of asset inputs with valid witnesses are included that
 	// satisfy the resulting state transition.
-	splitAsset, err := p.verifyAssetStateTransition(ctx, prev)
+	splitAsset, err := p.verifyAssetStateTransition(ctx, prev, tracer)
 	if err != nil {
 		return nil, err
 	}
@@ -303,6 +315,7 @@ func (p *Proof) Verify(ctx context.Context,
 		InternalKey:     p.InclusionProof.InternalKey,
 		ScriptRoot:      taroCommitment,
 		SplitAsset:      splitAsset != nil,
+		IsBurn:          p.Asset.IsBurn(),
 	}, nil
 }
 
@@ -342,3 +355,46 @@ func (f *File) Verify(ctx context.Context) (*AssetSnapshot, error) {
 
 	return prev, nil
 }
+
+// VerifyTransition verifies the proof file up to and including the state
+// transition at the given index, notifying the passed tracer of each step the
+// VM takes while validating that final transition. This allows a single
+// (failing) transition to be replayed in detail.
+func (f *File) VerifyTransition(ctx context.Context, index uint32,
+	tracer vm.Tracer) (*AssetSnapshot, error) {
+
+	if index >= uint32(f.NumProofs()) {
+		return nil, fmt.Errorf("invalid proof index %d, file contains "+
+			"%d proofs", index, f.NumProofs())
+	}
+
+	var prev *AssetSnapshot
+	for idx := uint32(0); idx <= index; idx++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		decodedProof, err := f.ProofAt(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		// We only trace the transition we're interested in, all prior
+		// ones just need to be valid.
+		if idx != index {
+			prev, err = decodedProof.Verify(ctx, prev)
+			if err != nil {
+				return nil, fmt.Errorf("prior transition %d "+
+					"invalid: %w", idx, err)
+			}
+
+			continue
+		}
+
+		return decodedProof.VerifyWithTracer(ctx, prev, tracer)
+	}
+
+	return prev, nil
+}
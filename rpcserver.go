@@ -3,9 +3,13 @@ package taro
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -13,16 +17,22 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/rpcperms"
+	"github.com/lightninglabs/taro/tarodb"
 	"github.com/lightninglabs/taro/tarofreighter"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightninglabs/taro/vm"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -52,10 +62,50 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/tarorpc.Taro/MintAssetBatch": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/tarorpc.Taro/ListAssets": {{
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/tarorpc.Taro/ListBatches": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ListSeedlings": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/CancelSeedling": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/CancelBatch": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/FinalizeBatch": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/ListUtxos": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ListLeases": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ReleaseLease": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/AssetHistory": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/tarorpc.Taro/ListBalances": {{
 			Entity: "assets",
 			Action: "read",
@@ -80,6 +130,22 @@ var (
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/tarorpc.Taro/SubscribeReceiveEvents": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ExportAddrs": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ImportAddrs": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/RescanAddrs": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
 		"/tarorpc.Taro/VerifyProof": {{
 			Entity: "proofs",
 			Action: "read",
@@ -92,10 +158,30 @@ var (
 			Entity: "proofs",
 			Action: "write",
 		}},
+		"/tarorpc.Taro/DebugVerifyTransition": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
 		"/tarorpc.Taro/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/tarorpc.Taro/EstimateSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/BurnAsset": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/ListBurns": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/FetchAssetMeta": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 	}
 )
 
@@ -286,14 +372,11 @@ func (r *rpcServer) DebugLevel(ctx context.Context,
 func (r *rpcServer) MintAsset(ctx context.Context,
 	req *tarorpc.MintAssetRequest) (*tarorpc.MintAssetResponse, error) {
 
-	seedling := &tarogarden.Seedling{
-		AssetType:      asset.Type(req.AssetType),
-		AssetName:      req.Name,
-		Metadata:       req.MetaData,
-		Amount:         uint64(req.Amount),
-		EnableEmission: req.EnableEmission,
-		NoBatch:        req.SkipBatch,
+	seedling, err := unmarshalSeedling(req)
+	if err != nil {
+		return nil, err
 	}
+
 	updates, err := r.cfg.AssetMinter.QueueNewSeedling(seedling)
 	if err != nil {
 		return nil, fmt.Errorf("unable to mint new asset: %w", err)
@@ -317,257 +400,941 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 	}
 }
 
-// ListAssets lists the set of assets owned by the target daemon.
-func (r *rpcServer) ListAssets(ctx context.Context,
-	req *tarorpc.ListAssetRequest) (*tarorpc.ListAssetResponse, error) {
+// MintAssetBatch attempts to atomically queue a set of assets to be minted in
+// the pending batch. If any of the assets is invalid, then none of them are
+// queued and the reason for each invalid asset is returned.
+func (r *rpcServer) MintAssetBatch(_ context.Context,
+	req *tarorpc.MintAssetBatchRequest) (*tarorpc.MintAssetBatchResponse,
+	error) {
 
-	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, nil)
-	if err != nil {
-		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	if len(req.Assets) == 0 {
+		return nil, fmt.Errorf("no assets specified")
 	}
 
-	rpcAssets := make([]*tarorpc.Asset, len(assets))
-	for i, a := range assets {
-		assetID := a.Genesis.ID()
-
-		var bootstrapInfoBuf bytes.Buffer
-		if err := a.Genesis.Encode(&bootstrapInfoBuf); err != nil {
-			return nil, fmt.Errorf("unable to encode genesis: %w",
-				err)
+	// We'll first parse all assets, so we're able to report all malformed
+	// assets at once.
+	var (
+		resp      tarorpc.MintAssetBatchResponse
+		seedlings = make([]*tarogarden.Seedling, len(req.Assets))
+	)
+	for i, rpcAsset := range req.Assets {
+		seedling, err := unmarshalSeedling(rpcAsset)
+		if err != nil {
+			resp.Errors = append(resp.Errors,
+				&tarorpc.MintAssetBatchError{
+					Index: uint32(i),
+					Name:  rpcAsset.Name,
+					Error: err.Error(),
+				},
+			)
+			continue
 		}
 
-		var anchorTxBytes []byte
-		if a.AnchorTx != nil {
-			var anchorTxBuf bytes.Buffer
-			err := a.AnchorTx.Serialize(&anchorTxBuf)
-			if err != nil {
-				return nil, fmt.Errorf("unable to serialize "+
-					"anchor tx: %w", err)
-			}
-			anchorTxBytes = anchorTxBuf.Bytes()
-		}
-		rpcAssets[i] = &tarorpc.Asset{
-			Version: int32(a.Version),
-			AssetGenesis: &tarorpc.GenesisInfo{
-				GenesisPoint:         a.Genesis.FirstPrevOut.String(),
-				Name:                 a.Genesis.Tag,
-				Meta:                 a.Genesis.Metadata,
-				AssetId:              assetID[:],
-				OutputIndex:          a.Genesis.OutputIndex,
-				GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
-			},
-			AssetType:        tarorpc.AssetType(a.Type),
-			Amount:           int64(a.Amount),
-			LockTime:         int32(a.LockTime),
-			RelativeLockTime: int32(a.RelativeLockTime),
-			ScriptVersion:    int32(a.ScriptVersion),
-			ScriptKey:        a.ScriptKey.PubKey.SerializeCompressed(),
-			ChainAnchor: &tarorpc.AnchorInfo{
-				AnchorTx:        anchorTxBytes,
-				AnchorTxid:      a.AnchorTxid[:],
-				AnchorBlockHash: a.AnchorBlockHash[:],
-				AnchorOutpoint:  a.AnchorOutpoint.String(),
-				InternalKey:     a.AnchorInternalKey.SerializeCompressed(),
-			},
-		}
+		seedlings[i] = seedling
+	}
+	if len(resp.Errors) != 0 {
+		return &resp, nil
+	}
 
-		if a.FamilyKey != nil {
-			rpcAssets[i].AssetFamily = &tarorpc.AssetFamily{
-				RawFamilyKey:     a.FamilyKey.RawKey.PubKey.SerializeCompressed(),
-				TweakedFamilyKey: a.FamilyKey.FamKey.SerializeCompressed(),
-				AssetIdSig:       a.FamilyKey.Sig.Serialize(),
-			}
+	batchKey, err := r.cfg.AssetMinter.QueueNewSeedlings(seedlings)
+
+	// If the planter rejected any of the seedlings, then we'll report back
+	// the reason for each of them.
+	var batchErr *tarogarden.SeedlingBatchError
+	switch {
+	case errors.As(err, &batchErr):
+		for _, seedlingErr := range batchErr.Errors {
+			resp.Errors = append(resp.Errors,
+				&tarorpc.MintAssetBatchError{
+					Index: uint32(seedlingErr.Index),
+					Name:  seedlingErr.AssetName,
+					Error: seedlingErr.Err.Error(),
+				},
+			)
 		}
+
+		return &resp, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to mint assets: %w", err)
 	}
 
-	return &tarorpc.ListAssetResponse{
-		Assets: rpcAssets,
-	}, nil
-}
+	resp.BatchKey = batchKey.SerializeCompressed()
 
-func (r *rpcServer) listBalancesByAsset(ctx context.Context,
-	assetID *asset.ID) (*tarorpc.ListBalancesResponse, error) {
+	return &resp, nil
+}
 
-	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+// unmarshalSeedling parses a seedling from the given RPC mint request.
+func unmarshalSeedling(
+	req *tarorpc.MintAssetRequest) (*tarogarden.Seedling, error) {
+
+	// Typed metadata is wrapped in an envelope that commits to its type
+	// and decimal display. The planter makes sure the data actually
+	// matches that type.
+	meta := &asset.Meta{
+		Type:           asset.MetaType(req.MetaType),
+		MimeType:       req.MetaMimeType,
+		Data:           req.MetaData,
+		DecimalDisplay: req.DecimalDisplay,
+	}
+	metadata, err := meta.Encode()
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, fmt.Errorf("unable to encode metadata: %w", err)
 	}
 
-	resp := &tarorpc.ListBalancesResponse{
-		AssetBalances: make(map[string]*tarorpc.AssetBalance, len(balances)),
+	seedling := &tarogarden.Seedling{
+		AssetType:      asset.Type(req.AssetType),
+		AssetName:      req.Name,
+		Metadata:       metadata,
+		Amount:         uint64(req.Amount),
+		EnableEmission: req.EnableEmission,
+		NoBatch:        req.SkipBatch,
 	}
 
-	for _, balance := range balances {
-		assetIDStr := hex.EncodeToString(balance.ID[:])
-
-		gen := asset.Genesis{
-			FirstPrevOut: balance.GenesisPoint,
-			Tag:          balance.Tag,
-			Metadata:     balance.Meta,
-			OutputIndex:  balance.OutputIndex,
-			Type:         balance.Type,
-		}
-		var bootstrapInfoBuf bytes.Buffer
-		if err := gen.Encode(&bootstrapInfoBuf); err != nil {
-			return nil, fmt.Errorf("unable to encode genesis: %w",
-				err)
+	// If a family key is specified, then the new asset will be issued
+	// into that existing asset family.
+	if len(req.FamilyKey) != 0 {
+		famKey, err := btcec.ParsePubKey(req.FamilyKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid family key: %w", err)
 		}
 
-		resp.AssetBalances[assetIDStr] = &tarorpc.AssetBalance{
-			AssetGenesis: &tarorpc.GenesisInfo{
-				Version:              int32(balance.Version),
-				GenesisPoint:         balance.GenesisPoint.String(),
-				Name:                 balance.Tag,
-				Meta:                 balance.Meta,
-				AssetId:              balance.ID[:],
-				GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
-			},
-			AssetType: tarorpc.AssetType(balance.Type),
-			Balance:   int64(balance.Balance),
-		}
+		seedling.FamilyKey = famKey
 	}
 
-	return resp, nil
+	return seedling, nil
 }
 
-func (r *rpcServer) listBalancesByFamilyKey(ctx context.Context,
-	famKey *btcec.PublicKey) (*tarorpc.ListBalancesResponse, error) {
+// ListBatches lists the minting batches of the target daemon, including the
+// assets they create and their genesis transaction.
+func (r *rpcServer) ListBatches(_ context.Context,
+	req *tarorpc.ListBatchesRequest) (*tarorpc.ListBatchesResponse, error) {
 
-	balances, err := r.cfg.AssetStore.QueryAssetBalancesByFamily(
-		ctx, famKey,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+	var batchKey *btcec.PublicKey
+	if len(req.BatchKey) != 0 {
+		var err error
+		batchKey, err = btcec.ParsePubKey(req.BatchKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
+		}
 	}
 
-	resp := &tarorpc.ListBalancesResponse{
-		AssetFamilyBalances: make(
-			map[string]*tarorpc.AssetFamilyBalance, len(balances),
-		),
+	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
 	}
 
-	for _, balance := range balances {
-		var famKey []byte
-		if balance.FamKey != nil {
-			famKey = balance.FamKey.SerializeCompressed()
-		}
-
-		famKeyString := hex.EncodeToString(famKey)
-		resp.AssetFamilyBalances[famKeyString] = &tarorpc.AssetFamilyBalance{
-			FamilyKey: famKey,
-			Balance:   int64(balance.Balance),
+	rpcBatches := make([]*tarorpc.MintingBatch, len(batches))
+	for i, batch := range batches {
+		rpcBatches[i], err = marshalMintingBatch(batch)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return resp, nil
+	return &tarorpc.ListBatchesResponse{
+		Batches: rpcBatches,
+	}, nil
 }
 
-// ListBalances lists the asset balances owned by the daemon.
-func (r *rpcServer) ListBalances(ctx context.Context,
-	in *tarorpc.ListBalancesRequest) (*tarorpc.ListBalancesResponse, error) {
+// ListSeedlings lists the asset seedlings that are queued for minting, but
+// aren't yet part of a funded genesis transaction.
+func (r *rpcServer) ListSeedlings(_ context.Context,
+	_ *tarorpc.ListSeedlingsRequest) (*tarorpc.ListSeedlingsResponse,
+	error) {
 
-	switch groupBy := in.GroupBy.(type) {
-	case *tarorpc.ListBalancesRequest_AssetId:
-		if !groupBy.AssetId {
-			return nil, fmt.Errorf("invalid group_by")
-		}
+	batches, err := r.cfg.AssetMinter.ListBatches(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
+	}
 
-		var assetID *asset.ID
-		if len(in.AssetFilter) != 0 {
-			assetID = &asset.ID{}
-			if len(in.AssetFilter) != len(assetID) {
-				return nil, fmt.Errorf("invalid asset filter")
-			}
+	var rpcSeedlings []*tarorpc.PendingSeedling
+	for _, batch := range batches {
+		// Once a batch is committed, its seedlings have turned into
+		// sprouts that are part of the genesis transaction.
+		if batch.BatchState != tarogarden.BatchStatePending &&
+			batch.BatchState != tarogarden.BatchStateFrozen {
 
-			copy(assetID[:], in.AssetFilter)
+			continue
 		}
 
-		return r.listBalancesByAsset(ctx, assetID)
-
-	case *tarorpc.ListBalancesRequest_FamKey:
-		if !groupBy.FamKey {
-			return nil, fmt.Errorf("invalid group_by")
+		batchState, err := marshalBatchState(batch.BatchState)
+		if err != nil {
+			return nil, err
 		}
 
-		var famKey *btcec.PublicKey
-		if len(in.FamilyKeyFilter) != 0 {
-			var err error
-			famKey, err = btcec.ParsePubKey(in.FamilyKeyFilter)
-			if err != nil {
-				return nil, fmt.Errorf("invalid family key "+
-					"filter: %v", err)
-			}
+		for _, seedling := range sortedSeedlings(batch) {
+			rpcSeedlings = append(
+				rpcSeedlings, &tarorpc.PendingSeedling{
+					BatchKey: batch.BatchKey.PubKey.
+						SerializeCompressed(),
+					BatchState: batchState,
+					Asset:      marshalSeedling(seedling),
+				},
+			)
 		}
-
-		return r.listBalancesByFamilyKey(ctx, famKey)
-
-	default:
-		return nil, fmt.Errorf("invalid group_by")
 	}
+
+	return &tarorpc.ListSeedlingsResponse{
+		Seedlings: rpcSeedlings,
+	}, nil
 }
 
-// ListTransfers lists all asset transfers managed by this deamon.
-func (r *rpcServer) ListTransfers(ctx context.Context,
-	in *tarorpc.ListTransfersRequest) (*tarorpc.ListTransfersResponse,
+// CancelSeedling removes a seedling from the pending minting batch. If it's the
+// last seedling of the batch, the whole batch is cancelled.
+func (r *rpcServer) CancelSeedling(_ context.Context,
+	req *tarorpc.CancelSeedlingRequest) (*tarorpc.CancelSeedlingResponse,
 	error) {
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	if req.Name == "" {
+		return nil, fmt.Errorf("seedling name must be set")
 	}
 
-	resp := &tarorpc.ListTransfersResponse{
-		Transfers: make([]*tarorpc.AssetTransfer, 0, len(parcels)),
+	if err := r.cfg.AssetMinter.CancelSeedling(req.Name); err != nil {
+		return nil, fmt.Errorf("unable to cancel seedling: %w", err)
 	}
 
-	for _, parcel := range parcels {
-		deltas := make(
-			[]*tarorpc.AssetSpendDelta,
-			len(parcel.AssetSpendDeltas),
-		)
+	return &tarorpc.CancelSeedlingResponse{}, nil
+}
 
-		for i, delta := range parcel.AssetSpendDeltas {
-			senderProof := &proof.Proof{}
-			err := senderProof.Decode(
-				bytes.NewReader(delta.SenderAssetProof),
-			)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode "+
-					"sender proof: %w", err)
-			}
+// CancelBatch cancels a minting batch whose genesis transaction hasn't been
+// broadcast yet, and releases the wallet inputs locked for it.
+func (r *rpcServer) CancelBatch(_ context.Context,
+	req *tarorpc.CancelBatchRequest) (*tarorpc.CancelBatchResponse, error) {
 
-			assetID := senderProof.Asset.ID()
-			deltas[i] = &tarorpc.AssetSpendDelta{
-				AssetId:      assetID[:],
-				OldScriptKey: delta.OldScriptKey.SerializeCompressed(),
-				NewScriptKey: delta.NewScriptKey.PubKey.SerializeCompressed(),
-				NewAmt:       int64(delta.NewAmt),
-			}
+	var batchKey *btcec.PublicKey
+	if len(req.BatchKey) != 0 {
+		var err error
+		batchKey, err = btcec.ParsePubKey(req.BatchKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
 		}
+	}
 
-		anchorTxHash := parcel.AnchorTx.TxHash()
-		resp.Transfers = append(
-			resp.Transfers, &tarorpc.AssetTransfer{
-				TransferTimestamp: parcel.TransferTime.Unix(),
-				OldAnchorPoint:    parcel.OldAnchorPoint.String(),
-				NewAnchorPoint:    parcel.NewAnchorPoint.String(),
-				TaroRoot:          parcel.TaroRoot,
-				AnchorTxHash:      anchorTxHash[:],
-				AssetSpendDeltas:  deltas,
-			},
-		)
+	if err := r.cfg.AssetMinter.CancelBatch(batchKey); err != nil {
+		return nil, fmt.Errorf("unable to cancel batch: %w", err)
 	}
 
-	return resp, nil
+	return &tarorpc.CancelBatchResponse{}, nil
 }
 
-// QueryAddrs queries the set of Taro addresses stored in the database.
-func (r *rpcServer) QueryAddrs(ctx context.Context,
-	in *tarorpc.QueryAddrRequest) (*tarorpc.QueryAddrResponse, error) {
+// FinalizeBatch freezes the pending minting batch immediately, without waiting
+// for the next batch tick, and starts to mint its assets.
+func (r *rpcServer) FinalizeBatch(_ context.Context,
+	req *tarorpc.FinalizeBatchRequest) (*tarorpc.FinalizeBatchResponse,
+	error) {
 
-	query := address.QueryParams{
-		Limit:  in.Limit,
-		Offset: in.Offset,
+	// A fee rate below the relay fee floor would result in a genesis
+	// transaction that is never confirmed.
+	feeRate := chainfee.SatPerKWeight(req.FeeRate)
+	if feeRate != 0 && feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate must be at least %v",
+			chainfee.FeePerKwFloor)
+	}
+
+	batch, err := r.cfg.AssetMinter.FinalizeBatch(feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize batch: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tarorpc.FinalizeBatchResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// sortedSeedlings returns the seedlings of a batch, sorted by their name.
+func sortedSeedlings(batch *tarogarden.MintingBatch) []*tarogarden.Seedling {
+	seedlings := make([]*tarogarden.Seedling, 0, len(batch.Seedlings))
+	for _, seedling := range batch.Seedlings {
+		seedlings = append(seedlings, seedling)
+	}
+	sort.Slice(seedlings, func(i, j int) bool {
+		return seedlings[i].AssetName < seedlings[j].AssetName
+	})
+
+	return seedlings
+}
+
+// marshalMintingBatch converts a minting batch into its RPC counterpart.
+func marshalMintingBatch(
+	batch *tarogarden.MintingBatch) (*tarorpc.MintingBatch, error) {
+
+	batchState, err := marshalBatchState(batch.BatchState)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatch := &tarorpc.MintingBatch{
+		BatchKey:         batch.BatchKey.PubKey.SerializeCompressed(),
+		State:            batchState,
+		CreationTimeUnix: batch.CreationTime.Unix(),
+	}
+
+	// Depending on the state of the batch, we either know the final
+	// assets, or only the seedlings they're created from.
+	switch {
+	case batch.RootAssetCommitment != nil:
+		assets := batch.RootAssetCommitment.CommittedAssets()
+		sort.Slice(assets, func(i, j int) bool {
+			return assets[i].Genesis.Tag < assets[j].Genesis.Tag
+		})
+		for _, a := range assets {
+			rpcBatch.Assets = append(
+				rpcBatch.Assets, marshalBatchAsset(a),
+			)
+		}
+
+	default:
+		for _, seedling := range sortedSeedlings(batch) {
+			rpcBatch.Assets = append(
+				rpcBatch.Assets, marshalSeedling(seedling),
+			)
+		}
+	}
+
+	if batch.GenesisPacket != nil {
+		var psbtBuf bytes.Buffer
+		err := batch.GenesisPacket.Pkt.Serialize(&psbtBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize genesis "+
+				"psbt: %w", err)
+		}
+		rpcBatch.GenesisPsbt = psbtBuf.Bytes()
+		rpcBatch.GenesisTxid = batch.GenesisPacket.Pkt.UnsignedTx.
+			TxHash().String()
+
+		// The fee can only be computed if the packet contains the
+		// UTXO information of all inputs, which is the case for any
+		// packet funded by the wallet.
+		chainFees, err := tarogarden.GetTxFee(batch.GenesisPacket.Pkt)
+		if err == nil {
+			rpcBatch.ChainFeesSats = chainFees
+		}
+	}
+
+	return rpcBatch, nil
+}
+
+// marshalSeedling converts a seedling into the RPC representation of the
+// asset it will create.
+func marshalSeedling(seedling *tarogarden.Seedling) *tarorpc.BatchAsset {
+	meta := asset.DecodeMeta(seedling.Metadata)
+
+	rpcAsset := &tarorpc.BatchAsset{
+		AssetType:      tarorpc.AssetType(seedling.AssetType),
+		Name:           seedling.AssetName,
+		MetaData:       meta.Data,
+		MetaType:       tarorpc.AssetMetaType(meta.Type),
+		MetaMimeType:   meta.MimeType,
+		DecimalDisplay: meta.DecimalDisplay,
+		Amount:         int64(seedling.Amount),
+		EnableEmission: seedling.EnableEmission,
+	}
+	if seedling.FamilyKey != nil {
+		rpcAsset.FamilyKey = seedling.FamilyKey.SerializeCompressed()
+	}
+
+	return rpcAsset
+}
+
+// marshalBatchAsset converts an asset created by a committed batch into its
+// RPC counterpart.
+func marshalBatchAsset(a *asset.Asset) *tarorpc.BatchAsset {
+	meta := a.Genesis.Meta()
+	assetID := a.Genesis.ID()
+
+	rpcAsset := &tarorpc.BatchAsset{
+		AssetType:      tarorpc.AssetType(a.Type),
+		Name:           a.Genesis.Tag,
+		MetaData:       meta.Data,
+		MetaType:       tarorpc.AssetMetaType(meta.Type),
+		MetaMimeType:   meta.MimeType,
+		DecimalDisplay: meta.DecimalDisplay,
+		Amount:         int64(a.Amount),
+		EnableEmission: a.FamilyKey != nil,
+		AssetId:        assetID[:],
+	}
+	if a.FamilyKey != nil {
+		rpcAsset.FamilyKey = a.FamilyKey.FamKey.SerializeCompressed()
+	}
+
+	return rpcAsset
+}
+
+// marshalBatchState converts a batch state into its RPC counterpart.
+func marshalBatchState(state tarogarden.BatchState) (tarorpc.BatchState,
+	error) {
+
+	switch state {
+	case tarogarden.BatchStatePending:
+		return tarorpc.BatchState_BATCH_STATE_PENDING, nil
+
+	case tarogarden.BatchStateFrozen:
+		return tarorpc.BatchState_BATCH_STATE_FROZEN, nil
+
+	case tarogarden.BatchStateCommitted:
+		return tarorpc.BatchState_BATCH_STATE_COMMITTED, nil
+
+	case tarogarden.BatchStateBroadcast:
+		return tarorpc.BatchState_BATCH_STATE_BROADCAST, nil
+
+	case tarogarden.BatchStateConfirmed:
+		return tarorpc.BatchState_BATCH_STATE_CONFIRMED, nil
+
+	case tarogarden.BatchStateFinalized:
+		return tarorpc.BatchState_BATCH_STATE_FINALIZED, nil
+
+	case tarogarden.BatchStateCancelled:
+		return tarorpc.BatchState_BATCH_STATE_CANCELLED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state <%d>", state)
+	}
+}
+
+// ListAssets lists the set of assets owned by the target daemon.
+func (r *rpcServer) ListAssets(ctx context.Context,
+	req *tarorpc.ListAssetRequest) (*tarorpc.ListAssetResponse, error) {
+
+	query, err := unmarshalAssetQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The prior states of spent assets are only returned if explicitly
+	// requested, in which case they're paginated together with the
+	// unspent assets.
+	var (
+		assets      []*tarodb.ChainAsset
+		spentAssets []*tarodb.SpentAsset
+	)
+	assetStore := r.cfg.AssetStore
+	if req.IncludeSpent {
+		assets, spentAssets, err = assetStore.FetchAssetsWithSpent(
+			ctx, query,
+		)
+	} else {
+		assets, err = assetStore.FetchAllAssets(ctx, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	rpcAssets := make([]*tarorpc.Asset, len(assets))
+	for i, a := range assets {
+		rpcAssets[i], err = marshalChainAsset(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &tarorpc.ListAssetResponse{
+		Assets: rpcAssets,
+	}
+	if !req.IncludeSpent {
+		return resp, nil
+	}
+
+	resp.SpentAssets = make([]*tarorpc.SpentAsset, len(spentAssets))
+	for i, spent := range spentAssets {
+		resp.SpentAssets[i] = marshalSpentAsset(spent)
+	}
+
+	return resp, nil
+}
+
+// marshalSpentAsset converts a spent asset into its RPC counterpart.
+func marshalSpentAsset(spent *tarodb.SpentAsset) *tarorpc.SpentAsset {
+	rpcSpent := &tarorpc.SpentAsset{
+		ScriptKey:      spent.ScriptKey.SerializeCompressed(),
+		Amount:         int64(spent.Amount),
+		ChangeAmount:   int64(spent.ChangeAmount),
+		AnchorOutpoint: spent.AnchorOutpoint.String(),
+		SpendTxid:      spent.SpendTxid[:],
+		Confirmed:      spent.Confirmed,
+		SpendTimeUnix:  spent.SpendTime.Unix(),
+	}
+
+	if spent.Genesis != nil {
+		assetID := spent.Genesis.ID()
+		rpcSpent.AssetGenesis = &tarorpc.GenesisInfo{
+			GenesisPoint:   spent.Genesis.FirstPrevOut.String(),
+			Name:           spent.Genesis.Tag,
+			Meta:           spent.Genesis.Metadata,
+			AssetId:        assetID[:],
+			OutputIndex:    spent.Genesis.OutputIndex,
+			DecimalDisplay: spent.Genesis.Meta().DecimalDisplay,
+		}
+		rpcSpent.AssetType = tarorpc.AssetType(spent.Genesis.Type)
+	}
+
+	if spent.FamilyKey != nil {
+		rpcSpent.FamilyKey = spent.FamilyKey.SerializeCompressed()
+	}
+
+	return rpcSpent
+}
+
+// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+// hold.
+func (r *rpcServer) ListUtxos(ctx context.Context,
+	_ *tarorpc.ListUtxosRequest) (*tarorpc.ListUtxosResponse, error) {
+
+	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read managed utxos: %w", err)
+	}
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	rpcUtxos := make(map[string]*tarorpc.ManagedUtxo, len(managedUtxos))
+	for _, u := range managedUtxos {
+		rpcUtxos[u.OutPoint.String()] = &tarorpc.ManagedUtxo{
+			OutPoint:         u.OutPoint.String(),
+			AmtSat:           int64(u.OutputValue),
+			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
+			TaroRoot:         u.TaroRoot,
+			TapscriptSibling: u.TapscriptSibling,
+		}
+	}
+
+	// Now that we have all the managed UTXOs, we can group the assets by
+	// the outpoint that anchors them.
+	for _, a := range assets {
+		anchorPoint := a.AnchorOutpoint.String()
+		rpcUtxo, ok := rpcUtxos[anchorPoint]
+		if !ok {
+			return nil, fmt.Errorf("unable to find utxo %v that "+
+				"anchors asset %v", anchorPoint, a.ID())
+		}
+
+		rpcAsset, err := marshalChainAsset(a)
+		if err != nil {
+			return nil, err
+		}
+		rpcUtxo.Assets = append(rpcUtxo.Assets, rpcAsset)
+	}
+
+	return &tarorpc.ListUtxosResponse{
+		ManagedUtxos: rpcUtxos,
+	}, nil
+}
+
+// ListLeases lists the managed UTXOs that are currently leased.
+func (r *rpcServer) ListLeases(ctx context.Context,
+	_ *tarorpc.ListLeasesRequest) (*tarorpc.ListLeasesResponse, error) {
+
+	leasedUtxos, err := r.cfg.AssetStore.ListLeases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list leases: %w", err)
+	}
+
+	rpcLeases := make([]*tarorpc.UtxoLease, len(leasedUtxos))
+	for i, u := range leasedUtxos {
+		rpcLeases[i] = &tarorpc.UtxoLease{
+			OutPoint:   u.OutPoint.String(),
+			LeaseOwner: u.LeaseOwner,
+			Expiry:     u.LeaseExpiry.Unix(),
+		}
+	}
+
+	return &tarorpc.ListLeasesResponse{
+		Leases: rpcLeases,
+	}, nil
+}
+
+// ReleaseLease releases the lease on a managed UTXO, which makes the assets
+// anchored at it available for coin selection again.
+func (r *rpcServer) ReleaseLease(ctx context.Context,
+	req *tarorpc.ReleaseLeaseRequest) (*tarorpc.ReleaseLeaseResponse,
+	error) {
+
+	outPoint, err := parseOutPoint(req.OutPoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %w", err)
+	}
+
+	// The lease on the input of a send that was already logged to disk
+	// can't be released, as the send might already be broadcast.
+	if err := r.cfg.AssetStore.ReleaseLease(ctx, outPoint); err != nil {
+		return nil, fmt.Errorf("unable to release lease: %w", err)
+	}
+
+	return &tarorpc.ReleaseLeaseResponse{}, nil
+}
+
+// marshalChainAsset converts a chain asset into its RPC counterpart.
+func marshalChainAsset(a *tarodb.ChainAsset) (*tarorpc.Asset, error) {
+	assetID := a.Genesis.ID()
+
+	var bootstrapInfoBuf bytes.Buffer
+	if err := a.Genesis.Encode(&bootstrapInfoBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode genesis: %w", err)
+	}
+
+	var anchorTxBytes []byte
+	if a.AnchorTx != nil {
+		var anchorTxBuf bytes.Buffer
+		err := a.AnchorTx.Serialize(&anchorTxBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize "+
+				"anchor tx: %w", err)
+		}
+		anchorTxBytes = anchorTxBuf.Bytes()
+	}
+
+	meta := a.Genesis.Meta()
+	var metaJSON string
+	if meta.Type == asset.MetaJSON {
+		metaJSON = string(meta.Data)
+	}
+
+	rpcAsset := &tarorpc.Asset{
+		Version: int32(a.Version),
+		AssetGenesis: &tarorpc.GenesisInfo{
+			GenesisPoint:         a.Genesis.FirstPrevOut.String(),
+			Name:                 a.Genesis.Tag,
+			Meta:                 a.Genesis.Metadata,
+			AssetId:              assetID[:],
+			OutputIndex:          a.Genesis.OutputIndex,
+			GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
+			MetaType:             tarorpc.AssetMetaType(meta.Type),
+			MetaMimeType:         meta.MimeType,
+			MetaJson:             metaJSON,
+			DecimalDisplay:       meta.DecimalDisplay,
+		},
+		AssetType:        tarorpc.AssetType(a.Type),
+		Amount:           int64(a.Amount),
+		LockTime:         int32(a.LockTime),
+		RelativeLockTime: int32(a.RelativeLockTime),
+		ScriptVersion:    int32(a.ScriptVersion),
+		ScriptKey:        a.ScriptKey.PubKey.SerializeCompressed(),
+		ChainAnchor: &tarorpc.AnchorInfo{
+			AnchorTx:        anchorTxBytes,
+			AnchorTxid:      a.AnchorTxid[:],
+			AnchorBlockHash: a.AnchorBlockHash[:],
+			AnchorOutpoint:  a.AnchorOutpoint.String(),
+			InternalKey:     a.AnchorInternalKey.SerializeCompressed(),
+		},
+		DisplayAmount: asset.DisplayAmount(
+			a.Amount, meta.DecimalDisplay,
+		),
+	}
+
+	if a.FamilyKey != nil {
+		rpcAsset.AssetFamily = &tarorpc.AssetFamily{
+			RawFamilyKey:     a.FamilyKey.RawKey.PubKey.SerializeCompressed(),
+			TweakedFamilyKey: a.FamilyKey.FamKey.SerializeCompressed(),
+			AssetIdSig:       a.FamilyKey.Sig.Serialize(),
+		}
+	}
+
+	return rpcAsset, nil
+}
+
+// unmarshalAssetQuery parses the filters of a ListAssets request into an
+// asset query.
+func unmarshalAssetQuery(
+	req *tarorpc.ListAssetRequest) (*tarodb.AssetQueryFilters, error) {
+
+	query := &tarodb.AssetQueryFilters{
+		ConfirmedOnly:   req.ConfirmedOnly,
+		UnconfirmedOnly: req.UnconfirmedOnly,
+	}
+
+	if len(req.AssetId) != 0 {
+		var assetID asset.ID
+		if len(req.AssetId) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+		copy(assetID[:], req.AssetId)
+
+		query.AssetID = &assetID
+	}
+
+	if len(req.FamilyKey) != 0 {
+		famKey, err := btcec.ParsePubKey(req.FamilyKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid family key: %w", err)
+		}
+
+		query.FamilyKey = famKey
+	}
+
+	typeFilter, ok := req.TypeFilter.(*tarorpc.ListAssetRequest_AssetType)
+	if ok {
+		assetType := asset.Type(typeFilter.AssetType)
+		query.AssetType = &assetType
+	}
+
+	if req.AnchorOutpoint != "" {
+		anchorPoint, err := parseOutPoint(req.AnchorOutpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor outpoint: %w",
+				err)
+		}
+
+		query.AnchorPoint = &anchorPoint
+	}
+
+	switch {
+	case req.MinAmount < 0:
+		return nil, fmt.Errorf("min amount cannot be negative")
+
+	case req.Offset < 0:
+		return nil, fmt.Errorf("offset cannot be negative")
+
+	case req.Limit < 0:
+		return nil, fmt.Errorf("limit cannot be negative")
+	}
+
+	query.MinAmt = uint64(req.MinAmount)
+	query.Offset = req.Offset
+	query.Limit = req.Limit
+
+	return query, nil
+}
+
+// parseOutPoint parses an outpoint from its string representation, which is
+// of the form txid:vout.
+func parseOutPoint(s string) (wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return wire.OutPoint{}, fmt.Errorf("outpoint must be of the " +
+			"form txid:vout")
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("invalid txid: %w", err)
+	}
+
+	vout, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("invalid vout: %w", err)
+	}
+
+	return wire.OutPoint{
+		Hash:  *txid,
+		Index: uint32(vout),
+	}, nil
+}
+
+func (r *rpcServer) listBalancesByAsset(ctx context.Context,
+	assetID *asset.ID) (*tarorpc.ListBalancesResponse, error) {
+
+	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
+
+	resp := &tarorpc.ListBalancesResponse{
+		AssetBalances: make(map[string]*tarorpc.AssetBalance, len(balances)),
+	}
+
+	for _, balance := range balances {
+		assetIDStr := hex.EncodeToString(balance.ID[:])
+
+		gen := asset.Genesis{
+			FirstPrevOut: balance.GenesisPoint,
+			Tag:          balance.Tag,
+			Metadata:     balance.Meta,
+			OutputIndex:  balance.OutputIndex,
+			Type:         balance.Type,
+		}
+		var bootstrapInfoBuf bytes.Buffer
+		if err := gen.Encode(&bootstrapInfoBuf); err != nil {
+			return nil, fmt.Errorf("unable to encode genesis: %w",
+				err)
+		}
+
+		resp.AssetBalances[assetIDStr] = &tarorpc.AssetBalance{
+			AssetGenesis: &tarorpc.GenesisInfo{
+				Version:              int32(balance.Version),
+				GenesisPoint:         balance.GenesisPoint.String(),
+				Name:                 balance.Tag,
+				Meta:                 balance.Meta,
+				AssetId:              balance.ID[:],
+				GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
+				DecimalDisplay:       balance.DecimalDisplay,
+			},
+			AssetType:      tarorpc.AssetType(balance.Type),
+			Balance:        int64(balance.Balance),
+			DecimalDisplay: balance.DecimalDisplay,
+			DisplayBalance: asset.DisplayAmount(
+				balance.Balance, balance.DecimalDisplay,
+			),
+		}
+	}
+
+	return resp, nil
+}
+
+func (r *rpcServer) listBalancesByFamilyKey(ctx context.Context,
+	famKey *btcec.PublicKey) (*tarorpc.ListBalancesResponse, error) {
+
+	balances, err := r.cfg.AssetStore.QueryAssetBalancesByFamily(
+		ctx, famKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
+
+	resp := &tarorpc.ListBalancesResponse{
+		AssetFamilyBalances: make(
+			map[string]*tarorpc.AssetFamilyBalance, len(balances),
+		),
+	}
+
+	for _, balance := range balances {
+		var famKey []byte
+		if balance.FamKey != nil {
+			famKey = balance.FamKey.SerializeCompressed()
+		}
+
+		famKeyString := hex.EncodeToString(famKey)
+		resp.AssetFamilyBalances[famKeyString] = &tarorpc.AssetFamilyBalance{
+			FamilyKey:      famKey,
+			Balance:        int64(balance.Balance),
+			DecimalDisplay: balance.DecimalDisplay,
+			DisplayBalance: asset.DisplayAmount(
+				balance.Balance, balance.DecimalDisplay,
+			),
+		}
+	}
+
+	return resp, nil
+}
+
+// ListBalances lists the asset balances owned by the daemon.
+func (r *rpcServer) ListBalances(ctx context.Context,
+	in *tarorpc.ListBalancesRequest) (*tarorpc.ListBalancesResponse, error) {
+
+	switch groupBy := in.GroupBy.(type) {
+	case *tarorpc.ListBalancesRequest_AssetId:
+		if !groupBy.AssetId {
+			return nil, fmt.Errorf("invalid group_by")
+		}
+
+		var assetID *asset.ID
+		if len(in.AssetFilter) != 0 {
+			assetID = &asset.ID{}
+			if len(in.AssetFilter) != len(assetID) {
+				return nil, fmt.Errorf("invalid asset filter")
+			}
+
+			copy(assetID[:], in.AssetFilter)
+		}
+
+		return r.listBalancesByAsset(ctx, assetID)
+
+	case *tarorpc.ListBalancesRequest_FamKey:
+		if !groupBy.FamKey {
+			return nil, fmt.Errorf("invalid group_by")
+		}
+
+		var famKey *btcec.PublicKey
+		if len(in.FamilyKeyFilter) != 0 {
+			var err error
+			famKey, err = btcec.ParsePubKey(in.FamilyKeyFilter)
+			if err != nil {
+				return nil, fmt.Errorf("invalid family key "+
+					"filter: %v", err)
+			}
+		}
+
+		return r.listBalancesByFamilyKey(ctx, famKey)
+
+	default:
+		return nil, fmt.Errorf("invalid group_by")
+	}
+}
+
+// ListTransfers lists all asset transfers managed by this deamon.
+func (r *rpcServer) ListTransfers(ctx context.Context,
+	in *tarorpc.ListTransfersRequest) (*tarorpc.ListTransfersResponse,
+	error) {
+
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	}
+
+	resp := &tarorpc.ListTransfersResponse{
+		Transfers: make([]*tarorpc.AssetTransfer, 0, len(parcels)),
+	}
+
+	for _, parcel := range parcels {
+		deltas := make(
+			[]*tarorpc.AssetSpendDelta,
+			len(parcel.AssetSpendDeltas),
+		)
+
+		for i, delta := range parcel.AssetSpendDeltas {
+			senderProof := &proof.Proof{}
+			err := senderProof.Decode(
+				bytes.NewReader(delta.SenderAssetProof),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"sender proof: %w", err)
+			}
+
+			assetID := senderProof.Asset.ID()
+			deltas[i] = &tarorpc.AssetSpendDelta{
+				AssetId:      assetID[:],
+				OldScriptKey: delta.OldScriptKey.SerializeCompressed(),
+				NewScriptKey: delta.NewScriptKey.PubKey.SerializeCompressed(),
+				NewAmt:       int64(delta.NewAmt),
+			}
+		}
+
+		anchorTxHash := parcel.AnchorTx.TxHash()
+		resp.Transfers = append(
+			resp.Transfers, &tarorpc.AssetTransfer{
+				TransferTimestamp: parcel.TransferTime.Unix(),
+				OldAnchorPoint:    parcel.OldAnchorPoint.String(),
+				NewAnchorPoint:    parcel.NewAnchorPoint.String(),
+				TaroRoot:          parcel.TaroRoot,
+				AnchorTxHash:      anchorTxHash[:],
+				AssetSpendDeltas:  deltas,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// QueryAddrs queries the set of Taro addresses stored in the database.
+func (r *rpcServer) QueryAddrs(ctx context.Context,
+	in *tarorpc.QueryAddrRequest) (*tarorpc.QueryAddrResponse, error) {
+
+	if in.ExpiredOnly && in.ActiveOnly {
+		return nil, fmt.Errorf("cannot query for expired and active " +
+			"addresses at the same time")
+	}
+
+	if in.TagValue != "" && in.TagKey == "" {
+		return nil, fmt.Errorf("cannot query for a tag value without " +
+			"a tag key")
+	}
+
+	query := address.QueryParams{
+		Limit:       in.Limit,
+		Offset:      in.Offset,
+		ExpiredOnly: in.ExpiredOnly,
+		ActiveOnly:  in.ActiveOnly,
+		Label:       in.Label,
+		TagKey:      in.TagKey,
+		TagValue:    in.TagValue,
 	}
 
 	// The unix time of 0 (1970-01-01) is not the same as an empty Time
@@ -594,7 +1361,7 @@ func (r *rpcServer) QueryAddrs(ctx context.Context,
 	addrs := make([]*tarorpc.Addr, len(dbAddrs))
 	for i, dbAddr := range dbAddrs {
 		dbAddr.ChainParams = &taroParams
-		addrs[i], err = marshalAddr(dbAddr.Taro)
+		addrs[i], err = marshalAddrWithKeyInfo(&dbAddr)
 		if err != nil {
 			return nil, fmt.Errorf("unable to marshal addr: %w",
 				err)
@@ -638,10 +1405,21 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v, "+
 		"type=%v", assetID[:], in.Amt, asset.Type(genesis.Type))
 
+	// The expiry is optional, the zero time means the address never
+	// expires.
+	var expiry time.Time
+	if in.Expiry < 0 {
+		return nil, fmt.Errorf("expiry cannot be negative")
+	}
+	if in.Expiry > 0 {
+		expiry = time.Unix(in.Expiry, 0)
+	}
+
 	// Now that we have all the params, we'll try to add a new address to
 	// the addr book.
 	addr, err := r.cfg.AddrBook.NewAddress(
-		ctx, genesis, famKey, uint64(in.Amt),
+		ctx, genesis, famKey, uint64(in.Amt), expiry, in.MaxReceives,
+		in.Label, in.Tags,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make new addr: %w", err)
@@ -649,7 +1427,7 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 
 	// With our addr obtained, we'll marshal it as an RPC message then send
 	// off the response.
-	rpcAddr, err := marshalAddr(addr.Taro)
+	rpcAddr, err := marshalAddrWithKeyInfo(addr)
 	if err != nil {
 		return nil, fmt.Errorf("unable to marshal addr: %w", err)
 	}
@@ -765,56 +1543,334 @@ func (r *rpcServer) ImportProof(ctx context.Context,
 	return &tarorpc.ImportProofResponse{}, nil
 }
 
+// DebugVerifyTransition replays a single state transition of the given proof
+// file and returns each step the Taro VM took while validating it.
+func (r *rpcServer) DebugVerifyTransition(ctx context.Context,
+	in *tarorpc.DebugVerifyTransitionRequest) (
+	*tarorpc.DebugVerifyTransitionResponse, error) {
+
+	if len(in.RawProof) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	var proofFile proof.File
+	err := proofFile.Decode(bytes.NewReader(in.RawProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	if proofFile.IsEmpty() {
+		return nil, fmt.Errorf("proof file is empty")
+	}
+
+	// A negative index means we should replay the last transition of the
+	// file.
+	proofIndex := uint32(proofFile.NumProofs() - 1)
+	if in.ProofIndex >= 0 {
+		proofIndex = uint32(in.ProofIndex)
+	}
+
+	rpcsLog.Debugf("[DebugVerifyTransition]: replaying transition %d of "+
+		"%d", proofIndex, proofFile.NumProofs())
+
+	recorder := vm.NewStepRecorder()
+	_, verifyErr := proofFile.VerifyTransition(ctx, proofIndex, recorder)
+
+	resp := &tarorpc.DebugVerifyTransitionResponse{
+		Valid:      verifyErr == nil,
+		ProofIndex: proofIndex,
+		Steps:      make([]*tarorpc.VMStep, len(recorder.Steps)),
+	}
+	if verifyErr != nil {
+		resp.Error = verifyErr.Error()
+	}
+
+	for idx, step := range recorder.Steps {
+		resp.Steps[idx], err = marshalVMStep(step)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling VM step: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// marshalVMStep turns a VM execution step into its RPC counterpart.
+func marshalVMStep(step vm.Step) (*tarorpc.VMStep, error) {
+	var stepType tarorpc.VMStepType
+	switch step.Type {
+	case vm.StepGenesisValidation:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_GENESIS_VALIDATION
+
+	case vm.StepSplitValidation:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_SPLIT_VALIDATION
+
+	case vm.StepInflationCheck:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_INFLATION_CHECK
+
+	case vm.StepGenesisMatch:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_GENESIS_MATCH
+
+	case vm.StepWitnessCheck:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_WITNESS_CHECK
+
+	case vm.StepScriptOpcode:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_SCRIPT_OPCODE
+
+	case vm.StepScriptResult:
+		stepType = tarorpc.VMStepType_VM_STEP_TYPE_SCRIPT_RESULT
+
+	default:
+		return nil, fmt.Errorf("unknown VM step type <%d>", step.Type)
+	}
+
+	rpcStep := &tarorpc.VMStep{
+		StepType:    stepType,
+		InputIndex:  step.InputIndex,
+		Description: step.Description,
+		Opcode:      step.Opcode,
+		Stack:       step.Stack,
+		AltStack:    step.AltStack,
+	}
+	if step.Err != nil {
+		rpcStep.Error = step.Err.Error()
+	}
+
+	return rpcStep, nil
+}
+
 // AddrReceives lists all receives for incoming asset transfers for addresses
 // that were created previously.
 func (r *rpcServer) AddrReceives(ctx context.Context,
 	in *tarorpc.AddrReceivesRequest) (*tarorpc.AddrReceivesResponse,
 	error) {
 
-	var sqlQuery address.EventQueryParams
+	var (
+		sqlQuery address.EventQueryParams
+		err      error
+	)
+
+	if len(in.FilterAddr) > 0 {
+		sqlQuery.AddrTaprootOutputKey, err = r.addrTaprootOutputKey(
+			in.FilterAddr,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filterStatus := in.FilterStatus !=
+		tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	if filterStatus && in.FailedOnly {
+		return nil, fmt.Errorf("cannot filter by status and only " +
+			"failed receives at the same time")
+	}
+
+	switch {
+	case filterStatus:
+		status, err := unmarshalAddrEventStatus(in.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
+
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
+
+	// All failure statuses come after the completed status, so they can
+	// be queried as a single range.
+	case in.FailedOnly:
+		from := address.StatusExpired
+		to := address.StatusTransactionFailed
+		sqlQuery.StatusFrom = &from
+		sqlQuery.StatusTo = &to
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	resp := &tarorpc.AddrReceivesResponse{
+		Events: make([]*tarorpc.AddrEvent, len(events)),
+	}
+
+	for idx, event := range events {
+		resp.Events[idx], err = marshalAddrEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// addrTaprootOutputKey decodes the given Taro address and returns the
+// serialized Taproot output key assets must be sent to on chain to be received
+// by it.
+func (r *rpcServer) addrTaprootOutputKey(addrStr string) ([]byte, error) {
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(addrStr, &taroParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	taprootOutputKey, err := addr.TaprootOutputKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving Taproot key: %w", err)
+	}
+
+	return schnorr.SerializePubKey(taprootOutputKey), nil
+}
+
+// SubscribeReceiveEvents streams status changes of inbound asset transfers to
+// the client, optionally filtered by address or status.
+func (r *rpcServer) SubscribeReceiveEvents(
+	in *tarorpc.SubscribeReceiveEventsRequest,
+	stream tarorpc.Taro_SubscribeReceiveEventsServer) error {
 
+	var (
+		query        address.EventQueryParams
+		addrKey      []byte
+		filterStatus *address.Status
+		err          error
+	)
 	if len(in.FilterAddr) > 0 {
-		taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+		addrKey, err = r.addrTaprootOutputKey(in.FilterAddr)
+		if err != nil {
+			return err
+		}
+
+		query.AddrTaprootOutputKey = addrKey
+	}
 
-		addr, err := address.DecodeAddress(in.FilterAddr, &taroParams)
+	unknownStatus := tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	if in.FilterStatus != unknownStatus {
+		status, err := unmarshalAddrEventStatus(in.FilterStatus)
+		if err != nil {
+			return fmt.Errorf("error parsing status: %w", err)
+		}
+
+		filterStatus = &status
+		query.StatusFrom = &status
+		query.StatusTo = &status
+	}
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = r.cfg.AddrBook.RegisterEventSubscriber(
+		eventSub, in.IncludeExisting, query,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to events: %w", err)
+	}
+	defer func() {
+		err := r.cfg.AddrBook.RemoveEventSubscriber(eventSub)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
+			rpcsLog.Errorf("Unable to remove event subscriber: %v",
+				err)
+		}
+	}()
+
+	for {
+		select {
+		case event := <-eventSub.NewItemCreated.ChanOut():
+			if filterStatus != nil &&
+				event.Status != *filterStatus {
+
+				continue
+			}
+
+			eventKey := schnorr.SerializePubKey(
+				&event.Addr.TaprootOutputKey,
+			)
+			if addrKey != nil && !bytes.Equal(addrKey, eventKey) {
+				continue
+			}
+
+			rpcEvent, err := marshalAddrEvent(event)
+			if err != nil {
+				return fmt.Errorf("error marshaling event: %w",
+					err)
+			}
+
+			if err := stream.Send(rpcEvent); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-r.quit:
+			return nil
 		}
+	}
+}
+
+// ExportAddrs exports all Taro addresses, including the key information needed
+// to re-derive their keys, as a versioned address export file.
+func (r *rpcServer) ExportAddrs(ctx context.Context,
+	_ *tarorpc.ExportAddrsRequest) (*tarorpc.ExportAddrsResponse, error) {
+
+	addrs, err := r.cfg.AddrBook.ListAddrs(ctx, address.QueryParams{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
 
-		taprootOutputKey, err := addr.TaprootOutputKey(nil)
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
-				err)
-		}
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
 
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
-		)
+	var b bytes.Buffer
+	err = address.EncodeExport(&b, &taroParams, addrs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode addrs: %w", err)
 	}
 
-	if in.FilterStatus != tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(in.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+	rpcsLog.Infof("[ExportAddrs]: exported %v addrs", len(addrs))
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
+	return &tarorpc.ExportAddrsResponse{
+		AddrFile: b.Bytes(),
+		NumAddrs: uint32(len(addrs)),
+	}, nil
+}
+
+// ImportAddrs imports the Taro addresses of an address export file. Addresses
+// that aren't known yet are imported into the wallet again by the custodian.
+func (r *rpcServer) ImportAddrs(ctx context.Context,
+	in *tarorpc.ImportAddrsRequest) (*tarorpc.ImportAddrsResponse, error) {
+
+	if len(in.AddrFile) == 0 {
+		return nil, fmt.Errorf("must specify an addr file")
 	}
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addrs, err := address.DecodeExport(
+		bytes.NewReader(in.AddrFile), &taroParams,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+		return nil, fmt.Errorf("unable to decode addr file: %w", err)
 	}
 
-	resp := &tarorpc.AddrReceivesResponse{
-		Events: make([]*tarorpc.AddrEvent, len(events)),
+	imported, err := r.cfg.AddrBook.ImportAddrs(ctx, addrs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import addrs: %w", err)
 	}
 
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(event)
+	rpcsLog.Infof("[ImportAddrs]: imported %v addrs, skipped %v known "+
+		"addrs", len(imported), len(addrs)-len(imported))
+
+	resp := &tarorpc.ImportAddrsResponse{
+		ImportedAddrs: make([]*tarorpc.Addr, len(imported)),
+		NumSkipped:    uint32(len(addrs) - len(imported)),
+	}
+	for idx := range imported {
+		resp.ImportedAddrs[idx], err = marshalAddrWithKeyInfo(
+			&imported[idx],
+		)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
+			return nil, fmt.Errorf("unable to marshal addr: %w",
 				err)
 		}
 	}
@@ -822,6 +1878,28 @@ func (r *rpcServer) AddrReceives(ctx context.Context,
 	return resp, nil
 }
 
+// RescanAddrs replays all wallet transactions starting at the given block
+// height and inspects them for inbound asset transfers to our addresses that
+// were missed before. The progress of the rescan is streamed to the client.
+func (r *rpcServer) RescanAddrs(in *tarorpc.RescanAddrsRequest,
+	stream tarorpc.Taro_RescanAddrsServer) error {
+
+	rpcsLog.Infof("[RescanAddrs]: rescanning from height %v",
+		in.StartHeight)
+
+	return r.cfg.AssetCustodian.RescanAddrs(
+		stream.Context(), in.StartHeight,
+		func(progress tarogarden.RescanProgress) error {
+			return stream.Send(&tarorpc.RescanAddrsResponse{
+				StartHeight:  progress.StartHeight,
+				NumTxns:      progress.NumTxns,
+				NumInspected: progress.NumInspected,
+				Height:       progress.Height,
+			})
+		},
+	)
+}
+
 // marshalAddr turns an address into its RPC counterpart.
 func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 	addrStr, err := addr.EncodeAddress()
@@ -835,7 +1913,11 @@ func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 			err)
 	}
 
+	// The address contains the full genesis of the asset, so we can show
+	// the amount with the decimal display committed to in its metadata,
+	// even if we don't know the asset yet.
 	id := addr.ID()
+	decimalDisplay := addr.Genesis.Meta().DecimalDisplay
 	rpcAddr := &tarorpc.Addr{
 		Encoded:          addrStr,
 		AssetId:          id[:],
@@ -844,6 +1926,10 @@ func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
 		InternalKey:      addr.InternalKey.SerializeCompressed(),
 		TaprootOutputKey: schnorr.SerializePubKey(taprootOutputKey),
+		DecimalDisplay:   decimalDisplay,
+		DisplayAmount: asset.DisplayAmount(
+			addr.Amount, decimalDisplay,
+		),
 	}
 
 	if addr.FamilyKey != nil {
@@ -853,9 +1939,29 @@ func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 	return rpcAddr, nil
 }
 
+// marshalAddrWithKeyInfo turns an address of our own address book into its RPC
+// counterpart, including the receive restrictions of the address.
+func marshalAddrWithKeyInfo(addr *address.AddrWithKeyInfo) (*tarorpc.Addr,
+	error) {
+
+	rpcAddr, err := marshalAddr(addr.Taro)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.Expiry.IsZero() {
+		rpcAddr.Expiry = addr.Expiry.Unix()
+	}
+	rpcAddr.MaxReceives = addr.MaxReceives
+	rpcAddr.Label = addr.Label
+	rpcAddr.Tags = addr.Tags
+
+	return rpcAddr, nil
+}
+
 // marshalAddrEvent turns an address event into its RPC counterpart.
 func marshalAddrEvent(event *address.Event) (*tarorpc.AddrEvent, error) {
-	rpcAddr, err := marshalAddr(event.Addr.Taro)
+	rpcAddr, err := marshalAddrWithKeyInfo(event.Addr)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling addr: %w", err)
 	}
@@ -874,6 +1980,8 @@ func marshalAddrEvent(event *address.Event) (*tarorpc.AddrEvent, error) {
 		TaprootSibling:          event.TapscriptSibling,
 		ConfirmationHeight:      event.ConfirmationHeight,
 		HasProof:                event.HasProof,
+		AssetAmount:             event.AssetAmount,
+		FailureReason:           event.FailureReason,
 	}, nil
 }
 
@@ -895,6 +2003,21 @@ func unmarshalAddrEventStatus(
 	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
 		return address.StatusCompleted, nil
 
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_EXPIRED:
+		return address.StatusExpired, nil
+
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_MAX_RECEIVES_EXCEEDED:
+		return address.StatusMaxReceivesExceeded, nil
+
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_INVALID:
+		return address.StatusProofInvalid, nil
+
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_ASSET_MISMATCH:
+		return address.StatusAssetMismatch, nil
+
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FAILED:
+		return address.StatusTransactionFailed, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address event status <%d>",
 			rpcStatus)
@@ -922,6 +2045,25 @@ func marshalAddrEventStatus(status address.Status) (tarorpc.AddrEventStatus,
 	case address.StatusCompleted:
 		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
 
+	case address.StatusExpired:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_EXPIRED, nil
+
+	case address.StatusMaxReceivesExceeded:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_MAX_RECEIVES_EXCEEDED,
+			nil
+
+	case address.StatusProofInvalid:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_INVALID,
+			nil
+
+	case address.StatusAssetMismatch:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_ASSET_MISMATCH,
+			nil
+
+	case address.StatusTransactionFailed:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FAILED,
+			nil
+
 	default:
 		return 0, fmt.Errorf("unknown address event status <%d>",
 			status)
@@ -934,23 +2076,157 @@ func marshalAddrEventStatus(status address.Status) (tarorpc.AddrEventStatus,
 func (r *rpcServer) SendAsset(ctx context.Context,
 	in *tarorpc.SendAssetRequest) (*tarorpc.SendAssetResponse, error) {
 
-	if in.TaroAddr == "" {
+	parcel, err := r.unmarshalAssetParcel(
+		in.TaroAddr, in.Amt, in.CoinSelectStrategy, in.InputOutpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(parcel)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalPendingParcel(resp)
+}
+
+// EstimateSend does a dry run of an asset send to a taro address. It returns
+// the asset input that would be spent, whether the input needs to be split,
+// the resulting asset outputs and the estimated on-chain fee, without
+// committing to the send.
+func (r *rpcServer) EstimateSend(ctx context.Context,
+	in *tarorpc.EstimateSendRequest) (*tarorpc.EstimateSendResponse,
+	error) {
+
+	parcel, err := r.unmarshalAssetParcel(
+		in.TaroAddr, in.Amt, in.CoinSelectStrategy, in.InputOutpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := r.cfg.ChainPorter.EstimateSend(parcel)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*tarorpc.EstimatedOutput, len(estimate.Outputs))
+	for i, output := range estimate.Outputs {
+		output := output
+
+		outputs[i] = &tarorpc.EstimatedOutput{
+			AnchorOutputIndex: output.AnchorOutputIndex,
+			AssetId:           output.ID[:],
+			ScriptKey:         output.ScriptKey[:],
+			Amount:            int64(output.Amount),
+			IsChange:          output.IsChange,
+		}
+	}
+
+	input := estimate.Input
+	return &tarorpc.EstimateSendResponse{
+		Input: &tarorpc.PrevInputAsset{
+			AnchorPoint: input.PrevID.OutPoint.String(),
+			AssetId:     input.PrevID.ID[:],
+			ScriptKey:   input.PrevID.ScriptKey[:],
+			Amount:      int64(input.Amount),
+		},
+		NeedsSplit:   estimate.NeedsSplit,
+		Outputs:      outputs,
+		SatPerKw:     int64(estimate.FeeRate),
+		TotalFeeSats: int64(estimate.TotalFees),
+	}, nil
+}
+
+// unmarshalAssetParcel parses the parameters of a send request into an asset
+// parcel for the chain porter.
+func (r *rpcServer) unmarshalAssetParcel(addr string, amt int64,
+	rpcStrategy tarorpc.CoinSelectStrategy,
+	inputOutpoints []string) (*tarofreighter.AssetParcel, error) {
+
+	if addr == "" {
 		return nil, fmt.Errorf("addr must be set")
 	}
 
 	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
-	taroAddr, err := address.DecodeAddress(in.TaroAddr, &taroParams)
+	taroAddr, err := address.DecodeAddress(addr, &taroParams)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(&tarofreighter.AssetParcel{
-		Dest: taroAddr,
-	})
+	// The amount to send is chosen by the sender only if the address
+	// doesn't specify one.
+	switch {
+	case amt < 0:
+		return nil, fmt.Errorf("amt cannot be negative")
+
+	case taroAddr.IsAmountless() && amt == 0:
+		return nil, fmt.Errorf("amt must be set for amount-less addr")
+
+	case !taroAddr.IsAmountless() && amt != 0:
+		return nil, fmt.Errorf("amt cannot be set for addr with amount")
+
+	case taroAddr.IsAmountless():
+		taroAddr.Amount = uint64(amt)
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(rpcStrategy)
 	if err != nil {
 		return nil, err
 	}
 
+	inputAnchorPoints := make([]wire.OutPoint, len(inputOutpoints))
+	for i, op := range inputOutpoints {
+		inputAnchorPoints[i], err = parseOutPoint(op)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input outpoint: %w",
+				err)
+		}
+	}
+
+	return &tarofreighter.AssetParcel{
+		Dest:               taroAddr,
+		InputAnchorPoints:  inputAnchorPoints,
+		CoinSelectStrategy: strategy,
+	}, nil
+}
+
+// unmarshalCoinSelectStrategy parses the RPC coin selection strategy into its
+// native counterpart.
+func unmarshalCoinSelectStrategy(
+	strategy tarorpc.CoinSelectStrategy) (tarofreighter.CoinSelectStrategy,
+	error) {
+
+	switch strategy {
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_DEFAULT:
+		return tarofreighter.CoinSelectDefault, nil
+
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_SMALLEST_SUFFICIENT:
+		return tarofreighter.CoinSelectSmallestSufficient, nil
+
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST:
+		return tarofreighter.CoinSelectLargestFirst, nil
+
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_OLDEST_FIRST:
+		return tarofreighter.CoinSelectOldestFirst, nil
+
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_PRIVACY:
+		return tarofreighter.CoinSelectPrivacy, nil
+
+	case tarorpc.CoinSelectStrategy_COIN_SELECT_MINIMIZE_SPLIT:
+		return tarofreighter.CoinSelectMinimizeSplit, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
+}
+
+// marshalPendingParcel turns a pending parcel into its RPC counterpart.
+func marshalPendingParcel(
+	resp *tarofreighter.PendingParcel) (*tarorpc.SendAssetResponse, error) {
+
 	transferTXID := resp.TransferTx.TxHash()
 
 	var txBuf bytes.Buffer
@@ -997,3 +2273,339 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 		TotalFeeSats: int64(resp.TotalFees),
 	}, nil
 }
+
+// BurnAsset burns the given number of units of a given asset by sending them
+// to a provably unspendable script key.
+func (r *rpcServer) BurnAsset(ctx context.Context,
+	in *tarorpc.BurnAssetRequest) (*tarorpc.BurnAssetResponse, error) {
+
+	var assetID asset.ID
+	if len(in.AssetId) != len(assetID) {
+		return nil, fmt.Errorf("invalid asset ID length")
+	}
+	copy(assetID[:], in.AssetId)
+
+	if in.AmountToBurn <= 0 {
+		return nil, fmt.Errorf("amount to burn must be positive")
+	}
+
+	// We'll need the genesis and family key of the asset to burn, so
+	// we'll look up one of the assets we own with the given ID.
+	assets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, &tarodb.AssetQueryFilters{
+			CommitmentConstraints: tarofreighter.CommitmentConstraints{
+				AssetID: &assetID,
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch assets: %w", err)
+	}
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("no assets with ID %x found",
+			assetID[:])
+	}
+
+	// The burn is modelled as a send to a partial address that the
+	// porter completes with the burn key once it selected the input.
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	burnAddr := &address.Taro{
+		ChainParams: &taroParams,
+		Genesis:     assets[0].Genesis,
+		Amount:      uint64(in.AmountToBurn),
+	}
+	if assets[0].FamilyKey != nil {
+		burnAddr.FamilyKey = &assets[0].FamilyKey.FamKey
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(&tarofreighter.AssetParcel{
+		Dest: burnAddr,
+		Burn: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	burnTransfer, err := marshalPendingParcel(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.BurnOutput == nil {
+		return nil, fmt.Errorf("burn transfer is missing burn output")
+	}
+
+	return &tarorpc.BurnAssetResponse{
+		BurnTransfer:  burnTransfer,
+		BurnScriptKey: resp.BurnOutput.PrevID.ScriptKey[:],
+	}, nil
+}
+
+// ListBurns lists the asset burns that were initiated by this daemon.
+func (r *rpcServer) ListBurns(ctx context.Context,
+	in *tarorpc.ListBurnsRequest) (*tarorpc.ListBurnsResponse, error) {
+
+	var assetID *asset.ID
+	if len(in.AssetId) != 0 {
+		assetID = &asset.ID{}
+		if len(in.AssetId) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+
+		copy(assetID[:], in.AssetId)
+	}
+
+	burns, err := r.cfg.AssetStore.QueryBurns(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query burns: %w", err)
+	}
+
+	rpcBurns := make([]*tarorpc.AssetBurn, len(burns))
+	for i, burn := range burns {
+		var famKey []byte
+		if burn.FamilyKey != nil {
+			famKey = burn.FamilyKey.SerializeCompressed()
+		}
+
+		rpcBurns[i] = &tarorpc.AssetBurn{
+			AssetId:       burn.AssetID[:],
+			FamilyKey:     famKey,
+			Amount:        int64(burn.Amount),
+			BurnScriptKey: burn.ScriptKey.SerializeCompressed(),
+			AnchorTxid:    burn.AnchorTxid[:],
+			Confirmed:     burn.AnchorBlockHash != nil,
+			BurnTimeUnix:  burn.BurnTime.Unix(),
+		}
+	}
+
+	return &tarorpc.ListBurnsResponse{
+		Burns: rpcBurns,
+	}, nil
+}
+
+// historyEntry is an asset history entry along with the exact time of the
+// event, which is used to order the entries.
+type historyEntry struct {
+	eventTime time.Time
+
+	*tarorpc.AssetHistoryEntry
+}
+
+// AssetHistory lists the mints, receives, sends and burns of an asset or an
+// asset family in the order they happened.
+func (r *rpcServer) AssetHistory(ctx context.Context,
+	in *tarorpc.AssetHistoryRequest) (*tarorpc.AssetHistoryResponse,
+	error) {
+
+	var (
+		assetID *asset.ID
+		famKey  *btcec.PublicKey
+		err     error
+	)
+	switch {
+	case len(in.GetAssetId()) != 0:
+		assetID = &asset.ID{}
+		if len(in.GetAssetId()) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+		copy(assetID[:], in.GetAssetId())
+
+	case len(in.GetFamilyKey()) != 0:
+		famKey, err = btcec.ParsePubKey(in.GetFamilyKey())
+		if err != nil {
+			return nil, fmt.Errorf("invalid family key: %w", err)
+		}
+	}
+
+	if in.Offset < 0 || in.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit must not be negative")
+	}
+
+	// matchesFilter returns true if an asset with the given ID and family
+	// key is part of the requested history.
+	matchesFilter := func(id asset.ID, key *btcec.PublicKey) bool {
+		switch {
+		case assetID != nil:
+			return id == *assetID
+
+		case famKey != nil:
+			return key != nil && key.IsEqual(famKey)
+
+		default:
+			return true
+		}
+	}
+
+	serializeKey := func(key *btcec.PublicKey) []byte {
+		if key == nil {
+			return nil
+		}
+		return key.SerializeCompressed()
+	}
+
+	var entries []historyEntry
+
+	mints, err := r.cfg.AssetStore.QueryAssetMints(ctx, assetID, famKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query mints: %w", err)
+	}
+	for _, mint := range mints {
+		var txid []byte
+		if mint.AnchorTxid != nil {
+			txid = mint.AnchorTxid[:]
+		}
+
+		entries = append(entries, historyEntry{
+			eventTime: mint.MintTime,
+			AssetHistoryEntry: &tarorpc.AssetHistoryEntry{
+				EventType:   tarorpc.AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_MINT,
+				AssetId:     mint.AssetID[:],
+				FamilyKey:   serializeKey(mint.FamilyKey),
+				Amount:      int64(mint.Amount),
+				Txid:        txid,
+				BlockHeight: mint.BlockHeight,
+			},
+		})
+	}
+
+	// The address book doesn't index events by asset, so we'll filter the
+	// receive events ourselves.
+	// Receives that were rejected by the address were never taken custody
+	// of, so they're not part of the history.
+	statusFrom := address.StatusTransactionDetected
+	statusTo := address.StatusCompleted
+	events, err := r.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{
+			StatusFrom: &statusFrom,
+			StatusTo:   &statusTo,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query receives: %w", err)
+	}
+	for _, event := range events {
+		addr := event.Addr
+		if !matchesFilter(addr.ID(), addr.FamilyKey) {
+			continue
+		}
+
+		addrStr, err := addr.EncodeAddress()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode addr: %w", err)
+		}
+
+		id := addr.ID()
+		entries = append(entries, historyEntry{
+			eventTime: event.CreationTime,
+			AssetHistoryEntry: &tarorpc.AssetHistoryEntry{
+				EventType:    tarorpc.AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_RECEIVE,
+				AssetId:      id[:],
+				FamilyKey:    serializeKey(addr.FamilyKey),
+				Amount:       int64(event.AssetAmount),
+				Txid:         event.Outpoint.Hash[:],
+				BlockHeight:  event.ConfirmationHeight,
+				Counterparty: addrStr,
+			},
+		})
+	}
+
+	sends, err := r.cfg.AssetStore.QueryAssetSends(ctx, assetID, famKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query sends: %w", err)
+	}
+	for _, send := range sends {
+		eventType := tarorpc.AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_SEND
+		if send.Burn {
+			eventType = tarorpc.AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_BURN
+		}
+
+		receiverKey := send.ReceiverScriptKey.SerializeCompressed()
+		entries = append(entries, historyEntry{
+			eventTime: send.SendTime,
+			AssetHistoryEntry: &tarorpc.AssetHistoryEntry{
+				EventType:    eventType,
+				AssetId:      send.AssetID[:],
+				FamilyKey:    serializeKey(send.FamilyKey),
+				Amount:       int64(send.Amount),
+				Txid:         send.AnchorTxid[:],
+				BlockHeight:  send.BlockHeight,
+				Counterparty: hex.EncodeToString(receiverKey),
+			},
+		})
+	}
+
+	// With all the entries collected, we'll order them by time, then
+	// apply the requested pagination.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].eventTime.Before(entries[j].eventTime)
+	})
+
+	offset := int(in.Offset)
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	entries = entries[offset:]
+	if in.Limit != 0 && int(in.Limit) < len(entries) {
+		entries = entries[:in.Limit]
+	}
+
+	resp := &tarorpc.AssetHistoryResponse{
+		Entries: make([]*tarorpc.AssetHistoryEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		entry.Timestamp = entry.eventTime.Unix()
+		resp.Entries[i] = entry.AssetHistoryEntry
+	}
+
+	return resp, nil
+}
+
+// FetchAssetMeta reveals the metadata committed to in the genesis of an
+// asset, looked up either by the asset ID or by the hash of the metadata.
+func (r *rpcServer) FetchAssetMeta(ctx context.Context,
+	in *tarorpc.FetchAssetMetaRequest) (*tarorpc.AssetMeta, error) {
+
+	var (
+		metadata []byte
+		err      error
+	)
+	switch {
+	case len(in.GetAssetId()) != 0:
+		var assetID asset.ID
+		if len(in.GetAssetId()) != len(assetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+		copy(assetID[:], in.GetAssetId())
+
+		metadata, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case len(in.GetMetaHash()) != 0:
+		var metaHash [sha256.Size]byte
+		if len(in.GetMetaHash()) != len(metaHash) {
+			return nil, fmt.Errorf("invalid meta hash length")
+		}
+		copy(metaHash[:], in.GetMetaHash())
+
+		metadata, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	default:
+		return nil, fmt.Errorf("either asset ID or meta hash must be set")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset meta: %w", err)
+	}
+
+	meta := asset.DecodeMeta(metadata)
+	metaHash := sha256.Sum256(metadata)
+
+	return &tarorpc.AssetMeta{
+		Data:     meta.Data,
+		Type:     tarorpc.AssetMetaType(meta.Type),
+		MimeType: meta.MimeType,
+		MetaHash: metaHash[:],
+	}, nil
+}
@@ -190,7 +190,14 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 	// TODO(roasbeef): make macaroons service, needs the lnd APIs present
 	// an abstracted
 
-	// First, we'll start the main batched asset minter.
+	// The reorg watcher needs to be started first, as the minter, the
+	// custodian and the porter hand their confirmed transactions over to
+	// it.
+	if err := s.cfg.ReorgWatcher.Start(); err != nil {
+		return mkErr("unable to start reorg watcher: %v", err)
+	}
+
+	// Next, we'll start the main batched asset minter.
 	if err := s.cfg.AssetMinter.Start(); err != nil {
 		return mkErr("unable to start asset minter: %v", err)
 	}
@@ -396,6 +403,12 @@ func (s *Server) Stop() error {
 	if err := s.rpcServer.Stop(); err != nil {
 		return err
 	}
+
+	// We stop the reorg watcher first, so it doesn't invoke any callbacks
+	// of the sub-systems we stop below.
+	if err := s.cfg.ReorgWatcher.Stop(); err != nil {
+		return err
+	}
 	if err := s.cfg.AssetMinter.Stop(); err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package tarocfg
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -19,6 +20,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro"
+	"github.com/lightninglabs/taro/address"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -56,6 +58,11 @@ const (
 	// batch.
 	defaultBatchMintingInterval = time.Minute * 10
 
+	// defaultReOrgSafeDepth is the default number of confirmations after
+	// which we consider a minting or transfer transaction safe from chain
+	// reorganizations.
+	defaultReOrgSafeDepth = 6
+
 	// defaultHashMailAddr is the default address we'll use to deliver
 	// optionally deliver proofs for asynchronous sends.
 	defaultHashMailAddr = "mailbox.terminal.lightning.today:443"
@@ -120,7 +127,7 @@ var (
 // ChainConfig houses the configuration options that govern which chain/network
 // we operate on.
 type ChainConfig struct {
-	Network string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"simnet"`
+	Network string `long:"network" description:"network to run on" choice:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
 
 	SigNetChallenge string `long:"signetchallenge" description:"Connect to a custom signet network defined by this challenge instead of using the global default signet test network -- Can be specified multiple times"`
 }
@@ -191,6 +198,8 @@ type Config struct {
 
 	HashMailAddr string `long:"hashmailaddr" description:"The full host:port that should be used to optionally deliver proofs files for asynchronous sends"`
 
+	ReOrgSafeDepth uint32 `long:"reorgsafedepth" description:"The number of confirmations after which a minting, transfer or receive transaction is considered safe from chain reorganizations. Until then, the proofs of a transaction are re-created if it is re-confirmed in a different block."`
+
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
 
@@ -242,6 +251,7 @@ func DefaultConfig() Config {
 		LogWriter:            build.NewRotatingLogWriter(),
 		BatchMintingInterval: defaultBatchMintingInterval,
 		HashMailAddr:         defaultHashMailAddr,
+		ReOrgSafeDepth:       defaultReOrgSafeDepth,
 	}
 }
 
@@ -421,7 +431,16 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor) (*Config,
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
 	// while we're at it.
+	if cfg.ChainConf.SigNetChallenge != "" &&
+		cfg.ChainConf.Network != "signet" {
+
+		return nil, nil, mkErr("signet challenge can only be set " +
+			"for the signet network")
+	}
+
 	switch cfg.ChainConf.Network {
+	case "mainnet":
+		cfg.ActiveNetParams = chaincfg.MainNetParams
 	case "testnet":
 		cfg.ActiveNetParams = chaincfg.TestNet3Params
 	case "regtest":
@@ -453,6 +472,23 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor) (*Config,
 			sigNetChallenge, sigNetSeeds,
 		)
 		cfg.ActiveNetParams = chainParams
+
+		// A custom signet needs to be registered, so its parameters
+		// are used for Taro addresses instead of the ones of the
+		// default signet. Custom signets use the same HRP as the
+		// default signet.
+		if cfg.ChainConf.SigNetChallenge != "" {
+			err := address.Register(&address.ChainParams{
+				Params:  &chainParams,
+				TaroHRP: address.SigNetHRP,
+			})
+			if err != nil &&
+				!errors.Is(err, chaincfg.ErrDuplicateNet) {
+
+				return nil, nil, mkErr("unable to register "+
+					"custom signet: %v", err)
+			}
+		}
 	default:
 		return nil, nil, mkErr(fmt.Sprintf("invalid network: %v",
 			cfg.ChainConf.Network))
@@ -570,6 +606,12 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor) (*Config,
 		return nil, nil, mkErr("log writer missing in config")
 	}
 
+	// A transaction needs at least a single confirmation to be considered
+	// safe from chain reorganizations.
+	if cfg.ReOrgSafeDepth == 0 {
+		return nil, nil, mkErr("reorgsafedepth must be at least 1")
+	}
+
 	// Special show command to list supported subsystems and exit.
 	if cfg.DebugLevel == "show" {
 		fmt.Println("Supported subsystems",
@@ -664,6 +706,23 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor) (*Config,
 	return &cfg, taroCfgLog, nil
 }
 
+// genCertPair generates a new self-signed TLS certificate and key and writes
+// them to the paths given in the RPC config.
+func genCertPair(rpcCfg *RpcConfig) error {
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"taro autogenerated cert", rpcCfg.TLSExtraIPs,
+		rpcCfg.TLSExtraDomains, rpcCfg.TLSDisableAutofill,
+		rpcCfg.TLSCertDuration,
+	)
+	if err != nil {
+		return err
+	}
+
+	return cert.WriteCertPair(
+		rpcCfg.TLSCertPath, rpcCfg.TLSKeyPath, certBytes, keyBytes,
+	)
+}
+
 // getTLSConfig returns a TLS configuration for the gRPC server and credentials
 // and a proxy destination for the REST reverse proxy.
 func getTLSConfig(cfg *Config,
@@ -675,14 +734,7 @@ func getTLSConfig(cfg *Config,
 		!fileExists(cfg.RpcConf.TLSKeyPath) {
 
 		cfgLogger.Infof("Generating TLS certificates...")
-		err := cert.GenCertPair(
-			"taro autogenerated cert", cfg.RpcConf.TLSCertPath,
-			cfg.RpcConf.TLSKeyPath, cfg.RpcConf.TLSExtraIPs,
-			cfg.RpcConf.TLSExtraDomains,
-			cfg.RpcConf.TLSDisableAutofill,
-			cfg.RpcConf.TLSCertDuration,
-		)
-		if err != nil {
+		if err := genCertPair(cfg.RpcConf); err != nil {
 			return nil, nil, nil, err
 		}
 		cfgLogger.Infof("Done generating TLS certificates")
@@ -728,14 +780,7 @@ func getTLSConfig(cfg *Config,
 		}
 
 		cfgLogger.Infof("Renewing TLS certificates...")
-		err = cert.GenCertPair(
-			"taro autogenerated cert", cfg.RpcConf.TLSCertPath,
-			cfg.RpcConf.TLSKeyPath, cfg.RpcConf.TLSExtraIPs,
-			cfg.RpcConf.TLSExtraDomains,
-			cfg.RpcConf.TLSDisableAutofill,
-			cfg.RpcConf.TLSCertDuration,
-		)
-		if err != nil {
+		if err := genCertPair(cfg.RpcConf); err != nil {
 			return nil, nil, nil, err
 		}
 		cfgLogger.Infof("Done renewing TLS certificates")
@@ -850,14 +895,108 @@ func getLnd(network string, cfg *LndConfig,
 		}
 	}()
 
-	return lndclient.NewLndServices(&lndclient.LndServicesConfig{
+	lndNetwork, err := lndClientNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+
+	// NewLndServices makes sure the connected lnd node runs on the same
+	// network as we do. If it doesn't, we return a typed error below so
+	// callers can act on it.
+	lndCfg := &lndclient.LndServicesConfig{
 		LndAddress:            cfg.Host,
-		Network:               lndclient.Network(network),
+		Network:               lndNetwork,
 		CustomMacaroonPath:    cfg.MacaroonPath,
 		TLSPath:               cfg.TLSPath,
 		CheckVersion:          minimalCompatibleVersion,
 		BlockUntilChainSynced: true,
 		BlockUntilUnlocked:    true,
 		CallerCtx:             ctxc,
-	})
+	}
+	lndServices, err := lndclient.NewLndServices(lndCfg)
+	if err != nil {
+		// The lnd client library doesn't tell us why the connection
+		// failed, so we'll query the network of the lnd node ourselves
+		// to be able to return a clear error if it doesn't match.
+		lndNodeNetwork, netErr := queryLndNetwork(ctxc, cfg, lndNetwork)
+		if netErr == nil && lndNodeNetwork != string(lndNetwork) {
+			return nil, &LndNetworkMismatchError{
+				Network:    network,
+				LndNetwork: lndNodeNetwork,
+			}
+		}
+
+		return nil, err
+	}
+
+	return lndServices, nil
+}
+
+// LndNetworkMismatchError is returned if the lnd node tarod connects to runs
+// on a different network than tarod itself.
+type LndNetworkMismatchError struct {
+	// Network is the network tarod is configured to run on.
+	Network string
+
+	// LndNetwork is the network the connected lnd node runs on.
+	LndNetwork string
+}
+
+// Error returns a human readable representation of the error.
+func (e *LndNetworkMismatchError) Error() string {
+	return fmt.Sprintf("tarod is configured to run on %v, but the "+
+		"connected lnd node runs on %v, make sure --network matches "+
+		"the network of lnd", e.Network, e.LndNetwork)
+}
+
+// queryLndNetwork connects to the lnd node with a basic client and returns the
+// network it runs on.
+func queryLndNetwork(ctx context.Context, cfg *LndConfig,
+	network lndclient.Network) (string, error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath),
+		string(network),
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	info, err := lnrpc.NewLightningClient(conn).GetInfo(
+		ctx, &lnrpc.GetInfoRequest{},
+	)
+	if err != nil {
+		return "", err
+	}
+	if len(info.Chains) == 0 {
+		return "", fmt.Errorf("lnd node didn't report its network")
+	}
+
+	return info.Chains[0].Network, nil
+}
+
+// lndClientNetwork maps the network tarod runs on to the network of the lnd
+// node it connects to.
+func lndClientNetwork(network string) (lndclient.Network, error) {
+	switch network {
+	case "mainnet":
+		return lndclient.NetworkMainnet, nil
+
+	case "testnet":
+		return lndclient.NetworkTestnet, nil
+
+	case "regtest":
+		return lndclient.NetworkRegtest, nil
+
+	case "simnet":
+		return lndclient.NetworkSimnet, nil
+
+	case "signet":
+		return lndclient.NetworkSignet, nil
+
+	default:
+		return "", fmt.Errorf("unknown network: %v", network)
+	}
 }
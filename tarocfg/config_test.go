@@ -0,0 +1,70 @@
+package tarocfg
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenCertPair tests that a freshly generated TLS certificate and key are
+// written to the configured paths and can be loaded as a key pair.
+func TestGenCertPair(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	rpcCfg := &RpcConfig{
+		TLSCertPath:     filepath.Join(tempDir, defaultTLSCertFilename),
+		TLSKeyPath:      filepath.Join(tempDir, defaultTLSKeyFilename),
+		TLSExtraDomains: []string{"taro.example.com"},
+		TLSCertDuration: time.Hour,
+	}
+	require.NoError(t, genCertPair(rpcCfg))
+
+	require.True(t, fileExists(rpcCfg.TLSCertPath))
+	require.True(t, fileExists(rpcCfg.TLSKeyPath))
+
+	_, err := tls.LoadX509KeyPair(rpcCfg.TLSCertPath, rpcCfg.TLSKeyPath)
+	require.NoError(t, err)
+}
+
+// TestLndClientNetwork tests that every network tarod can run on maps to the
+// network of the lnd client library, including signet.
+func TestLndClientNetwork(t *testing.T) {
+	t.Parallel()
+
+	networks := map[string]lndclient.Network{
+		"mainnet": lndclient.NetworkMainnet,
+		"testnet": lndclient.NetworkTestnet,
+		"regtest": lndclient.NetworkRegtest,
+		"simnet":  lndclient.NetworkSimnet,
+		"signet":  lndclient.NetworkSignet,
+	}
+	for network, expected := range networks {
+		lndNetwork, err := lndClientNetwork(network)
+		require.NoError(t, err)
+		require.Equal(t, expected, lndNetwork)
+	}
+
+	_, err := lndClientNetwork("unknown")
+	require.Error(t, err)
+}
+
+// TestLndNetworkMismatchError tests that a network mismatch is reported as a
+// typed error that names both networks.
+func TestLndNetworkMismatchError(t *testing.T) {
+	t.Parallel()
+
+	var err error = &LndNetworkMismatchError{
+		Network:    "signet",
+		LndNetwork: "testnet",
+	}
+
+	var mismatchErr *LndNetworkMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	require.Contains(t, err.Error(), "signet")
+	require.Contains(t, err.Error(), "testnet")
+}
@@ -124,6 +124,14 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		}
 	}
 
+	reorgWatcher := tarogarden.NewReorgWatcher(
+		&tarogarden.ReorgWatcherConfig{
+			ChainBridge: chainBridge,
+			SafeDepth:   cfg.ReOrgSafeDepth,
+			ErrChan:     mainErrChan,
+		},
+	)
+
 	server, err := taro.NewServer(&taro.Config{
 		DebugLevel:  cfg.DebugLevel,
 		ChainParams: cfg.ActiveNetParams,
@@ -136,7 +144,8 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 				GenSigner: taro.NewLndRpcGenSigner(
 					lndServices,
 				),
-				ProofFiles: proofFileStore,
+				ProofFiles:   proofFileStore,
+				ReorgWatcher: reorgWatcher,
 			},
 			BatchTicker: ticker.New(cfg.BatchMintingInterval),
 			ErrChan:     mainErrChan,
@@ -148,18 +157,23 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 				ChainBridge:  chainBridge,
 				AddrBook:     addrBook,
 				ProofArchive: proofArchive,
+				ProofFiles:   proofFileStore,
+				ReceiveLog:   assetStore,
 				ErrChan:      mainErrChan,
 				ProofCourier: hashMailCourier,
+				ReorgWatcher: reorgWatcher,
 			},
 		),
 		AddrBook:     addrBook,
 		ProofArchive: proofArchive,
+		ReorgWatcher: reorgWatcher,
 		ChainPorter: tarofreighter.NewChainPorter(&tarofreighter.ChainPorterConfig{
 			CoinSelector: assetStore,
 			Signer:       taro.NewLndRpcVirtualTxSigner(lndServices),
 			TxValidator:  &taro.ValidatorV0{},
 			ExportLog:    assetStore,
 			ChainBridge:  chainBridge,
+			ReorgWatcher: reorgWatcher,
 			Wallet:       walletAnchor,
 			KeyRing:      keyRing,
 			ChainParams:  &taroChainParams,
@@ -40,6 +40,12 @@ type (
 	// AddrManaged is a type alias for setting an address as managed.
 	AddrManaged = sqlite.SetAddrManagedParams
 
+	// NewAddrTag is a type alias for the params to add a tag to an address.
+	NewAddrTag = sqlite.InsertAddrTagParams
+
+	// AddrTag is a type alias for fetching the tag of an address.
+	AddrTag = sqlite.FetchAddrTagsRow
+
 	// UpsertAddrEvent is a type alias for creating a new address event or
 	// updating an existing one.
 	UpsertAddrEvent = sqlite.UpsertAddrEventParams
@@ -47,6 +53,10 @@ type (
 	// AddrEvent is a type alias for fetching an address event row.
 	AddrEvent = sqlite.FetchAddrEventRow
 
+	// FailedAddrEvent is a type alias for setting an address event to a
+	// failure status.
+	FailedAddrEvent = sqlite.FailAddrEventParams
+
 	// AddrEventQuery is a type alias for a query into the set of known
 	// address events.
 	AddrEventQuery = sqlite.QueryEventIDsParams
@@ -85,6 +95,12 @@ type AddrBook interface {
 	// InsertAddr inserts a new address into the database.
 	InsertAddr(ctx context.Context, arg NewAddr) (int32, error)
 
+	// InsertAddrTag inserts a new tag of an address into the database.
+	InsertAddrTag(ctx context.Context, arg NewAddrTag) error
+
+	// FetchAddrTags returns all the tags of an address.
+	FetchAddrTags(ctx context.Context, addrID int32) ([]AddrTag, error)
+
 	// UpsertInternalKey inserts a new or updates an existing internal key
 	// into the database and returns the primary key.
 	UpsertInternalKey(ctx context.Context, arg InternalKey) (int32, error)
@@ -113,6 +129,10 @@ type AddrBook interface {
 	// key.
 	FetchAddrEvent(ctx context.Context, id int32) (AddrEvent, error)
 
+	// FailAddrEvent sets an address event to a failure status and records
+	// the reason of the failure.
+	FailAddrEvent(ctx context.Context, arg FailedAddrEvent) error
+
 	// QueryEventIDs returns a list of event IDs and their corresponding
 	// address IDs that match the given query parameters.
 	QueryEventIDs(ctx context.Context, query AddrEventQuery) ([]AddrEventID,
@@ -239,7 +259,18 @@ func (t *TaroAddressBook) InsertAddrs(ctx context.Context,
 			if addr.FamilyKey != nil {
 				famKeyBytes = addr.FamilyKey.SerializeCompressed()
 			}
-			_, err = db.InsertAddr(ctx, NewAddr{
+
+			// We store the expiry in UTC, so it can be compared
+			// with the current time when querying for expired
+			// addresses.
+			var expiry sql.NullTime
+			if !addr.Expiry.IsZero() {
+				expiry = sql.NullTime{
+					Time:  addr.Expiry.UTC(),
+					Valid: true,
+				}
+			}
+			addrID, err := db.InsertAddr(ctx, NewAddr{
 				Version:        int16(addr.Version),
 				GenesisAssetID: genAssetID,
 				FamKey:         famKeyBytes,
@@ -251,11 +282,26 @@ func (t *TaroAddressBook) InsertAddrs(ctx context.Context,
 				Amount:       int64(addr.Amount),
 				AssetType:    int16(addr.Type),
 				CreationTime: addr.CreationTime,
+				Expiry:       expiry,
+				MaxReceives:  int32(addr.MaxReceives),
+				Label:        addr.Label,
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert addr: %w",
 					err)
 			}
+
+			for key, value := range addr.Tags {
+				err := db.InsertAddrTag(ctx, NewAddrTag{
+					AddrID:   addrID,
+					TagKey:   key,
+					TagValue: value,
+				})
+				if err != nil {
+					return fmt.Errorf("unable to insert "+
+						"addr tag: %w", err)
+				}
+			}
 		}
 
 		return nil
@@ -294,6 +340,16 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 			NumOffset:     int32(params.Offset),
 			NumLimit:      limit,
 			UnmanagedOnly: params.UnmanagedOnly,
+			ExpiredOnly:   params.ExpiredOnly,
+			ActiveOnly:    params.ActiveOnly,
+			Now: sql.NullTime{
+				Time:  time.Now().UTC(),
+				Valid: true,
+			},
+			MaxReceiveStatus: int16(address.StatusCompleted),
+			Label:            sqlStr(params.Label),
+			TagKey:           sqlStr(params.TagKey),
+			TagValue:         sqlStr(params.TagValue),
 		})
 		if err != nil {
 			return err
@@ -365,6 +421,11 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 					"output key: %w", err)
 			}
 
+			tags, err := fetchAddrTags(ctx, db, addr.ID)
+			if err != nil {
+				return err
+			}
+
 			addrs = append(addrs, address.AddrWithKeyInfo{
 				Taro: &address.Taro{
 					Version:     asset.Version(addr.Version),
@@ -383,6 +444,10 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 				TaprootOutputKey: *taprootOutputKey,
 				CreationTime:     addr.CreationTime,
 				ManagedAfter:     addr.ManagedFrom.Time,
+				Expiry:           addr.Expiry.Time,
+				MaxReceives:      uint32(addr.MaxReceives),
+				Label:            addr.Label,
+				Tags:             tags,
 			})
 		}
 
@@ -479,6 +544,11 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		PubKey: internalKey,
 	}
 
+	tags, err := fetchAddrTags(ctx, db, dbAddr.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &address.AddrWithKeyInfo{
 		Taro: &address.Taro{
 			Version:     asset.Version(dbAddr.Version),
@@ -496,9 +566,34 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     dbAddr.CreationTime,
+		Expiry:           dbAddr.Expiry.Time,
+		MaxReceives:      uint32(dbAddr.MaxReceives),
+		Label:            dbAddr.Label,
+		Tags:             tags,
 	}, nil
 }
 
+// fetchAddrTags fetches all tags of the address with the given primary key. If
+// the address has no tags, nil is returned.
+func fetchAddrTags(ctx context.Context, db AddrBook,
+	addrID int32) (map[string]string, error) {
+
+	dbTags, err := db.FetchAddrTags(ctx, addrID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch addr tags: %w", err)
+	}
+	if len(dbTags) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(dbTags))
+	for _, tag := range dbTags {
+		tags[tag.TagKey] = tag.TagValue
+	}
+
+	return tags, nil
+}
+
 // SetAddrManaged sets an address as being managed by the internal
 // wallet.
 func (t *TaroAddressBook) SetAddrManaged(ctx context.Context,
@@ -520,7 +615,9 @@ func (t *TaroAddressBook) SetAddrManaged(ctx context.Context,
 
 // GetOrCreateEvent creates a new address event for the given status, address
 // and transaction. If an event for that address and transaction already exists,
-// then the status and transaction information is updated instead.
+// then the status and transaction information is updated instead. The amount of
+// the given address is recorded as the amount received with a new event, so for
+// amount-less addresses it must be set to the amount actually received.
 func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 	status address.Status, addr *address.AddrWithKeyInfo,
 	walletTx *lndclient.Transaction, outputIdx uint32,
@@ -606,6 +703,8 @@ func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 			Txid:                txHash[:],
 			ChainTxnOutputIndex: int32(outputIdx),
 			ManagedUtxoID:       managedUtxoID,
+			AssetAmount:         int64(addr.Amount),
+			MinFinalStatus:      int16(address.StatusCompleted),
 		})
 		if err != nil {
 			return fmt.Errorf("error fetching existing events: %w",
@@ -630,7 +729,7 @@ func (t *TaroAddressBook) QueryAddrEvents(
 
 	sqlQuery := AddrEventQuery{
 		StatusFrom: int16(address.StatusTransactionDetected),
-		StatusTo:   int16(address.StatusCompleted),
+		StatusTo:   int16(address.StatusTransactionFailed),
 	}
 	if len(params.AddrTaprootOutputKey) > 0 {
 		sqlQuery.AddrTaprootKey = params.AddrTaprootOutputKey
@@ -723,6 +822,8 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int32,
 		TapscriptSibling:   dbEvent.TapscriptSibling,
 		ConfirmationHeight: uint32(dbEvent.ConfirmationHeight.Int32),
 		HasProof:           dbEvent.AssetProofID.Valid,
+		AssetAmount:        uint64(dbEvent.AssetAmount),
+		FailureReason:      dbEvent.FailureReason,
 	}, nil
 }
 
@@ -750,11 +851,27 @@ func (t *TaroAddressBook) CompleteEvent(ctx context.Context,
 			ChainTxnOutputIndex: int32(anchorPoint.Index),
 			AssetProofID:        sqlInt32(proofData.ProofID),
 			AssetID:             sqlInt32(proofData.AssetID),
+			MinFinalStatus:      int16(address.StatusCompleted),
 		})
 		return err
 	})
 }
 
+// FailEvent updates an address event to the given failure status and records
+// the reason of the failure.
+func (t *TaroAddressBook) FailEvent(ctx context.Context, event *address.Event,
+	status address.Status, reason string) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		return db.FailAddrEvent(ctx, FailedAddrEvent{
+			Status:        int16(status),
+			FailureReason: reason,
+			ID:            event.ID,
+		})
+	})
+}
+
 // A set of compile-time assertions to ensure that TaroAddressBook meets the
 // address.Storage and address.EventStorage interface.
 var _ address.Storage = (*TaroAddressBook)(nil)
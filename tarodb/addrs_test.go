@@ -162,12 +162,18 @@ func assertEqualAddr(t *testing.T, expected, actual address.AddrWithKeyInfo) {
 	// compare the unix timestamps separately.
 	actualTime := actual.CreationTime
 	expectedTime := expected.CreationTime
+	actualExpiry := actual.Expiry
+	expectedExpiry := expected.Expiry
 
 	actual.CreationTime = time.Time{}
 	expected.CreationTime = time.Time{}
+	actual.Expiry = time.Time{}
+	expected.Expiry = time.Time{}
 
 	require.Equal(t, expected, actual)
 	require.Equal(t, expectedTime.Unix(), actualTime.Unix())
+	require.Equal(t, expectedExpiry.IsZero(), actualExpiry.IsZero())
+	require.Equal(t, expectedExpiry.Unix(), actualExpiry.Unix())
 }
 
 // assertEqualAddrEvents makes sure the given actual address events match the
@@ -214,6 +220,18 @@ func TestAddressInsertion(t *testing.T) {
 	for i := 0; i < numAddrs; i++ {
 		addrs[i] = *randAddr(t)
 	}
+
+	// The receive restrictions of an address should be stored as well.
+	addrs[0].Expiry = time.Now().Add(time.Hour)
+	addrs[0].MaxReceives = 1
+
+	// The same goes for the local label and tags.
+	addrs[1].Label = "invoice 1337"
+	addrs[1].Tags = map[string]string{
+		"customer": "alice",
+		"order":    "",
+	}
+
 	ctx := context.Background()
 	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
 
@@ -287,6 +305,20 @@ func TestAddressQuery(t *testing.T) {
 	for i := 0; i < numAddrs; i++ {
 		addrs[i] = *randAddr(t)
 	}
+
+	// The first address already expired, the second one will expire in
+	// the future. The third one can only be received to once, but hasn't
+	// received anything yet.
+	addrs[0].Expiry = time.Now().Add(-time.Hour)
+	addrs[1].Expiry = time.Now().Add(time.Hour)
+	addrs[2].MaxReceives = 1
+
+	// The third and fourth address are labelled and tagged.
+	addrs[2].Label = "store"
+	addrs[2].Tags = map[string]string{"customer": "alice"}
+	addrs[3].Label = "store"
+	addrs[3].Tags = map[string]string{"customer": "bob"}
+
 	ctx := context.Background()
 	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
 
@@ -298,6 +330,11 @@ func TestAddressQuery(t *testing.T) {
 		limit         int32
 		offset        int32
 		unmanagedOnly bool
+		expiredOnly   bool
+		activeOnly    bool
+		label         string
+		tagKey        string
+		tagValue      string
 
 		numAddrs   int
 		firstIndex int
@@ -359,6 +396,71 @@ func TestAddressQuery(t *testing.T) {
 			unmanagedOnly: true,
 			numAddrs:      numAddrs,
 		},
+
+		// Expired only, which is just the first address.
+		{
+			name: "expired only",
+
+			expiredOnly: true,
+			numAddrs:    1,
+		},
+
+		// Active only, which is everything but the first address.
+		{
+			name: "active only",
+
+			activeOnly: true,
+			numAddrs:   numAddrs - 1,
+			firstIndex: 1,
+		},
+
+		// Label, which matches the third and fourth address.
+		{
+			name: "label",
+
+			label:      "store",
+			numAddrs:   2,
+			firstIndex: 2,
+		},
+
+		// Unknown label, which doesn't match any address.
+		{
+			name: "unknown label",
+
+			label:    "unknown",
+			numAddrs: 0,
+		},
+
+		// Tag key with any value, which matches the third and fourth
+		// address.
+		{
+			name: "tag key",
+
+			tagKey:     "customer",
+			numAddrs:   2,
+			firstIndex: 2,
+		},
+
+		// Tag key and value, which only matches the fourth address.
+		{
+			name: "tag key and value",
+
+			tagKey:     "customer",
+			tagValue:   "bob",
+			numAddrs:   1,
+			firstIndex: 3,
+		},
+
+		// Label and tag combined, which only matches the third address.
+		{
+			name: "label and tag",
+
+			label:      "store",
+			tagKey:     "customer",
+			tagValue:   "alice",
+			numAddrs:   1,
+			firstIndex: 2,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -369,10 +471,21 @@ func TestAddressQuery(t *testing.T) {
 					Offset:        test.offset,
 					Limit:         test.limit,
 					UnmanagedOnly: test.unmanagedOnly,
+					ExpiredOnly:   test.expiredOnly,
+					ActiveOnly:    test.activeOnly,
+					Label:         test.label,
+					TagKey:        test.tagKey,
+					TagValue:      test.tagValue,
 				},
 			)
 			require.NoError(t, err)
 			require.Len(t, dbAddrs, test.numAddrs)
+
+			if test.numAddrs > 0 {
+				assertEqualAddr(
+					t, addrs[test.firstIndex], dbAddrs[0],
+				)
+			}
 		})
 	}
 }
@@ -398,7 +511,7 @@ func TestAddrEventStatusDBEnum(t *testing.T) {
 	outputIndex := rand.Intn(len(txn.Tx.TxOut))
 
 	_, err = addrBook.GetOrCreateEvent(
-		ctx, address.Status(4), addr, txn, uint32(outputIndex), nil,
+		ctx, address.Status(9), addr, txn, uint32(outputIndex), nil,
 	)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "CHECK constraint failed")
@@ -437,6 +550,10 @@ func TestAddrEventCreation(t *testing.T) {
 		)
 		require.NoError(t, err)
 
+		// The amount of the address is recorded as the amount that
+		// was received.
+		require.Equal(t, addr.Amount, event.AssetAmount)
+
 		events[i] = event
 	}
 
@@ -484,6 +601,75 @@ func TestAddrEventCreation(t *testing.T) {
 
 		assertEqualAddrEvent(t, *events[idx], *actual)
 	}
+
+	// A completed event is final, so inspecting its transaction again,
+	// for example during a rescan, must not change its status anymore.
+	completed, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusCompleted, events[0].Addr, txns[0],
+		events[0].Outpoint.Index, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, address.StatusCompleted, completed.Status)
+
+	actual, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionConfirmed, events[0].Addr,
+		txns[0], events[0].Outpoint.Index, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, address.StatusCompleted, actual.Status)
+}
+
+// TestAddrEventFailure tests that address events can be set to a failure
+// status and that a failed event isn't updated anymore.
+func TestAddrEventFailure(t *testing.T) {
+	t.Parallel()
+
+	// First, make a new addr book instance we'll use in the test below.
+	addrBook, _ := newAddrBook(t)
+
+	ctx := context.Background()
+
+	addr := randAddr(t)
+	require.NoError(t, addrBook.InsertAddrs(ctx, *addr))
+
+	txn := randWalletTx()
+	outputIndex := uint32(rand.Intn(len(txn.Tx.TxOut)))
+	event, err := addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionDetected, addr, txn, outputIndex,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, event.FailureReason)
+
+	// We now fail the event, which should be reflected when querying it.
+	const reason = "transaction was double spent"
+	err = addrBook.FailEvent(
+		ctx, event, address.StatusTransactionFailed, reason,
+	)
+	require.NoError(t, err)
+
+	failed := address.StatusTransactionFailed
+	events, err := addrBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{
+			StatusFrom: &failed,
+			StatusTo:   &failed,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, event.ID, events[0].ID)
+	require.Equal(t, reason, events[0].FailureReason)
+
+	// A failure is final, so seeing the transaction confirm doesn't change
+	// the status of the event anymore.
+	confirmTx(txn)
+	event, err = addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionConfirmed, addr, txn, outputIndex,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, address.StatusTransactionFailed, event.Status)
+	require.Equal(t, reason, event.FailureReason)
 }
 
 // TestAddressEventQuery tests that we're able to properly retrieve rows based
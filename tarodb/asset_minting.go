@@ -3,6 +3,8 @@ package tarodb
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -16,6 +18,7 @@ import (
 	"github.com/lightninglabs/taro/tarodb/sqlite"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 type (
@@ -23,6 +26,14 @@ type (
 	// batch.
 	BatchStateUpdate = sqlite.UpdateMintingBatchStateParams
 
+	// BatchFeeRateUpdate holds the arguments to update the fee rate of a
+	// batch.
+	BatchFeeRateUpdate = sqlite.UpdateMintingBatchFeeRateParams
+
+	// SeedlingRemoval holds the arguments to remove a seedling from a
+	// batch.
+	SeedlingRemoval = sqlite.DeleteSeedlingFromBatchParams
+
 	// InternalKey holds the arguments to update an internal key.
 	InternalKey = sqlite.UpsertInternalKeyParams
 
@@ -42,8 +53,22 @@ type (
 	// match a certain value.
 	MintingBatchI = sqlite.FetchMintingBatchesByInverseStateRow
 
-	// AssetSeedling is an asset seedling.
-	AssetSeedling = sqlite.AssetSeedling
+	// ConfirmedBatchesQuery is used to query for the minting batches in a
+	// given state that were confirmed at or above a given block height.
+	ConfirmedBatchesQuery = sqlite.FetchConfirmedMintingBatchesParams
+
+	// ConfirmedMintingBatch is an alias for a minting batch including the
+	// internal key info and the location of its minting transaction in the
+	// chain.
+	ConfirmedMintingBatch = sqlite.FetchConfirmedMintingBatchesRow
+
+	// AssetSeedling is an asset seedling, along with the family key of
+	// the existing asset family it's issued into, if any.
+	AssetSeedling = sqlite.FetchSeedlingsForBatchRow
+
+	// AssetFamily holds the raw key and initial genesis of an asset
+	// family.
+	AssetFamily = sqlite.FetchAssetFamilyRow
 
 	// MintingBatchTuple is used to update a batch state based on the raw
 	// key.
@@ -116,6 +141,15 @@ type PendingAssetStore interface {
 	UpdateMintingBatchState(ctx context.Context,
 		arg BatchStateUpdate) error
 
+	// UpdateMintingBatchFeeRate updates the fee rate of an existing
+	// minting batch.
+	UpdateMintingBatchFeeRate(ctx context.Context,
+		arg BatchFeeRateUpdate) error
+
+	// DeleteSeedlingFromBatch removes a seedling from a batch based on
+	// the batch key and the name of the seedling.
+	DeleteSeedlingFromBatch(ctx context.Context, arg SeedlingRemoval) error
+
 	// InsertAssetSeedling inserts a new asset seedling (base description)
 	// into the database.
 	InsertAssetSeedling(ctx context.Context, arg AssetSeedlingShell) error
@@ -132,10 +166,21 @@ type PendingAssetStore interface {
 	// that don't have a particular state.
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]MintingBatchI, error)
 
+	// FetchConfirmedMintingBatches is used to fetch the minting batches in
+	// a particular state whose minting transaction was confirmed at or
+	// above a given block height.
+	FetchConfirmedMintingBatches(ctx context.Context,
+		arg ConfirmedBatchesQuery) ([]ConfirmedMintingBatch, error)
+
 	// FetchSeedlingsForBatch is used to fetch all the seedlings by the key
 	// of the batch they're included in.
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]AssetSeedling, error)
 
+	// FetchAssetFamily fetches the raw key and initial genesis of an
+	// asset family identified by its tweaked family key.
+	FetchAssetFamily(ctx context.Context,
+		tweakedFamKey []byte) (AssetFamily, error)
+
 	// BindMintingBatchWithTx adds the minting transaction to an existing
 	// batch.
 	BindMintingBatchWithTx(ctx context.Context, arg BatchChainUpdate) error
@@ -257,13 +302,19 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 		// internal key inserted above, we can create the set of new
 		// seedlings.
 		for _, seedling := range newBatch.Seedlings {
-			err := q.InsertAssetSeedling(ctx, AssetSeedlingShell{
+			famID, err := seedlingFamilyID(ctx, q, seedling)
+			if err != nil {
+				return err
+			}
+
+			err = q.InsertAssetSeedling(ctx, AssetSeedlingShell{
 				BatchID:         batchID,
 				AssetName:       seedling.AssetName,
 				AssetType:       int16(seedling.AssetType),
 				AssetSupply:     int64(seedling.Amount),
 				AssetMeta:       seedling.Metadata,
 				EmissionEnabled: seedling.EnableEmission,
+				AssetFamilyID:   famID,
 			})
 			if err != nil {
 				return err
@@ -288,6 +339,11 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 		// TODO(roasbeef): can make sure to use the batch insert here
 		// when postgres
 		for _, seedling := range seedlings {
+			famID, err := seedlingFamilyID(ctx, q, seedling)
+			if err != nil {
+				return err
+			}
+
 			dbSeedling := AssetSeedlingItem{
 				RawKey:          rawKey,
 				AssetName:       seedling.AssetName,
@@ -295,8 +351,9 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMeta:       seedling.Metadata,
 				EmissionEnabled: seedling.EnableEmission,
+				AssetFamilyID:   famID,
 			}
-			err := q.InsertAssetSeedlingIntoBatch(ctx, dbSeedling)
+			err = q.InsertAssetSeedlingIntoBatch(ctx, dbSeedling)
 			if err != nil {
 				return fmt.Errorf("unable to insert "+
 					"seedling into db: %v", err)
@@ -307,6 +364,17 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 	})
 }
 
+// RemoveSeedlingFromBatch removes the seedling with the given asset name from
+// an existing batch.
+func (a *AssetMintingStore) RemoveSeedlingFromBatch(ctx context.Context,
+	batchKey *btcec.PublicKey, assetName string) error {
+
+	return a.db.DeleteSeedlingFromBatch(ctx, SeedlingRemoval{
+		RawKey:    batchKey.SerializeCompressed(),
+		AssetName: assetName,
+	})
+}
+
 // fetchAssetSeedlings attempts to fetch a set of asset seedlings for a given
 // batch. This is performed wtihin the context of a greater DB transaction.
 func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
@@ -323,6 +391,7 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 
 	seedlings := make(map[string]*tarogarden.Seedling)
 	for _, seedling := range dbSeedlings {
+		famKeyBytes := seedling.FamilyKey
 		seedling := &tarogarden.Seedling{
 			AssetType: asset.Type(
 				seedling.AssetType,
@@ -335,12 +404,123 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			EnableEmission: seedling.EmissionEnabled,
 		}
 
+		// If this seedling is issued into an existing asset family,
+		// then we'll also need to load the information of that family.
+		if len(famKeyBytes) != 0 {
+			famKey, err := btcec.ParsePubKey(famKeyBytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"family key: %w", err)
+			}
+
+			famInfo, err := fetchAssetFamily(ctx, q, famKey)
+			if err != nil {
+				return nil, err
+			}
+
+			seedling.FamilyKey = famKey
+			seedling.FamilyInfo = famInfo
+		}
+
 		seedlings[seedling.AssetName] = seedling
 	}
 
 	return seedlings, nil
 }
 
+// seedlingFamilyID returns the primary key of the existing asset family the
+// passed seedling is issued into, or a NULL value if the seedling doesn't
+// reference an existing family.
+func seedlingFamilyID(ctx context.Context, q PendingAssetStore,
+	seedling *tarogarden.Seedling) (sql.NullInt32, error) {
+
+	if seedling.FamilyKey == nil {
+		return sql.NullInt32{}, nil
+	}
+
+	dbFamily, err := q.FetchAssetFamily(
+		ctx, seedling.FamilyKey.SerializeCompressed(),
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return sql.NullInt32{}, tarogarden.ErrNoAssetFamily
+
+	case err != nil:
+		return sql.NullInt32{}, fmt.Errorf("unable to fetch asset "+
+			"family: %w", err)
+	}
+
+	return sqlInt32(dbFamily.FamilyID), nil
+}
+
+// fetchAssetFamily fetches the raw key and the initial genesis of the asset
+// family identified by the passed tweaked family key.
+func fetchAssetFamily(ctx context.Context, q PendingAssetStore,
+	famKey *btcec.PublicKey) (*asset.AssetFamily, error) {
+
+	dbFamily, err := q.FetchAssetFamily(ctx, famKey.SerializeCompressed())
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, tarogarden.ErrNoAssetFamily
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch asset family: %w", err)
+	}
+
+	rawKey, err := btcec.ParsePubKey(dbFamily.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse raw family key: %w",
+			err)
+	}
+
+	var genesisPrevOut wire.OutPoint
+	err = readOutPoint(
+		bytes.NewReader(dbFamily.GenesisPrevOut), 0, 0, &genesisPrevOut,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read genesis point: %w", err)
+	}
+
+	return &asset.AssetFamily{
+		Genesis: asset.Genesis{
+			FirstPrevOut: genesisPrevOut,
+			Tag:          dbFamily.AssetTag,
+			Metadata:     dbFamily.MetaData,
+			OutputIndex:  uint32(dbFamily.OutputIndex),
+			Type:         asset.Type(dbFamily.AssetType),
+		},
+		RawKey: keychain.KeyDescriptor{
+			PubKey: rawKey,
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(dbFamily.KeyFamily),
+				Index:  uint32(dbFamily.KeyIndex),
+			},
+		},
+		FamKey: famKey,
+	}, nil
+}
+
+// FetchAssetFamily fetches the information needed to issue a new asset into
+// the existing asset family identified by the passed tweaked family key.
+func (a *AssetMintingStore) FetchAssetFamily(ctx context.Context,
+	famKey *btcec.PublicKey) (*asset.AssetFamily, error) {
+
+	var (
+		famInfo *asset.AssetFamily
+		err     error
+	)
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		famInfo, err = fetchAssetFamily(ctx, q, famKey)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return famInfo, nil
+}
+
 // fetchAssetSprouts fetches all the asset sprouts, or unconfirmed assets
 // associated with a given batch. The assets are them inserted into a Taro
 // commitment for easy handling.
@@ -478,6 +658,77 @@ func fetchAssetSprouts(ctx context.Context, q PendingAssetStore,
 	return taroCommitment, nil
 }
 
+// marshalMintingBatch converts a minting batch read from disk into the
+// corresponding tarogarden.MintingBatch, fetching either its seedlings or its
+// sprouts depending on the state of the batch.
+func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
+	batch MintingBatchI) (*tarogarden.MintingBatch, error) {
+
+	batchKey, err := btcec.ParsePubKey(batch.RawKey)
+	if err != nil {
+		return nil, err
+	}
+	mintingBatch := &tarogarden.MintingBatch{
+		BatchState: tarogarden.BatchState(batch.BatchState),
+		BatchKey: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(batch.KeyFamily),
+				Index:  uint32(batch.KeyIndex),
+			},
+			PubKey: batchKey,
+		},
+		CreationTime: batch.CreationTimeUnix,
+		FeeRate: extractSqlInt64[chainfee.SatPerKWeight](
+			batch.BatchFeeRate,
+		),
+	}
+
+	if batch.MintingTxPsbt != nil {
+		genesisPkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(batch.MintingTxPsbt), false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mintingBatch.GenesisPacket = &tarogarden.FundedPsbt{
+			Pkt: genesisPkt,
+			ChangeOutputIndex: extractSqlInt16[uint32](
+				batch.MintingOutputIndex,
+			),
+		}
+	}
+
+	// Depending on what state this batch is in, we'll either fetch the set
+	// of seedlings (asset descriptions w/ no real assets), or the set of
+	// sprouts (full defined assets, but not yet mined). Once a batch is
+	// finalized, its assets may have been transferred already, so we only
+	// fetch the seedlings it was created from.
+	switch mintingBatch.BatchState {
+	case tarogarden.BatchStatePending, tarogarden.BatchStateFrozen,
+		tarogarden.BatchStateFinalized:
+
+		// In this case we can just fetch the set of descriptions of
+		// future assets to be.
+		mintingBatch.Seedlings, err = fetchAssetSeedlings(
+			ctx, q, batch.RawKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return mintingBatch, nil
+	}
+
+	mintingBatch.RootAssetCommitment, err = fetchAssetSprouts(
+		ctx, q, batch.RawKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mintingBatch, nil
+}
+
 // FetchNonFinalBatches fetches all the batches that aren't fully finalized on
 // disk.
 func (a *AssetMintingStore) FetchNonFinalBatches(ctx context.Context,
@@ -497,70 +748,142 @@ func (a *AssetMintingStore) FetchNonFinalBatches(ctx context.Context,
 		}
 
 		// For each batch returned, we'll assemble an intermediate
-		// batch struct, then fill in all the seedlings with another
-		// sub-query.
+		// batch struct, then fill in all the seedlings or sprouts with
+		// another sub-query.
+		batches = make([]*tarogarden.MintingBatch, 0, len(dbBatches))
+		for _, batch := range dbBatches {
+			// Cancelled batches are never resumed, so they don't
+			// count as non-final.
+			cancelled := int16(tarogarden.BatchStateCancelled)
+			if batch.BatchState == cancelled {
+				continue
+			}
+
+			mintingBatch, err := marshalMintingBatch(ctx, q, batch)
+			if err != nil {
+				return err
+			}
+			batches = append(batches, mintingBatch)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return batches, nil
+}
+
+// FetchFinalizedBatches fetches all the batches that are fully finalized on
+// disk. Only the seedlings and the genesis packet of each batch are
+// populated.
+func (a *AssetMintingStore) FetchFinalizedBatches(ctx context.Context,
+) ([]*tarogarden.MintingBatch, error) {
+
+	var batches []*tarogarden.MintingBatch
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbBatches, err := q.FetchMintingBatchesByState(
+			ctx, int16(tarogarden.BatchStateFinalized),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch finalized minting "+
+				"batches: %v", err)
+		}
+
 		batches = make([]*tarogarden.MintingBatch, len(dbBatches))
 		for i, batch := range dbBatches {
-			batchKey, err := btcec.ParsePubKey(batch.RawKey)
+			batches[i], err = marshalMintingBatch(
+				ctx, q, MintingBatchI(batch),
+			)
 			if err != nil {
 				return err
 			}
-			batches[i] = &tarogarden.MintingBatch{
-				BatchState: tarogarden.BatchState(
-					batch.BatchState,
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return batches, nil
+}
+
+// FetchConfirmedBatches fetches all finalized batches whose minting
+// transaction was confirmed at or above the given block height, along with the
+// location of the minting transaction in the chain. The sprouts and the
+// genesis packet of these batches are populated.
+func (a *AssetMintingStore) FetchConfirmedBatches(ctx context.Context,
+	minHeight uint32) ([]*tarogarden.ConfirmedBatch, error) {
+
+	var batches []*tarogarden.ConfirmedBatch
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbBatches, err := q.FetchConfirmedMintingBatches(
+			ctx, ConfirmedBatchesQuery{
+				BatchState: int16(
+					tarogarden.BatchStateFinalized,
 				),
-				BatchKey: keychain.KeyDescriptor{
-					KeyLocator: keychain.KeyLocator{
-						Family: keychain.KeyFamily(
-							batch.KeyFamily,
-						),
-						Index: uint32(batch.KeyIndex),
-					},
-					PubKey: batchKey,
-				},
-				CreationTime: batch.CreationTimeUnix,
-			}
+				MinBlockHeight: sqlInt32(minHeight),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch confirmed minting "+
+				"batches: %v", err)
+		}
 
-			if batch.MintingTxPsbt != nil {
-				genesisPkt, err := psbt.NewFromRawBytes(
-					bytes.NewReader(batch.MintingTxPsbt), false,
-				)
-				if err != nil {
-					return err
-				}
-				batches[i].GenesisPacket = &tarogarden.FundedPsbt{
-					Pkt: genesisPkt,
-					ChangeOutputIndex: extractSqlInt16[uint32](
-						batch.MintingOutputIndex,
-					),
-				}
+		batches = make([]*tarogarden.ConfirmedBatch, len(dbBatches))
+		for i, batch := range dbBatches {
+			dbBatch := MintingBatchI{
+				BatchID:            batch.BatchID,
+				BatchState:         batch.BatchState,
+				MintingTxPsbt:      batch.MintingTxPsbt,
+				MintingOutputIndex: batch.MintingOutputIndex,
+				GenesisID:          batch.GenesisID,
+				CreationTimeUnix:   batch.CreationTimeUnix,
+				BatchFeeRate:       batch.BatchFeeRate,
+				KeyID:              batch.KeyID,
+				RawKey:             batch.RawKey,
+				KeyFamily:          batch.KeyFamily,
+				KeyIndex:           batch.KeyIndex,
+			}
+			mintingBatch, err := marshalMintingBatch(
+				ctx, q, dbBatch,
+			)
+			if err != nil {
+				return err
 			}
 
-			// Depending on what state this batch is in, we'll
-			// either fetch the set of seedlings (asset
-			// descriptions w/ no real assets), or the set of
-			// sprouts (full defined assets, but not yet mined).
-			switch batches[i].BatchState {
-			case tarogarden.BatchStatePending,
-				tarogarden.BatchStateFrozen:
-				// In this case we can just fetch the set of
-				// descriptions of future assets to be.
-				batches[i].Seedlings, err = fetchAssetSeedlings(
-					ctx, q, batch.RawKey,
-				)
-				if err != nil {
-					return err
-				}
-
-				continue
+			// The minting proofs of a batch are created from its
+			// sprouts, so we need them in case the minting
+			// transaction is re-confirmed after a reorg.
+			sprouts, err := fetchAssetSprouts(ctx, q, batch.RawKey)
+			if err != nil {
+				return err
 			}
+			mintingBatch.RootAssetCommitment = sprouts
 
-			batches[i].RootAssetCommitment, err = fetchAssetSprouts(
-				ctx, q, batch.RawKey,
+			blockHash, err := chainhash.NewHash(
+				batch.MintingTxBlockHash,
 			)
 			if err != nil {
 				return err
 			}
+
+			batches[i] = &tarogarden.ConfirmedBatch{
+				MintingBatch: mintingBatch,
+				BlockHash:    *blockHash,
+				BlockHeight: extractSqlInt32[uint32](
+					batch.MintingTxBlockHeight,
+				),
+				TxIndex: extractSqlInt32[uint32](
+					batch.MintingTxIndex,
+				),
+			}
 		}
 
 		return nil
@@ -572,6 +895,17 @@ func (a *AssetMintingStore) FetchNonFinalBatches(ctx context.Context,
 	return batches, nil
 }
 
+// UpdateBatchFeeRate updates the fee rate the genesis transaction of a batch
+// is funded with, based on the batch key.
+func (a *AssetMintingStore) UpdateBatchFeeRate(ctx context.Context,
+	batchKey *btcec.PublicKey, feeRate chainfee.SatPerKWeight) error {
+
+	return a.db.UpdateMintingBatchFeeRate(ctx, BatchFeeRateUpdate{
+		RawKey:       batchKey.SerializeCompressed(),
+		BatchFeeRate: sqlInt64(feeRate),
+	})
+}
+
 // UpdateBatchState updates the state of a batch based on the batch key.
 func (a *AssetMintingStore) UpdateBatchState(ctx context.Context,
 	batchKey *btcec.PublicKey, newState tarogarden.BatchState) error {
@@ -21,6 +21,7 @@ import (
 	"github.com/lightninglabs/taro/tarodb/sqlite"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 )
@@ -70,11 +71,25 @@ func randSeedlings(t *testing.T, numSeedlings int) map[string]*tarogarden.Seedli
 			t.Fatalf("unable to read str: %v", err)
 		}
 		assetName := hex.EncodeToString(n[:])
+		assetType := asset.Type(rand.Int31n(2))
+
+		// Normal assets can be displayed with decimal places, which are
+		// committed to in their metadata.
+		meta := &asset.Meta{
+			Type: asset.MetaOpaque,
+			Data: n[:],
+		}
+		if assetType == asset.Normal {
+			meta.DecimalDisplay = uint32(rand.Int31n(3))
+		}
+		metadata, err := meta.Encode()
+		require.NoError(t, err)
+
 		seedlings[assetName] = &tarogarden.Seedling{
-			AssetType:      asset.Type(rand.Int31n(2)),
+			AssetType:      assetType,
 			AssetName:      assetName,
-			Metadata:       n[:],
-			Amount:         uint64(rand.Int63()),
+			Metadata:       metadata,
+			Amount:         uint64(rand.Int31()),
 			EnableEmission: randBool(),
 		}
 	}
@@ -189,12 +204,24 @@ func TestCommitMintingBatchSeedlings(t *testing.T) {
 	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
 	assertSeedlingBatchLen(t, mintingBatches, 0, 0)
 
+	// Instead, the batch should now be returned as a finalized batch,
+	// along with all its seedlings.
+	finalBatches := noError1(t, assetStore.FetchFinalizedBatches, ctx)
+	assertSeedlingBatchLen(t, finalBatches, 1, numSeedlings*2)
+	assertBatchState(t, finalBatches[0], tarogarden.BatchStateFinalized)
+	require.Equal(t, mintingBatch.Seedlings, finalBatches[0].Seedlings)
+
 	// Insert another normal batch into the database. We should get this
 	// batch back if we query for the set of non final batches.
 	mintingBatch = randSeedlingMintingBatch(t, numSeedlings)
 	require.NoError(t, err, assetStore.CommitMintingBatch(ctx, mintingBatch))
 	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
 	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings)
+
+	// The new batch isn't final, so it shouldn't show up as a finalized
+	// batch.
+	finalBatches = noError1(t, assetStore.FetchFinalizedBatches, ctx)
+	require.Len(t, finalBatches, 1)
 }
 
 func randKeyDesc(t *testing.T) (keychain.KeyDescriptor, *btcec.PrivateKey) {
@@ -233,7 +260,7 @@ func seedlingsToAssetRoot(t *testing.T, genesisPoint wire.OutPoint,
 			famKeyRaw, famPriv := randKeyDesc(t)
 			famKey, err := asset.DeriveFamilyKey(
 				asset.NewRawKeyGenesisSigner(famPriv),
-				famKeyRaw, assetGen,
+				famKeyRaw, assetGen, nil,
 			)
 			require.NoError(t, err)
 
@@ -555,6 +582,10 @@ func TestCommitBatchChainActions(t *testing.T) {
 		require.True(t, ok)
 
 		require.Equal(t, newAsset.Amount, assetBalance.Balance)
+		require.Equal(
+			t, newAsset.Genesis.Meta().DecimalDisplay,
+			assetBalance.DecimalDisplay,
+		)
 	}
 
 	// We'll also now ensure that if we group by key family, then we're
@@ -569,10 +600,13 @@ func TestCommitBatchChainActions(t *testing.T) {
 	numKeyFams := chanutils.Reduce(mintedAssets, keyFamSumReducer)
 	assetBalancesByFam, err := confAssets.QueryAssetBalancesByFamily(ctx, nil)
 	require.NoError(t, err)
-	require.Equal(t, numKeyFams, len(assetBalancesByFam))
 
+	// All assets without a family are aggregated into a single balance
+	// under the empty key.
+	var noFamBalance uint64
 	for _, newAsset := range mintedAssets {
 		if newAsset.FamilyKey == nil {
+			noFamBalance += newAsset.Amount
 			continue
 		}
 
@@ -581,7 +615,69 @@ func TestCommitBatchChainActions(t *testing.T) {
 		require.True(t, ok)
 
 		require.Equal(t, newAsset.Amount, assetBalance.Balance)
+		require.Equal(
+			t, newAsset.Genesis.Meta().DecimalDisplay,
+			assetBalance.DecimalDisplay,
+		)
 	}
+
+	if numKeyFams == len(mintedAssets) {
+		require.Equal(t, numKeyFams, len(assetBalancesByFam))
+	} else {
+		require.Equal(t, numKeyFams+1, len(assetBalancesByFam))
+
+		var emptyKey asset.SerializedKey
+		require.Equal(
+			t, noFamBalance, assetBalancesByFam[emptyKey].Balance,
+		)
+	}
+
+	// Finally, all the assets should show up as minted by the genesis
+	// transaction of the batch.
+	mints, err := confAssets.QueryAssetMints(ctx, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, mints, numSeedlings)
+
+	mintedAmts := make(map[asset.ID]uint64, numSeedlings)
+	for _, newAsset := range mintedAssets {
+		mintedAmts[newAsset.ID()] = newAsset.Amount
+	}
+	for _, mint := range mints {
+		require.Equal(t, mintedAmts[mint.AssetID], mint.Amount)
+		require.Equal(t, genTXID, *mint.AnchorTxid)
+		require.Equal(t, blockHeight, mint.BlockHeight)
+	}
+
+	// We should also be able to only query for the mint of a single
+	// asset.
+	mintedID := mintedAssets[0].ID()
+	mints, err = confAssets.QueryAssetMints(ctx, &mintedID, nil)
+	require.NoError(t, err)
+	require.Len(t, mints, 1)
+	require.Equal(t, mintedID, mints[0].AssetID)
+
+	// Once the batch is finalized, it should be returned along with its
+	// confirmation when querying for batches confirmed at or above its
+	// block height, but not above.
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, batchKey, tarogarden.BatchStateFinalized,
+	))
+	confBatches, err := assetStore.FetchConfirmedBatches(ctx, blockHeight)
+	require.NoError(t, err)
+	require.Len(t, confBatches, 1)
+	require.Equal(t, fakeBlockHash, confBatches[0].BlockHash)
+	require.Equal(t, blockHeight, confBatches[0].BlockHeight)
+	require.Equal(t, txIndex, confBatches[0].TxIndex)
+	require.Equal(
+		t, assetRoot.TapscriptRoot(nil),
+		confBatches[0].RootAssetCommitment.TapscriptRoot(nil),
+	)
+
+	confBatches, err = assetStore.FetchConfirmedBatches(
+		ctx, blockHeight+1,
+	)
+	require.NoError(t, err)
+	require.Empty(t, confBatches)
 }
 
 // TestDuplicateFamilyKey tests that if we attempt to insert a family key with
@@ -631,6 +727,157 @@ func TestDuplicateFamilyKey(t *testing.T) {
 	require.Equal(t, famID, famID2)
 }
 
+// TestAssetFamilyEmission tests that we're able to look up the raw key and
+// initial genesis of an asset family we minted, and that seedlings that are
+// issued into an existing family are properly stored and read from disk.
+func TestAssetFamilyEmission(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assetStore, _, _ := newAssetStore(t)
+
+	// First, we'll mint a new asset that has a family key, so we can
+	// issue more assets into that family later on.
+	mintingBatch := randSeedlingMintingBatch(t, 1)
+	for _, seedling := range mintingBatch.Seedlings {
+		seedling.AssetType = asset.Normal
+		seedling.EnableEmission = true
+	}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	genesisPacket := randGenesisPacket(t)
+	assetRoot := seedlingsToAssetRoot(
+		t, genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		mintingBatch.Seedlings,
+	)
+	require.NoError(t, assetStore.AddSproutsToBatch(
+		ctx, mintingBatch.BatchKey.PubKey, genesisPacket, assetRoot,
+	))
+
+	mintedAssets := assetRoot.CommittedAssets()
+	require.Len(t, mintedAssets, 1)
+	mintedAsset := mintedAssets[0]
+	famKey := &mintedAsset.FamilyKey.FamKey
+
+	// Looking up the family should give us the raw key and the genesis of
+	// the asset we just minted.
+	famInfo, err := assetStore.FetchAssetFamily(ctx, famKey)
+	require.NoError(t, err)
+	require.Equal(t, mintedAsset.Genesis, famInfo.Genesis)
+	require.Equal(t, mintedAsset.FamilyKey.RawKey, famInfo.RawKey)
+	require.True(t, famKey.IsEqual(famInfo.FamKey))
+
+	// A family key we don't know about should result in an error.
+	unknownKey, _ := randKeyDesc(t)
+	_, err = assetStore.FetchAssetFamily(ctx, unknownKey.PubKey)
+	require.ErrorIs(t, err, tarogarden.ErrNoAssetFamily)
+
+	// We'll now create a new batch with a seedling that is issued into the
+	// existing family. When reading the batch back, the family information
+	// should be populated.
+	emissionBatch := randSeedlingMintingBatch(t, 1)
+	for _, seedling := range emissionBatch.Seedlings {
+		seedling.AssetType = asset.Normal
+		seedling.EnableEmission = false
+		seedling.FamilyKey = famKey
+		seedling.FamilyInfo = famInfo
+	}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, emissionBatch))
+
+	// We'll also add a seedling to the batch afterwards, which should be
+	// stored with the same family.
+	extraSeedlings := randSeedlings(t, 1)
+	for _, seedling := range extraSeedlings {
+		seedling.AssetType = asset.Normal
+		seedling.EnableEmission = false
+		seedling.FamilyKey = famKey
+		seedling.FamilyInfo = famInfo
+	}
+	require.NoError(t, assetStore.AddSeedlingsToBatch(
+		ctx, emissionBatch.BatchKey.PubKey,
+		maps.Values(extraSeedlings)...,
+	))
+	emissionBatch.Seedlings = mergeMap(
+		emissionBatch.Seedlings, extraSeedlings,
+	)
+
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, mintingBatches, 2)
+
+	var dbBatch *tarogarden.MintingBatch
+	for _, batch := range mintingBatches {
+		if batch.BatchKey.PubKey.IsEqual(emissionBatch.BatchKey.PubKey) {
+			dbBatch = batch
+		}
+	}
+	require.NotNil(t, dbBatch)
+	assertBatchEqual(t, emissionBatch, dbBatch)
+
+	// Seedlings referencing an unknown family can't be added to a batch.
+	badSeedlings := randSeedlings(t, 1)
+	for _, seedling := range badSeedlings {
+		seedling.EnableEmission = false
+		seedling.FamilyKey = unknownKey.PubKey
+	}
+	err = assetStore.AddSeedlingsToBatch(
+		ctx, emissionBatch.BatchKey.PubKey, maps.Values(badSeedlings)...,
+	)
+	require.ErrorIs(t, err, tarogarden.ErrNoAssetFamily)
+}
+
+// TestCancelMintingBatch tests that seedlings can be removed from a pending
+// batch, that a fee rate can be attached to a batch, and that cancelled
+// batches are no longer returned as non-final batches.
+func TestCancelMintingBatch(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+
+	ctx := context.Background()
+	const numSeedlings = 5
+
+	mintingBatch := randSeedlingMintingBatch(t, numSeedlings)
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// We'll remove one of the seedlings from the batch, the batch on disk
+	// should no longer contain it.
+	seedlingName := maps.Keys(mintingBatch.Seedlings)[0]
+	delete(mintingBatch.Seedlings, seedlingName)
+	require.NoError(t, assetStore.RemoveSeedlingFromBatch(
+		ctx, batchKey, seedlingName,
+	))
+
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings-1)
+	assertBatchEqual(t, mintingBatch, mintingBatches[0])
+	require.Zero(t, mintingBatches[0].FeeRate)
+
+	// Next, we'll attach a fee rate to the batch, which should be read
+	// back along with the rest of the batch.
+	const feeRate = chainfee.SatPerKWeight(2500)
+	require.NoError(t, assetStore.UpdateBatchFeeRate(
+		ctx, batchKey, feeRate,
+	))
+
+	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings-1)
+	require.Equal(t, feeRate, mintingBatches[0].FeeRate)
+
+	// Finally, once the batch is cancelled, it shouldn't be returned as a
+	// non-final batch, nor as a finalized batch.
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, batchKey, tarogarden.BatchStateCancelled,
+	))
+
+	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertSeedlingBatchLen(t, mintingBatches, 0, 0)
+
+	finalBatches := noError1(t, assetStore.FetchFinalizedBatches, ctx)
+	require.Empty(t, finalBatches)
+}
+
 func init() {
 	rand.Seed(time.Now().Unix())
 }
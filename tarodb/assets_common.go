@@ -75,10 +75,13 @@ func upsertGenesis(ctx context.Context, q UpsertAssetStore,
 	// Then we'll insert the genesis_assets row which tracks all the
 	// information that uniquely derives a given asset ID.
 	assetID := genesis.ID()
+	metaHash := genesis.MetadataHash()
 	genAssetID, err := q.UpsertGenesisAsset(ctx, GenesisAsset{
 		AssetID:        assetID[:],
 		AssetTag:       genesis.Tag,
 		MetaData:       genesis.Metadata,
+		MetaHash:       metaHash[:],
+		DecimalDisplay: int32(genesis.Meta().DecimalDisplay),
 		OutputIndex:    int32(genesis.OutputIndex),
 		AssetType:      int16(genesis.Type),
 		GenesisPointID: genesisPointID,
@@ -215,9 +218,9 @@ func upsertFamilyKey(ctx context.Context, familyKey *asset.FamilyKey,
 	// With the statement above complete, we'll now insert the
 	// asset_family_sig entry for this, which has a one-to-many relationship
 	// with family keys (there can be many sigs for a family key which link
-	// together otherwise disparate asset IDs).
-	//
-	// TODO(roasbeef): sig here doesn't actually matter?
+	// together otherwise disparate asset IDs). The sig covers the full
+	// genesis of the asset and is what proves its membership in the
+	// family, so we need to retain it to re-create the asset later.
 	famSigID, err := q.UpsertAssetFamilySig(ctx, AssetFamSig{
 		GenesisSig: familyKey.Sig.Serialize(),
 		GenAssetID: genAssetID,
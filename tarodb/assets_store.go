@@ -3,9 +3,11 @@ package tarodb
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -22,6 +24,17 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+var (
+	// ErrAssetMetaNotFound is returned when the metadata of an asset
+	// can't be found in the database.
+	ErrAssetMetaNotFound = errors.New("asset meta not found")
+
+	// ErrLeaseInUse is returned when the lease on a managed UTXO that's
+	// the input of a transfer that was already logged to disk is
+	// released.
+	ErrLeaseInUse = errors.New("utxo is the input of a logged transfer")
+)
+
 type (
 	// ConfirmedAsset is an asset that has been fully confirmed on chain.
 	ConfirmedAsset = sqlite.QueryAssetsRow
@@ -55,6 +68,14 @@ type (
 	// or for things like coin selection.
 	QueryAssetFilters = sqlite.QueryAssetsParams
 
+	// SpentAssetQuery lets us query the prior, now spent, states of assets
+	// based on a set of filters.
+	SpentAssetQuery = sqlite.QuerySpentAssetsParams
+
+	// RawSpentAsset is a prior state of an asset that was spent by an
+	// outbound transfer.
+	RawSpentAsset = sqlite.QuerySpentAssetsRow
+
 	// UtxoQuery lets us query a managed UTXO by either the transaction it
 	// references, or the outpoint.
 	UtxoQuery = sqlite.FetchManagedUTXOParams
@@ -63,6 +84,14 @@ type (
 	// information it references.
 	AnchorPoint = sqlite.FetchManagedUTXORow
 
+	// ManagedUTXORow is a managed UTXO along with the internal key that
+	// was used to create its anchor output.
+	ManagedUTXORow = sqlite.FetchManagedUTXOsRow
+
+	// UtxoLeaseUpdate is used to lease a managed UTXO to an owner until
+	// a certain expiry time.
+	UtxoLeaseUpdate = sqlite.UpdateUTXOLeaseParams
+
 	// AssetAnchorUpdate is used to update the managed UTXO pointer when
 	// spending assets on chain.
 	AssetAnchorUpdate = sqlite.ReanchorAssetsParams
@@ -99,6 +128,32 @@ type (
 	// NewSpendProof is used to insert new spend proofs for the
 	// sender+receiver.
 	NewSpendProof = sqlite.InsertSpendProofsParams
+
+	// NewAssetBurn wraps the params needed to insert a new asset burn.
+	NewAssetBurn = sqlite.InsertAssetBurnParams
+
+	// TransferBurn is an asset burn that is part of a given transfer.
+	TransferBurn = sqlite.FetchAssetBurnsRow
+
+	// RawAssetBurn holds an asset burn along with the information of the
+	// transaction that anchors it.
+	RawAssetBurn = sqlite.QueryAssetBurnsRow
+
+	// AssetHistoryQuery wraps the params needed to query the mints and
+	// sends of an asset.
+	AssetHistoryQuery = sqlite.QueryAssetMintsParams
+
+	// RawAssetMint holds an asset minted by the daemon along with the
+	// information of the transaction that minted it.
+	RawAssetMint = sqlite.QueryAssetMintsRow
+
+	// TransferHistoryQuery wraps the params needed to query the outbound
+	// transfers of an asset.
+	TransferHistoryQuery = sqlite.QueryTransferHistoryParams
+
+	// RawAssetSend holds an asset that was spent by an outbound transfer
+	// along with the proof of the asset that was sent to the receiver.
+	RawAssetSend = sqlite.QueryTransferHistoryRow
 )
 
 // ActiveAssetsStore is a sub-set of the main sqlite.Querier interface that
@@ -157,6 +212,9 @@ type ActiveAssetsStore interface {
 	// or the transaction that anchors it.
 	FetchManagedUTXO(context.Context, UtxoQuery) (AnchorPoint, error)
 
+	// FetchManagedUTXOs fetches all the managed UTXOs on disk.
+	FetchManagedUTXOs(context.Context) ([]ManagedUTXORow, error)
+
 	// ReanchorAssets takes an old anchor point, then updates all assets
 	// that point to that old anchor point-to-point to the new one.
 	ReanchorAssets(ctx context.Context, arg AssetAnchorUpdate) error
@@ -169,6 +227,19 @@ type ActiveAssetsStore interface {
 	// serialized outpoint.
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 
+	// UpdateUTXOLease leases the managed UTXO identified by the passed
+	// serialized outpoint to an owner until the given expiry time.
+	UpdateUTXOLease(ctx context.Context, arg UtxoLeaseUpdate) error
+
+	// DeleteUTXOLease removes the lease on the managed UTXO identified by
+	// the passed serialized outpoint.
+	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+
+	// CountTransfersByOldAnchorPoint returns the number of transfers that
+	// spend the anchor UTXO identified by the passed serialized outpoint.
+	CountTransfersByOldAnchorPoint(ctx context.Context,
+		oldAnchorPoint []byte) (int64, error)
+
 	// ConfirmChainAnchorTx marks a new anchor transaction that was
 	// previously unconfirmed as confirmed.
 	ConfirmChainAnchorTx(ctx context.Context, arg AnchorTxConf) error
@@ -186,6 +257,11 @@ type ActiveAssetsStore interface {
 	// InsertAssetDelta inserts a new asset delta into the DB.
 	InsertAssetDelta(ctx context.Context, arg NewAssetDelta) error
 
+	// FetchAssetIDByScriptKey fetches the primary key of the asset that's
+	// locked to the given tweaked script key.
+	FetchAssetIDByScriptKey(ctx context.Context,
+		tweakedScriptKey []byte) (int32, error)
+
 	// InsertAssetTransfer inserts a new asset transfer into the DB.
 	InsertAssetTransfer(ctx context.Context,
 		arg NewAssetTransfer) (int32, error)
@@ -210,26 +286,137 @@ type ActiveAssetsStore interface {
 	// ID.
 	FetchSpendProofs(ctx context.Context,
 		transferID int32) (sqlite.FetchSpendProofsRow, error)
+
+	// InsertAssetBurn inserts a new asset burn that is part of a transfer
+	// into the DB.
+	InsertAssetBurn(ctx context.Context, arg NewAssetBurn) error
+
+	// FetchAssetBurns fetches the asset burns associated with a given
+	// transfer id.
+	FetchAssetBurns(ctx context.Context,
+		transferID int32) ([]TransferBurn, error)
+
+	// QueryAssetBurns queries for all asset burns or alternatively for the
+	// burns of the asset that matches the passed asset ID filter.
+	QueryAssetBurns(ctx context.Context,
+		assetIDFilter interface{}) ([]RawAssetBurn, error)
+
+	// QuerySpentAssets queries for the prior states of assets that were
+	// spent by outbound transfers.
+	QuerySpentAssets(ctx context.Context,
+		query SpentAssetQuery) ([]RawSpentAsset, error)
+
+	// QueryAssetMints queries for all the assets minted by the daemon,
+	// optionally filtered by asset ID or family key.
+	QueryAssetMints(ctx context.Context,
+		query AssetHistoryQuery) ([]RawAssetMint, error)
+
+	// QueryTransferHistory queries for all the assets spent by outbound
+	// transfers, optionally filtered by asset ID or family key.
+	QueryTransferHistory(ctx context.Context,
+		query TransferHistoryQuery) ([]RawAssetSend, error)
+
+	// FetchAssetMetaForAsset fetches the metadata of the asset with the
+	// given asset ID.
+	FetchAssetMetaForAsset(ctx context.Context,
+		assetID []byte) (sqlite.FetchAssetMetaForAssetRow, error)
+
+	// FetchAssetMetaByHash fetches the metadata that hashes to the given
+	// metadata hash.
+	FetchAssetMetaByHash(ctx context.Context,
+		metaHash []byte) (sqlite.FetchAssetMetaByHashRow, error)
 }
 
 // AssetBalance holds a balance query result for a particular asset or all
 // assets tracked by this daemon.
 type AssetBalance struct {
-	ID           asset.ID
-	Version      int32
-	Balance      uint64
-	Tag          string
-	Meta         []byte
-	Type         asset.Type
-	GenesisPoint wire.OutPoint
-	OutputIndex  uint32
+	ID             asset.ID
+	Version        int32
+	Balance        uint64
+	Tag            string
+	Meta           []byte
+	Type           asset.Type
+	GenesisPoint   wire.OutPoint
+	OutputIndex    uint32
+	DecimalDisplay uint32
+}
+
+// AssetBurn describes a number of asset units that were burned, along with
+// the transaction that burned them.
+type AssetBurn struct {
+	tarofreighter.AssetBurn
+
+	// AnchorTxid is the txid of the transaction that burned the asset
+	// units.
+	AnchorTxid chainhash.Hash
+
+	// AnchorBlockHash is the hash of the block that confirmed the above
+	// transaction. This is nil if the transaction isn't confirmed yet.
+	AnchorBlockHash *chainhash.Hash
+
+	// BurnTime is the time the burn was initiated.
+	BurnTime time.Time
+}
+
+// AssetMint is an asset that was minted by the daemon.
+type AssetMint struct {
+	// AssetID is the ID of the minted asset.
+	AssetID asset.ID
+
+	// FamilyKey is the optional family key of the minted asset.
+	FamilyKey *btcec.PublicKey
+
+	// Amount is the number of asset units that were minted.
+	Amount uint64
+
+	// AnchorTxid is the txid of the genesis transaction that minted the
+	// asset. This is nil if the genesis transaction hasn't been created
+	// yet.
+	AnchorTxid *chainhash.Hash
+
+	// BlockHeight is the height of the block that confirmed the genesis
+	// transaction. This is zero if it isn't confirmed yet.
+	BlockHeight uint32
+
+	// MintTime is the time the batch that minted the asset was created.
+	MintTime time.Time
+}
+
+// AssetSend is an asset that was sent or burned by an outbound transfer.
+type AssetSend struct {
+	// AssetID is the ID of the sent asset.
+	AssetID asset.ID
+
+	// FamilyKey is the optional family key of the sent asset.
+	FamilyKey *btcec.PublicKey
+
+	// Amount is the number of asset units that were sent.
+	Amount uint64
+
+	// ReceiverScriptKey is the script key of the receiver of the asset.
+	// For burns, this is the burn key.
+	ReceiverScriptKey *btcec.PublicKey
+
+	// Burn is true if the asset units were burned.
+	Burn bool
+
+	// AnchorTxid is the txid of the transaction that sent the asset.
+	AnchorTxid chainhash.Hash
+
+	// BlockHeight is the height of the block that confirmed the above
+	// transaction. This is zero if it isn't confirmed yet.
+	BlockHeight uint32
+
+	// SendTime is the time the transfer was initiated.
+	SendTime time.Time
 }
 
 // AssetFamilyBalance holds abalance query result for a particular asset family
 // or all asset families tracked by this daemon.
 type AssetFamilyBalance struct {
-	FamKey  *btcec.PublicKey
-	Balance uint64
+	FamKey         *btcec.PublicKey
+	Balance        uint64
+	DecimalDisplay uint32
 }
 
 // BatchedAssetStore combines the AssetStore interface with the BatchedTx
@@ -268,6 +455,10 @@ type ChainAsset struct {
 	// AnchorBlockHash is the blockhash that mined the anchor tx.
 	AnchorBlockHash chainhash.Hash
 
+	// AnchorBlockHeight is the height of the block that mined the anchor
+	// tx. This is zero if the anchor tx isn't confirmed yet.
+	AnchorBlockHeight uint32
+
 	// AnchorOutpoint is the outpoint that commits to the asset.
 	AnchorOutpoint wire.OutPoint
 
@@ -534,6 +725,9 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			}
 			anchorBlockHash = *anchorHash
 		}
+		anchorBlockHeight := extractSqlInt32[uint32](
+			sprout.AnchorBlockHeight,
+		)
 
 		var anchorOutpoint wire.OutPoint
 		err = readOutPoint(
@@ -558,6 +752,7 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			AnchorTx:          anchorTx,
 			AnchorTxid:        anchorTx.TxHash(),
 			AnchorBlockHash:   anchorBlockHash,
+			AnchorBlockHeight: anchorBlockHeight,
 			AnchorOutpoint:    anchorOutpoint,
 			AnchorInternalKey: anchorInternalKey,
 		}
@@ -568,8 +763,14 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 
 // constraintsToDbFilter maps application level constraints to the set of
 // filters we use in the SQL queries.
-func constraintsToDbFilter(query *AssetQueryFilters) QueryAssetFilters {
-	var assetFilter QueryAssetFilters
+func constraintsToDbFilter(query *AssetQueryFilters) (QueryAssetFilters,
+	error) {
+
+	// For sqlite using LIMIT with a value of -1 means no rows should be
+	// limited.
+	assetFilter := QueryAssetFilters{
+		NumLimit: -1,
+	}
 	if query != nil {
 		if query.MinAmt != 0 {
 			assetFilter.MinAmt = sql.NullInt64{
@@ -586,9 +787,40 @@ func constraintsToDbFilter(query *AssetQueryFilters) QueryAssetFilters {
 			assetFilter.KeyFamFilter = famKey
 		}
 		// TODO(roasbeef): only want to allow asset ID or other and not both?
+
+		if query.AssetType != nil {
+			assetFilter.AssetType = sql.NullInt16{
+				Int16: int16(*query.AssetType),
+				Valid: true,
+			}
+		}
+		if query.AnchorPoint != nil {
+			anchorPoint, err := encodeOutpoint(*query.AnchorPoint)
+			if err != nil {
+				return assetFilter, err
+			}
+			assetFilter.AnchorPoint = anchorPoint
+		}
+
+		switch {
+		case query.ConfirmedOnly && query.UnconfirmedOnly:
+			return assetFilter, fmt.Errorf("cannot filter for both " +
+				"confirmed and unconfirmed assets")
+
+		case query.ConfirmedOnly:
+			assetFilter.ConfirmedFilter = confirmedFilter
+
+		case query.UnconfirmedOnly:
+			assetFilter.ConfirmedFilter = unconfirmedFilter
+		}
+
+		assetFilter.NumOffset = query.Offset
+		if query.Limit != 0 {
+			assetFilter.NumLimit = query.Limit
+		}
 	}
 
-	return assetFilter
+	return assetFilter, nil
 }
 
 // fetchAssetsWithWitness fetches the set of assets in the backing store based
@@ -618,10 +850,43 @@ func fetchAssetsWithWitness(ctx context.Context, q ActiveAssetsStore,
 	return dbAssets, assetWitnesses, nil
 }
 
+const (
+	// confirmedFilter is the value of the confirmed_filter query param
+	// that only selects assets with a confirmed anchor transaction.
+	confirmedFilter = 1
+
+	// unconfirmedFilter is the value of the confirmed_filter query param
+	// that only selects assets with an unconfirmed anchor transaction.
+	unconfirmedFilter = 2
+)
+
 // AssetQueryFilters is a wrapper struct over the CommitmentConstraints struct
 // which lets us filter the results of the set of assets returned.
 type AssetQueryFilters struct {
 	tarofreighter.CommitmentConstraints
+
+	// AssetType if set, only selects assets of the given type.
+	AssetType *asset.Type
+
+	// AnchorPoint if set, only selects assets anchored at the given
+	// outpoint. For spent assets, this is the outpoint the asset was
+	// anchored at before it was spent.
+	AnchorPoint *wire.OutPoint
+
+	// ConfirmedOnly if true, only selects assets with a confirmed anchor
+	// transaction.
+	ConfirmedOnly bool
+
+	// UnconfirmedOnly if true, only selects assets with an unconfirmed
+	// anchor transaction.
+	UnconfirmedOnly bool
+
+	// Offset is the number of matching assets that should be skipped.
+	Offset int32
+
+	// Limit is the max number of assets that should be returned. If this
+	// is zero, then all matching assets are returned.
+	Limit int32
 }
 
 // QueryBalancesByAsset queries the balances for assets or alternatively
@@ -654,6 +919,9 @@ func (a *AssetStore) QueryBalancesByAsset(ctx context.Context,
 				Tag:         assetBalance.AssetTag,
 				Type:        asset.Type(assetBalance.AssetType),
 				OutputIndex: uint32(assetBalance.OutputIndex),
+				DecimalDisplay: uint32(
+					assetBalance.DecimalDisplay,
+				),
 			}
 
 			err = readOutPoint(
@@ -715,10 +983,18 @@ func (a *AssetStore) QueryAssetBalancesByFamily(ctx context.Context,
 				}
 			}
 
-			serializedKey := asset.ToSerialized(famKey)
+			// Assets without a family are aggregated under the
+			// empty key.
+			var serializedKey asset.SerializedKey
+			if famKey != nil {
+				serializedKey = asset.ToSerialized(famKey)
+			}
 			balances[serializedKey] = AssetFamilyBalance{
 				FamKey:  famKey,
 				Balance: uint64(famBalance.Balance),
+				DecimalDisplay: uint32(
+					famBalance.DecimalDisplay,
+				),
 			}
 		}
 
@@ -743,7 +1019,10 @@ func (a *AssetStore) FetchAllAssets(ctx context.Context,
 
 	// We'll now map the application level filtering to the type of
 	// filtering our database query understands.
-	assetFilter := constraintsToDbFilter(query)
+	assetFilter, err := constraintsToDbFilter(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// With the query constructed, we can now fetch the assets along w/
 	// their witness information.
@@ -762,6 +1041,210 @@ func (a *AssetStore) FetchAllAssets(ctx context.Context,
 	return dbAssetsToChainAssets(dbAssets, assetWitnesses)
 }
 
+// SpentAsset is a prior state of an asset that was spent by an outbound
+// transfer initiated by this daemon.
+type SpentAsset struct {
+	// Genesis is the genesis of the spent asset. This is nil for
+	// transfers logged before the asset history was recorded, if the
+	// spent asset couldn't be recovered from the later transfers.
+	Genesis *asset.Genesis
+
+	// FamilyKey is the optional family key of the spent asset.
+	FamilyKey *btcec.PublicKey
+
+	// ScriptKey is the script key the asset was locked to before it was
+	// spent.
+	ScriptKey *btcec.PublicKey
+
+	// Amount is the amount of the asset before it was spent. This is zero
+	// for transfers that were logged before the amount was recorded.
+	Amount uint64
+
+	// ChangeAmount is the amount of the asset that was kept by this
+	// daemon after the transfer.
+	ChangeAmount uint64
+
+	// AnchorOutpoint is the outpoint the asset was anchored at before it
+	// was spent.
+	AnchorOutpoint wire.OutPoint
+
+	// SpendTxid is the txid of the transaction that spent the asset.
+	SpendTxid chainhash.Hash
+
+	// Confirmed is true if the transaction that spent the asset has been
+	// confirmed.
+	Confirmed bool
+
+	// SpendTime is the time the transfer that spent the asset was
+	// initiated.
+	SpendTime time.Time
+}
+
+// FetchSpentAssets fetches the prior, now spent, states of assets based on
+// the asset history recorded by outbound transfers. The passed filters are
+// applied to the spent state of the asset.
+func (a *AssetStore) FetchSpentAssets(ctx context.Context,
+	query *AssetQueryFilters) ([]*SpentAsset, error) {
+
+	assetFilter, err := constraintsToDbFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	spentQuery := SpentAssetQuery{
+		AssetIDFilter:   assetFilter.AssetIDFilter,
+		KeyFamFilter:    assetFilter.KeyFamFilter,
+		AnchorPoint:     assetFilter.AnchorPoint,
+		AssetType:       assetFilter.AssetType,
+		MinAmt:          assetFilter.MinAmt,
+		ConfirmedFilter: assetFilter.ConfirmedFilter,
+		NumOffset:       assetFilter.NumOffset,
+		NumLimit:        assetFilter.NumLimit,
+	}
+
+	var spentAssets []*SpentAsset
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbSpentAssets, err := q.QuerySpentAssets(ctx, spentQuery)
+		if err != nil {
+			return fmt.Errorf("unable to query spent assets: %w",
+				err)
+		}
+
+		for _, dbSpent := range dbSpentAssets {
+			spentAsset, err := parseSpentAsset(dbSpent)
+			if err != nil {
+				return err
+			}
+
+			spentAssets = append(spentAssets, spentAsset)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return spentAssets, nil
+}
+
+// FetchAssetsWithSpent fetches the assets that match the passed filters,
+// followed by the prior, now spent, states of the assets that match them. The
+// offset and limit of the query are applied to the combined list, as if the
+// spent assets were appended to the unspent ones.
+func (a *AssetStore) FetchAssetsWithSpent(ctx context.Context,
+	query *AssetQueryFilters) ([]*ChainAsset, []*SpentAsset, error) {
+
+	if query == nil {
+		query = &AssetQueryFilters{}
+	}
+
+	assets, err := a.FetchAllAssets(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If the page is already filled with unspent assets, there's nothing
+	// left to do.
+	if query.Limit != 0 && len(assets) == int(query.Limit) {
+		return assets, nil, nil
+	}
+
+	// Otherwise, we'll need to know how many unspent assets precede the
+	// spent ones to obtain the offset into the spent assets. If the page
+	// isn't empty, then it contains the last of the unspent assets, so
+	// the spent assets start right after it. Otherwise, we'll count the
+	// unspent assets that were skipped.
+	var spentOffset int32
+	if len(assets) == 0 && query.Offset != 0 {
+		skippedQuery := *query
+		skippedQuery.Offset = 0
+		skippedQuery.Limit = query.Offset
+
+		skipped, err := a.FetchAllAssets(ctx, &skippedQuery)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		spentOffset = query.Offset - int32(len(skipped))
+	}
+
+	spentQuery := *query
+	spentQuery.Offset = spentOffset
+	if query.Limit != 0 {
+		spentQuery.Limit = query.Limit - int32(len(assets))
+	}
+
+	spentAssets, err := a.FetchSpentAssets(ctx, &spentQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return assets, spentAssets, nil
+}
+
+// parseSpentAsset maps a spent asset database row to a SpentAsset.
+func parseSpentAsset(dbSpent RawSpentAsset) (*SpentAsset, error) {
+	spentAsset := &SpentAsset{
+		Amount:       uint64(dbSpent.OldAmt.Int64),
+		ChangeAmount: uint64(dbSpent.NewAmt),
+		Confirmed:    len(dbSpent.SpendBlockHash) != 0,
+		SpendTime:    dbSpent.TransferTimeUnix,
+	}
+
+	// The genesis information is only missing if the asset of the delta
+	// couldn't be recovered when migrating older transfers.
+	if dbSpent.AssetTag.Valid {
+		spentAsset.Genesis = &asset.Genesis{
+			Tag:      dbSpent.AssetTag.String,
+			Metadata: dbSpent.MetaData,
+			OutputIndex: extractSqlInt32[uint32](
+				dbSpent.GenesisOutputIndex,
+			),
+			Type: asset.Type(
+				extractSqlInt16[uint8](dbSpent.AssetType),
+			),
+		}
+
+		err := readOutPoint(
+			bytes.NewReader(dbSpent.GenesisPrevOut), 0, 0,
+			&spentAsset.Genesis.FirstPrevOut,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read genesis "+
+				"point: %w", err)
+		}
+	}
+
+	err := readOutPoint(
+		bytes.NewReader(dbSpent.OldAnchorPoint), 0, 0,
+		&spentAsset.AnchorOutpoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read anchor point: %w", err)
+	}
+
+	spentAsset.ScriptKey, err = btcec.ParsePubKey(dbSpent.OldScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	if len(dbSpent.TweakedFamKey) != 0 {
+		spentAsset.FamilyKey, err = btcec.ParsePubKey(
+			dbSpent.TweakedFamKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse family key: %w",
+				err)
+		}
+	}
+
+	copy(spentAsset.SpendTxid[:], dbSpent.SpendTxid)
+
+	return spentAsset, nil
+}
+
 // FetchAssetProofs returns the latest proof file for either the set of target
 // assets, or all assets if no script keys for an asset are passed in.
 //
@@ -1063,6 +1546,76 @@ func (a *AssetStore) SelectCommitment(
 	ctx context.Context, constraints tarofreighter.CommitmentConstraints,
 ) ([]*tarofreighter.AnchoredCommitment, error) {
 
+	var selectedAssets []*tarofreighter.AnchoredCommitment
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		selectedAssets, err = selectCommitments(ctx, q, constraints)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return selectedAssets, nil
+}
+
+// LeaseCommitment selects the preferred commitment that satisfies the
+// constraints and leases its anchor UTXO to the given lease owner until the
+// expiry time. Both happen within the same database transaction, and only
+// commitments anchored at a UTXO that isn't leased or whose lease expired are
+// considered, so concurrent sends can never select the same input.
+//
+// NOTE: This implements the tarofreighter.CommitmentSelector interface.
+func (a *AssetStore) LeaseCommitment(ctx context.Context,
+	constraints tarofreighter.CommitmentConstraints, leaseOwner [32]byte,
+	expiry time.Time) (*tarofreighter.AnchoredCommitment, error) {
+
+	var selectedAsset *tarofreighter.AnchoredCommitment
+
+	var writeTxOpts AssetStoreTxOptions
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		selectedAssets, err := selectCommitments(ctx, q, constraints)
+		if err != nil {
+			return err
+		}
+		selectedAsset = selectedAssets[0]
+
+		outpointBytes, err := encodeOutpoint(selectedAsset.AnchorPoint)
+		if err != nil {
+			return err
+		}
+
+		err = q.UpdateUTXOLease(ctx, UtxoLeaseUpdate{
+			LeaseOwner: leaseOwner[:],
+			LeaseExpiry: sql.NullTime{
+				Time:  expiry.UTC(),
+				Valid: true,
+			},
+			Outpoint: outpointBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to lease utxo %v: %w",
+				selectedAsset.AnchorPoint, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return selectedAsset, nil
+}
+
+// selectCommitments queries for all the commitments that satisfy the passed
+// constraints and aren't anchored at a leased UTXO. The commitments are
+// ordered according to the coin selection strategy of the constraints.
+func selectCommitments(ctx context.Context, q ActiveAssetsStore,
+	constraints tarofreighter.CommitmentConstraints,
+) ([]*tarofreighter.AnchoredCommitment, error) {
+
 	var (
 		matchingAssets      []*ChainAsset
 		chainAnchorToAssets = make(map[wire.OutPoint][]*ChainAsset)
@@ -1072,68 +1625,77 @@ func (a *AssetStore) SelectCommitment(
 
 	// First, we'll map the commitment constraints to our database query
 	// filters.
-	assetFilter := constraintsToDbFilter(&AssetQueryFilters{
-		constraints,
+	assetFilter, err := constraintsToDbFilter(&AssetQueryFilters{
+		CommitmentConstraints: constraints,
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	readOpts := NewAssetStoreReadTx()
-	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
-		// Now that we have the set of filters we need we'll query the
-		// DB for the set of assets that matches them.
-		matchingAssets, err = queryChainAssets(ctx, q, assetFilter)
-		if err != nil {
-			return err
-		}
+	// We'll skip all assets that are anchored at a UTXO that is currently
+	// leased, as they're already used as the input of another send.
+	assetFilter.LeaseTime = sql.NullTime{
+		Time:  time.Now().UTC(),
+		Valid: true,
+	}
 
-		if len(matchingAssets) == 0 {
-			return tarofreighter.ErrNoPossibleAssetInputs
-		}
+	// Now that we have the set of filters we need we'll query the
+	// DB for the set of assets that matches them.
+	matchingAssets, err = queryChainAssets(ctx, q, assetFilter)
+	if err != nil {
+		return nil, err
+	}
 
-		// At this point, we have the set of assets that match our
-		// filter query, but we also need to be able to construct the
-		// full Taro commitment for each asset so it can be used as an
-		// input in a transaction.
-		//
-		// To obtain this, we'll first do another query to fetch all
-		// the _other_ assets that are anchored at the anchor point for
-		// each of the assets above.
-		for _, matchingAsset := range matchingAssets {
-			anchorPoint := matchingAsset.AnchorOutpoint
-			anchorPointBytes, err := encodeOutpoint(
-				matchingAsset.AnchorOutpoint,
-			)
-			if err != nil {
-				return err
-			}
-			outpointQuery := QueryAssetFilters{
-				AnchorPoint: anchorPointBytes,
-			}
+	// If the caller wants to spend specific outpoints, then we'll
+	// only keep the assets anchored at those outpoints.
+	matchingAssets = filterByAnchorPoints(
+		matchingAssets, constraints.AnchorPoints,
+	)
 
-			anchoredAssets, err := queryChainAssets(
-				ctx, q, outpointQuery,
-			)
-			if err != nil {
-				return err
-			}
+	if len(matchingAssets) == 0 {
+		return nil, tarofreighter.ErrNoPossibleAssetInputs
+	}
 
-			chainAnchorToAssets[anchorPoint] = anchoredAssets
+	// At this point, we have the set of assets that match our
+	// filter query, but we also need to be able to construct the
+	// full Taro commitment for each asset so it can be used as an
+	// input in a transaction.
+	//
+	// To obtain this, we'll first do another query to fetch all
+	// the _other_ assets that are anchored at the anchor point for
+	// each of the assets above.
+	for _, matchingAsset := range matchingAssets {
+		anchorPoint := matchingAsset.AnchorOutpoint
+		anchorPointBytes, err := encodeOutpoint(
+			matchingAsset.AnchorOutpoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+		outpointQuery := QueryAssetFilters{
+			AnchorPoint: anchorPointBytes,
+			NumLimit:    -1,
+		}
 
-			// In addition to the assets anchored at the target
-			// UTXO, we'll also fetch the managed UTXO itself.
-			anchorUTXO, err := q.FetchManagedUTXO(ctx, UtxoQuery{
-				Outpoint: anchorPointBytes,
-			})
-			if err != nil {
-				return err
-			}
+		anchoredAssets, err := queryChainAssets(
+			ctx, q, outpointQuery,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		chainAnchorToAssets[anchorPoint] = anchoredAssets
 
-			anchorPoints[anchorPoint] = anchorUTXO
+		// In addition to the assets anchored at the target
+		// UTXO, we'll also fetch the managed UTXO itself.
+		anchorUTXO, err := q.FetchManagedUTXO(ctx, UtxoQuery{
+			Outpoint: anchorPointBytes,
+		})
+		if err != nil {
+			return nil, err
 		}
 
-		return nil
-	})
-	if dbErr != nil {
-		return nil, dbErr
+		anchorPoints[anchorPoint] = anchorUTXO
 	}
 
 	// Our final query wants the complete taro commitment for each of the
@@ -1197,6 +1759,7 @@ func (a *AssetStore) SelectCommitment(
 		selectedAssets[i] = &tarofreighter.AnchoredCommitment{
 			AnchorPoint:       anchorPoint,
 			AnchorOutputValue: btcutil.Amount(anchorUTXO.AmtSats),
+			AnchorHeight:      matchingAsset.AnchorBlockHeight,
 			InternalKey: keychain.KeyDescriptor{
 				PubKey: internalKey,
 				KeyLocator: keychain.KeyLocator{
@@ -1212,15 +1775,275 @@ func (a *AssetStore) SelectCommitment(
 		}
 	}
 
-	return selectedAssets, nil
-}
-
+	// Finally, we'll order the commitments according to the coin
+	// selection strategy, so the preferred commitment comes first.
+	err = tarofreighter.OrderCommitments(
+		selectedAssets, constraints.Strategy, constraints.MinAmt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectedAssets, nil
+}
+
+// filterByAnchorPoints returns only the assets that are anchored at one of the
+// passed anchor points. If no anchor points are passed, then all assets are
+// returned.
+func filterByAnchorPoints(assets []*ChainAsset,
+	anchorPoints []wire.OutPoint) []*ChainAsset {
+
+	if len(anchorPoints) == 0 {
+		return assets
+	}
+
+	allowed := make(map[wire.OutPoint]struct{}, len(anchorPoints))
+	for _, anchorPoint := range anchorPoints {
+		allowed[anchorPoint] = struct{}{}
+	}
+
+	var filtered []*ChainAsset
+	for _, a := range assets {
+		if _, ok := allowed[a.AnchorOutpoint]; ok {
+			filtered = append(filtered, a)
+		}
+	}
+
+	return filtered
+}
+
+// ManagedUTXO holds information about a BTC UTXO managed by tarod that
+// anchors a Taro commitment.
+type ManagedUTXO struct {
+	// OutPoint is the outpoint of the UTXO.
+	OutPoint wire.OutPoint
+
+	// OutputValue is the value of the UTXO in satoshis.
+	OutputValue btcutil.Amount
+
+	// InternalKey is the internal key that's used to anchor the Taro
+	// commitment in the above outpoint.
+	InternalKey keychain.KeyDescriptor
+
+	// TaroRoot is the Taro commitment root hash of the UTXO.
+	TaroRoot []byte
+
+	// TapscriptSibling is the tapscript sibling of the Taro commitment.
+	// This will usually be blank.
+	TapscriptSibling []byte
+
+	// LeaseOwner is the owner of the lease on the UTXO. This is nil if
+	// the UTXO was never leased, or the lease was released.
+	LeaseOwner []byte
+
+	// LeaseExpiry is the time the lease on the UTXO expires. This is the
+	// zero time if the UTXO isn't leased.
+	LeaseExpiry time.Time
+}
+
+// IsLeased returns true if the UTXO is leased at the given time.
+func (m *ManagedUTXO) IsLeased(now time.Time) bool {
+	return len(m.LeaseOwner) != 0 && m.LeaseExpiry.After(now)
+}
+
+// FetchManagedUTXOs fetches all the BTC UTXOs managed by tarod that anchor a
+// Taro commitment.
+func (a *AssetStore) FetchManagedUTXOs(ctx context.Context) (
+	[]*ManagedUTXO, error) {
+
+	var utxos []ManagedUTXORow
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		utxos, err = q.FetchManagedUTXOs(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch managed utxos: %w",
+			dbErr)
+	}
+
+	managedUtxos := make([]*ManagedUTXO, len(utxos))
+	for i, u := range utxos {
+		var anchorPoint wire.OutPoint
+		err := readOutPoint(
+			bytes.NewReader(u.Outpoint), 0, 0, &anchorPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read outpoint: %w",
+				err)
+		}
+
+		internalKey, err := btcec.ParsePubKey(u.RawKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse internal "+
+				"key: %w", err)
+		}
+
+		managedUtxos[i] = &ManagedUTXO{
+			OutPoint:    anchorPoint,
+			OutputValue: btcutil.Amount(u.AmtSats),
+			InternalKey: keychain.KeyDescriptor{
+				PubKey: internalKey,
+				KeyLocator: keychain.KeyLocator{
+					Index:  uint32(u.KeyIndex),
+					Family: keychain.KeyFamily(u.KeyFamily),
+				},
+			},
+			TaroRoot:         u.TaroRoot,
+			TapscriptSibling: u.TapscriptSibling,
+			LeaseOwner:       u.LeaseOwner,
+		}
+		if u.LeaseExpiry.Valid {
+			managedUtxos[i].LeaseExpiry = u.LeaseExpiry.Time
+		}
+	}
+
+	return managedUtxos, nil
+}
+
+// ListLeases returns all the managed UTXOs that are currently leased.
+func (a *AssetStore) ListLeases(ctx context.Context) ([]*ManagedUTXO, error) {
+	managedUtxos, err := a.FetchManagedUTXOs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var leased []*ManagedUTXO
+	for _, u := range managedUtxos {
+		if u.IsLeased(now) {
+			leased = append(leased, u)
+		}
+	}
+
+	return leased, nil
+}
+
+// LeaseCoins leases the managed UTXOs at the passed outpoints to the given
+// lease owner until the expiry time. If any of the UTXOs is already leased by
+// a different owner, ErrCoinAlreadyLeased is returned and none of the UTXOs
+// are leased.
+func (a *AssetStore) LeaseCoins(ctx context.Context, leaseOwner [32]byte,
+	expiry time.Time, utxoOutpoints ...wire.OutPoint) error {
+
+	now := time.Now()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		for _, outpoint := range utxoOutpoints {
+			outpointBytes, err := encodeOutpoint(outpoint)
+			if err != nil {
+				return err
+			}
+
+			utxo, err := q.FetchManagedUTXO(ctx, UtxoQuery{
+				Outpoint: outpointBytes,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to fetch managed "+
+					"utxo %v: %w", outpoint, err)
+			}
+
+			// An active lease can only be extended by its owner.
+			isLeased := len(utxo.LeaseOwner) != 0 &&
+				utxo.LeaseExpiry.Valid &&
+				utxo.LeaseExpiry.Time.After(now)
+			isOwner := bytes.Equal(utxo.LeaseOwner, leaseOwner[:])
+			if isLeased && !isOwner {
+				return fmt.Errorf("%w: %v",
+					tarofreighter.ErrCoinAlreadyLeased,
+					outpoint)
+			}
+
+			err = q.UpdateUTXOLease(ctx, UtxoLeaseUpdate{
+				LeaseOwner: leaseOwner[:],
+				LeaseExpiry: sql.NullTime{
+					Time:  expiry.UTC(),
+					Valid: true,
+				},
+				Outpoint: outpointBytes,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to lease utxo "+
+					"%v: %w", outpoint, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReleaseCoins releases the leases on the managed UTXOs at the passed
+// outpoints, which makes them available for coin selection again.
+//
+// NOTE: This implements the tarofreighter.CommitmentSelector interface.
+func (a *AssetStore) ReleaseCoins(ctx context.Context,
+	utxoOutpoints ...wire.OutPoint) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		for _, outpoint := range utxoOutpoints {
+			outpointBytes, err := encodeOutpoint(outpoint)
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteUTXOLease(ctx, outpointBytes)
+			if err != nil {
+				return fmt.Errorf("unable to release lease "+
+					"on utxo %v: %w", outpoint, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReleaseLease releases the lease on the managed UTXO at the passed outpoint on
+// behalf of a user. Unlike ReleaseCoins, this refuses to release the lease on
+// a UTXO that's the input of a transfer that was already logged to disk, as
+// the transfer transaction might already be broadcast. Releasing it would
+// allow the assets to be selected for another send that could never confirm.
+func (a *AssetStore) ReleaseLease(ctx context.Context,
+	outpoint wire.OutPoint) error {
+
+	outpointBytes, err := encodeOutpoint(outpoint)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		numTransfers, err := q.CountTransfersByOldAnchorPoint(
+			ctx, outpointBytes,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query transfers: %w", err)
+		}
+		if numTransfers != 0 {
+			return fmt.Errorf("%w: %v", ErrLeaseInUse, outpoint)
+		}
+
+		err = q.DeleteUTXOLease(ctx, outpointBytes)
+		if err != nil {
+			return fmt.Errorf("unable to release lease on utxo "+
+				"%v: %w", outpoint, err)
+		}
+
+		return nil
+	})
+}
+
 // TODO(jhb): Update for new table
 // LogPendingParcel marks an outbound parcel as pending on disk. This commits
 // the set of changes to disk (the asset deltas) but doesn't mark the batched
 // spend as being finalized.
 func (a *AssetStore) LogPendingParcel(ctx context.Context,
-	spend *tarofreighter.OutboundParcelDelta) error {
+	spend *tarofreighter.OutboundParcelDelta, finalLeaseOwner [32]byte,
+	finalLeaseExpiry time.Time) error {
 
 	// Before we enter the DB transaction below, we'll use this space to
 	// encode a few values outside the transaction closure.
@@ -1352,8 +2175,22 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 				return fmt.Errorf("unable to insert script "+
 					"key: %w", err)
 			}
+
+			// We'll also reference the asset that's being spent,
+			// which still carries the old script key, so we can
+			// reconstruct its history later on.
+			oldScriptKey := assetDelta.OldScriptKey
+			oldScriptKeyBytes := oldScriptKey.SerializeCompressed()
+			spentAssetID, err := q.FetchAssetIDByScriptKey(
+				ctx, oldScriptKeyBytes,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch spent "+
+					"asset: %w", err)
+			}
+
 			err = q.InsertAssetDelta(ctx, NewAssetDelta{
-				OldScriptKey:            assetDelta.OldScriptKey.SerializeCompressed(),
+				OldScriptKey:            oldScriptKeyBytes,
 				NewAmt:                  int64(assetDelta.NewAmt),
 				NewScriptKey:            scriptKeyID,
 				SerializedWitnesses:     witnessBuf.Bytes(),
@@ -1364,6 +2201,11 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 					Int64: int64(splitRootSum),
 					Valid: true,
 				},
+				OldAmt: sql.NullInt64{
+					Int64: int64(assetDelta.OldAmt),
+					Valid: true,
+				},
+				AssetID: sqlInt32(spentAssetID),
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert asset "+
@@ -1371,13 +2213,52 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			}
 		}
 
+		// The old anchor point stays selectable until the transfer
+		// confirms, so we'll lease it to make sure it's not spent a
+		// second time in the meantime.
+		err = q.UpdateUTXOLease(ctx, UtxoLeaseUpdate{
+			LeaseOwner: finalLeaseOwner[:],
+			LeaseExpiry: sql.NullTime{
+				Time:  finalLeaseExpiry.UTC(),
+				Valid: true,
+			},
+			Outpoint: oldAnchorPointBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to lease old anchor "+
+				"point: %w", err)
+		}
+
+		// Finally, if this transfer burns assets, we'll record the
+		// burn, so it can be accounted for.
+		if spend.Burn == nil {
+			return nil
+		}
+
+		var famKeyBytes []byte
+		if spend.Burn.FamilyKey != nil {
+			famKeyBytes = spend.Burn.FamilyKey.SerializeCompressed()
+		}
+		err = q.InsertAssetBurn(ctx, NewAssetBurn{
+			TransferID:    transferID,
+			AssetID:       spend.Burn.AssetID[:],
+			TweakedFamKey: famKeyBytes,
+			Amount:        int64(spend.Burn.Amount),
+			BurnScriptKey: spend.Burn.ScriptKey.SerializeCompressed(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert asset burn: %w",
+				err)
+		}
+
 		return nil
 	})
 }
 
 // ConfirmParcelDelivery marks a spend event on disk as confirmed. This
 // updates the on-chain reference information on disk to point to this
-// new spend.
+// new spend. If the parcel was already confirmed before, only the on-chain
+// reference information and the final sender proof are updated.
 func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 	conf *tarofreighter.AssetConfirmEvent) error {
 
@@ -1398,18 +2279,44 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 		}
 		assetTransfer := assetTransfers[0]
 
-		// Now that we have the new managed UTXO inserted, we'll update
-		// the managed UTXO pointer for _all_ assets that were anchored
-		// by the old managed UTXO.
-		err = q.ReanchorAssets(ctx, AssetAnchorUpdate{
-			OldOutpoint: assetTransfer.OldAnchorPoint,
-			NewOutpointUtxoID: sqlInt32(
-				assetTransfer.NewAnchorUtxoID,
-			),
+		// If the transfer transaction was re-confirmed after a reorg,
+		// then the delta was already applied when it was first
+		// confirmed. In that case, no assets are anchored at the old
+		// anchor point anymore, and we only need to update the chain
+		// information and the sender proofs.
+		oldAnchorAssets, err := q.QueryAssets(ctx, QueryAssetFilters{
+			AnchorPoint: assetTransfer.OldAnchorPoint,
+			NumLimit:    1,
 		})
 		if err != nil {
 			return err
 		}
+		deltaApplied := len(oldAnchorAssets) == 0
+
+		if !deltaApplied {
+			// Now that we have the new managed UTXO inserted,
+			// we'll update the managed UTXO pointer for _all_
+			// assets that were anchored by the old managed UTXO.
+			err = q.ReanchorAssets(ctx, AssetAnchorUpdate{
+				OldOutpoint: assetTransfer.OldAnchorPoint,
+				NewOutpointUtxoID: sqlInt32(
+					assetTransfer.NewAnchorUtxoID,
+				),
+			})
+			if err != nil {
+				return err
+			}
+
+			// With the old anchor point spent, we can also release
+			// the lease we held on it while the transfer was
+			// pending.
+			err = q.DeleteUTXOLease(
+				ctx, assetTransfer.OldAnchorPoint,
+			)
+			if err != nil {
+				return err
+			}
+		}
 
 		// Now that we've re-anchored all the other assets, we also
 		// need to fetch the set of deltas so we can apply to each
@@ -1421,46 +2328,11 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 			return err
 		}
 		for _, assetDelta := range assetDeltas {
-			// First, we'll apply the spend delta to update the
-			// amount and script key of all assets.
-			assetIDKey, err := q.ApplySpendDelta(ctx, AssetSpendDelta{
-				NewAmount:                int64(assetDelta.NewAmt),
-				OldScriptKey:             assetDelta.OldScriptKey,
-				NewScriptKeyID:           assetDelta.NewScriptKeyID,
-				SplitCommitmentRootHash:  assetDelta.SplitCommitmentRootHash,
-				SplitCommitmentRootValue: assetDelta.SplitCommitmentRootValue,
-			})
-			if err != nil {
-				return fmt.Errorf("unable to update "+
-					"spend delta: %w", err)
-			}
-
-			// With the delta applied, we'll delete the _old_ set
-			// of witnesses, and re-insert new ones.
-			err = q.DeleteAssetWitnesses(ctx, assetIDKey)
-			if err != nil {
-				return fmt.Errorf("unable to delete "+
-					"witnesses: %v", err)
-			}
-
-			// With the old witnesses removed, we'll insert the new
-			// set on disk.
-			var witnessData []asset.Witness
-			err = asset.WitnessDecoder(
-				bytes.NewReader(assetDelta.SerializedWitnesses),
-				&witnessData, &[8]byte{},
-				uint64(len(assetDelta.SerializedWitnesses)),
-			)
-			if err != nil {
-				return fmt.Errorf("unable to decode "+
-					"witness: %v", err)
-			}
-			err = a.insertAssetWitnesses(
-				ctx, q, assetIDKey, witnessData,
-			)
-			if err != nil {
-				return fmt.Errorf("unable to insert asset "+
-					"witnesses: %v", err)
+			if !deltaApplied {
+				err := a.applySpendDelta(ctx, q, assetDelta)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Now we can update the asset proof for the sender for
@@ -1498,6 +2370,105 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 	})
 }
 
+// applySpendDelta applies the given spend delta to the asset it describes,
+// updating its amount, script key and witnesses.
+func (a *AssetStore) applySpendDelta(ctx context.Context, q ActiveAssetsStore,
+	assetDelta AssetDelta) error {
+
+	// First, we'll apply the spend delta to update the amount and script
+	// key of all assets.
+	assetIDKey, err := q.ApplySpendDelta(ctx, AssetSpendDelta{
+		NewAmount:                int64(assetDelta.NewAmt),
+		OldScriptKey:             assetDelta.OldScriptKey,
+		NewScriptKeyID:           assetDelta.NewScriptKeyID,
+		SplitCommitmentRootHash:  assetDelta.SplitCommitmentRootHash,
+		SplitCommitmentRootValue: assetDelta.SplitCommitmentRootValue,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update spend delta: %w", err)
+	}
+
+	// With the delta applied, we'll delete the _old_ set of witnesses, and
+	// re-insert new ones.
+	err = q.DeleteAssetWitnesses(ctx, assetIDKey)
+	if err != nil {
+		return fmt.Errorf("unable to delete witnesses: %v", err)
+	}
+
+	// With the old witnesses removed, we'll insert the new set on disk.
+	var witnessData []asset.Witness
+	err = asset.WitnessDecoder(
+		bytes.NewReader(assetDelta.SerializedWitnesses), &witnessData,
+		&[8]byte{}, uint64(len(assetDelta.SerializedWitnesses)),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode witness: %v", err)
+	}
+	err = a.insertAssetWitnesses(ctx, q, assetIDKey, witnessData)
+	if err != nil {
+		return fmt.Errorf("unable to insert asset witnesses: %v", err)
+	}
+
+	return nil
+}
+
+// ReorgParcelDelivery marks a confirmed parcel as pending again, after the
+// block that confirmed its transfer transaction was disconnected from the main
+// chain. The parcel is confirmed again with ConfirmParcelDelivery once the
+// transaction is re-confirmed.
+func (a *AssetStore) ReorgParcelDelivery(ctx context.Context,
+	anchorPoint wire.OutPoint) error {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		// Clearing the chain information of the transaction that
+		// anchors the new anchor point makes the parcel pending again.
+		return q.ConfirmChainAnchorTx(ctx, AnchorTxConf{
+			Outpoint: anchorPointBytes,
+		})
+	})
+}
+
+// UpdateReceiveProof replaces the proof of an asset that was received in the
+// given anchor point, and updates the block information of the anchor
+// transaction. This is used once the anchor transaction was confirmed in a
+// different block after a reorg. Unlike ImportProofs, no new asset is created.
+//
+// NOTE: This implements the tarogarden.ReceiveLog interface.
+func (a *AssetStore) UpdateReceiveProof(ctx context.Context,
+	anchorPoint wire.OutPoint, scriptKey *btcec.PublicKey,
+	blockHash *chainhash.Hash, blockHeight uint32, txIndex uint32,
+	proofBlob proof.Blob) error {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		err := q.UpsertAssetProof(ctx, ProofUpdate{
+			TweakedScriptKey: scriptKey.SerializeCompressed(),
+			ProofFile:        proofBlob,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update proof: %w", err)
+		}
+
+		return q.ConfirmChainAnchorTx(ctx, AnchorTxConf{
+			Outpoint:    anchorPointBytes,
+			BlockHash:   blockHash[:],
+			BlockHeight: sqlInt32(blockHeight),
+			TxIndex:     sqlInt32(txIndex),
+		})
+	})
+}
+
 // PendingParcels returns the set of parcels that haven't yet been finalized.
 // This can be used to query the set of unconfirmed
 // transactions for re-broadcast.
@@ -1507,24 +2478,49 @@ func (a *AssetStore) PendingParcels(
 	return a.QueryParcels(ctx, true)
 }
 
+// ConfirmedParcels returns the set of parcels whose transfer transaction was
+// confirmed at or above the given block height.
+//
+// NOTE: This implements the tarofreighter.ExportLog interface.
+func (a *AssetStore) ConfirmedParcels(ctx context.Context,
+	minHeight uint32) ([]*tarofreighter.OutboundParcelDelta, error) {
+
+	// A height of zero disables the height filter, but the first block
+	// can't contain a transfer anyway.
+	if minHeight == 0 {
+		minHeight = 1
+	}
+
+	return a.queryParcels(ctx, TransferQuery{
+		MinBlockHeight: int64(minHeight),
+	})
+}
+
 // QueryParcels returns the set of confirmed or unconformed parcels.
 func (a *AssetStore) QueryParcels(ctx context.Context,
 	pending bool) ([]*tarofreighter.OutboundParcelDelta, error) {
 
+	unconfOnly := 0
+	if pending {
+		unconfOnly = 1
+	}
+
+	// If we want every unconfirmed transfer, then we only pass in the
+	// UnconfOnly field.
+	return a.queryParcels(ctx, TransferQuery{
+		UnconfOnly: unconfOnly,
+	})
+}
+
+// queryParcels returns the set of parcels that match the given transfer query.
+func (a *AssetStore) queryParcels(ctx context.Context,
+	query TransferQuery) ([]*tarofreighter.OutboundParcelDelta, error) {
+
 	var deltas []*tarofreighter.OutboundParcelDelta
 
 	readOpts := NewAssetStoreReadTx()
 	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
-		unconfOnly := 0
-		if pending {
-			unconfOnly = 1
-		}
-
-		// If we want every unconfirmed transfer, then we only pass in
-		// the UnconfOnly field.
-		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
-			UnconfOnly: unconfOnly,
-		})
+		assetTransfers, err := q.QueryAssetTransfers(ctx, query)
 		if err != nil {
 			return err
 		}
@@ -1600,6 +2596,7 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 
 				spendDeltas[i] = tarofreighter.AssetSpendDelta{
 					OldScriptKey: *oldScriptKey,
+					OldAmt:       uint64(delta.OldAmt.Int64),
 					NewAmt:       uint64(delta.NewAmt),
 					NewScriptKey: asset.ScriptKey{
 						PubKey: newScriptKey,
@@ -1626,6 +2623,34 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 				}
 			}
 
+			burns, err := q.FetchAssetBurns(ctx, xfer.TransferID)
+			if err != nil {
+				return err
+			}
+
+			// A transfer burns at most a single asset, so we'll
+			// only ever find a single burn here.
+			var burn *tarofreighter.AssetBurn
+			if len(burns) > 0 {
+				burn, err = parseAssetBurn(
+					burns[0].AssetID, burns[0].TweakedFamKey,
+					burns[0].Amount, burns[0].BurnScriptKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			var anchorBlockHash *chainhash.Hash
+			if len(xfer.AnchorTxBlockHash) != 0 {
+				anchorBlockHash, err = chainhash.NewHash(
+					xfer.AnchorTxBlockHash,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
 			deltas = append(deltas, &tarofreighter.OutboundParcelDelta{
 				OldAnchorPoint: oldAnchorPoint,
 				NewAnchorPoint: newAnchorPoint,
@@ -1636,12 +2661,17 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 						Index:  uint32(xfer.InternalKeyIndex),
 					},
 				},
-				TaroRoot:         xfer.TaroRoot,
-				TapscriptSibling: xfer.TapscriptSibling,
-				AnchorTx:         anchorTx,
-				AssetSpendDeltas: spendDeltas,
-				TransferTime:     xfer.TransferTimeUnix,
-				ChainFees:        xfer.ChainFees,
+				TaroRoot:          xfer.TaroRoot,
+				TapscriptSibling:  xfer.TapscriptSibling,
+				AnchorTx:          anchorTx,
+				AssetSpendDeltas:  spendDeltas,
+				TransferTime:      xfer.TransferTimeUnix,
+				ChainFees:         xfer.ChainFees,
+				Burn:              burn,
+				AnchorTxBlockHash: anchorBlockHash,
+				AnchorTxHeight: extractSqlInt32[uint32](
+					xfer.AnchorTxBlockHeight,
+				),
 			})
 		}
 
@@ -1654,6 +2684,301 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 	return deltas, nil
 }
 
+// parseAssetBurn parses the raw database fields of an asset burn.
+func parseAssetBurn(assetID, famKeyBytes []byte, amount int64,
+	scriptKeyBytes []byte) (*tarofreighter.AssetBurn, error) {
+
+	burn := &tarofreighter.AssetBurn{
+		Amount: uint64(amount),
+	}
+	copy(burn.AssetID[:], assetID)
+
+	var err error
+	if len(famKeyBytes) != 0 {
+		burn.FamilyKey, err = btcec.ParsePubKey(famKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse family key: "+
+				"%w", err)
+		}
+	}
+
+	burn.ScriptKey, err = btcec.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse burn key: %w", err)
+	}
+
+	return burn, nil
+}
+
+// QueryBurns returns all asset burns or alternatively only the burns of the
+// asset that matches the passed asset ID filter, ordered by the time they
+// were initiated.
+func (a *AssetStore) QueryBurns(ctx context.Context,
+	assetID *asset.ID) ([]*AssetBurn, error) {
+
+	var assetFilter []byte
+	if assetID != nil {
+		assetFilter = assetID[:]
+	}
+
+	var burns []*AssetBurn
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbBurns, err := q.QueryAssetBurns(ctx, assetFilter)
+		if err != nil {
+			return fmt.Errorf("unable to query asset burns: %w",
+				err)
+		}
+
+		for _, dbBurn := range dbBurns {
+			burn, err := parseAssetBurn(
+				dbBurn.AssetID, dbBurn.TweakedFamKey,
+				dbBurn.Amount, dbBurn.BurnScriptKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			assetBurn := &AssetBurn{
+				AssetBurn: *burn,
+				BurnTime:  dbBurn.TransferTimeUnix,
+			}
+			copy(assetBurn.AnchorTxid[:], dbBurn.AnchorTxid)
+
+			if len(dbBurn.AnchorBlockHash) != 0 {
+				var blockHash chainhash.Hash
+				copy(blockHash[:], dbBurn.AnchorBlockHash)
+				assetBurn.AnchorBlockHash = &blockHash
+			}
+
+			burns = append(burns, assetBurn)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return burns, nil
+}
+
+// historyFilters maps the asset ID and family key filters of an asset history
+// query to the filters our database queries understand.
+func historyFilters(assetID *asset.ID,
+	famKey *btcec.PublicKey) (interface{}, interface{}) {
+
+	var assetFilter, famFilter []byte
+	if assetID != nil {
+		assetFilter = assetID[:]
+	}
+	if famKey != nil {
+		famFilter = famKey.SerializeCompressed()
+	}
+
+	return assetFilter, famFilter
+}
+
+// QueryAssetMints returns all assets minted by the daemon, or alternatively
+// only the ones that match the passed asset ID or family key filter, ordered
+// by the time their minting batch was created.
+func (a *AssetStore) QueryAssetMints(ctx context.Context, assetID *asset.ID,
+	famKey *btcec.PublicKey) ([]*AssetMint, error) {
+
+	assetFilter, famFilter := historyFilters(assetID, famKey)
+
+	var mints []*AssetMint
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbMints, err := q.QueryAssetMints(ctx, AssetHistoryQuery{
+			AssetIDFilter: assetFilter,
+			KeyFamFilter:  famFilter,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset mints: %w",
+				err)
+		}
+
+		for _, dbMint := range dbMints {
+			mint := &AssetMint{
+				Amount: uint64(dbMint.Amount),
+				BlockHeight: extractSqlInt32[uint32](
+					dbMint.AnchorBlockHeight,
+				),
+				MintTime: dbMint.MintTime,
+			}
+			copy(mint.AssetID[:], dbMint.AssetID)
+
+			if len(dbMint.AnchorTxid) != 0 {
+				var txid chainhash.Hash
+				copy(txid[:], dbMint.AnchorTxid)
+				mint.AnchorTxid = &txid
+			}
+
+			if len(dbMint.TweakedFamKey) != 0 {
+				mint.FamilyKey, err = btcec.ParsePubKey(
+					dbMint.TweakedFamKey,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to parse "+
+						"family key: %w", err)
+				}
+			}
+
+			mints = append(mints, mint)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return mints, nil
+}
+
+// QueryAssetSends returns all assets sent or burned by outbound transfers, or
+// alternatively only the ones that match the passed asset ID or family key
+// filter, ordered by the time the transfers were initiated.
+func (a *AssetStore) QueryAssetSends(ctx context.Context, assetID *asset.ID,
+	famKey *btcec.PublicKey) ([]*AssetSend, error) {
+
+	assetFilter, famFilter := historyFilters(assetID, famKey)
+
+	var sends []*AssetSend
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbSends, err := q.QueryTransferHistory(ctx, TransferHistoryQuery{
+			AssetIDFilter: assetFilter,
+			KeyFamFilter:  famFilter,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query transfer "+
+				"history: %w", err)
+		}
+
+		for _, dbSend := range dbSends {
+			send, err := parseAssetSend(dbSend)
+			if err != nil {
+				return err
+			}
+
+			sends = append(sends, send)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return sends, nil
+}
+
+// parseAssetSend maps a transfer history database row to an AssetSend. The
+// amount and receiver of the send are obtained from the receiver proof.
+func parseAssetSend(dbSend RawAssetSend) (*AssetSend, error) {
+	var receiverProof proof.Proof
+	err := receiverProof.Decode(bytes.NewReader(dbSend.ReceiverProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode receiver proof: %w",
+			err)
+	}
+
+	send := &AssetSend{
+		Amount:            receiverProof.Asset.Amount,
+		ReceiverScriptKey: receiverProof.Asset.ScriptKey.PubKey,
+		Burn:              dbSend.BurnAmt.Valid,
+		BlockHeight: extractSqlInt32[uint32](
+			dbSend.AnchorBlockHeight,
+		),
+		SendTime: dbSend.TransferTimeUnix,
+	}
+	copy(send.AssetID[:], dbSend.AssetID)
+	copy(send.AnchorTxid[:], dbSend.AnchorTxid)
+
+	// If the asset of the delta couldn't be recovered when migrating older
+	// transfers, then we'll fall back to the asset sent to the receiver,
+	// which shares the genesis and family key of the spent asset.
+	if len(dbSend.AssetID) == 0 {
+		send.AssetID = receiverProof.Asset.ID()
+		if receiverProof.Asset.FamilyKey != nil {
+			send.FamilyKey = &receiverProof.Asset.FamilyKey.FamKey
+		}
+
+		return send, nil
+	}
+
+	if len(dbSend.TweakedFamKey) != 0 {
+		send.FamilyKey, err = btcec.ParsePubKey(dbSend.TweakedFamKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse family key: %w",
+				err)
+		}
+	}
+
+	return send, nil
+}
+
+// FetchAssetMetaForAsset returns the metadata preimage of the asset with the
+// given asset ID.
+func (a *AssetStore) FetchAssetMetaForAsset(ctx context.Context,
+	assetID asset.ID) ([]byte, error) {
+
+	var metadata []byte
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbMeta, err := q.FetchAssetMetaForAsset(ctx, assetID[:])
+		if err != nil {
+			return err
+		}
+
+		metadata = dbMeta.MetaData
+
+		return nil
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, ErrAssetMetaNotFound
+	case dbErr != nil:
+		return nil, fmt.Errorf("unable to fetch asset meta: %w", dbErr)
+	}
+
+	return metadata, nil
+}
+
+// FetchAssetMetaByHash returns the metadata preimage of the passed metadata
+// hash, if any asset that commits to it is known.
+func (a *AssetStore) FetchAssetMetaByHash(ctx context.Context,
+	metaHash [sha256.Size]byte) ([]byte, error) {
+
+	var metadata []byte
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbMeta, err := q.FetchAssetMetaByHash(ctx, metaHash[:])
+		if err != nil {
+			return err
+		}
+
+		metadata = dbMeta.MetaData
+
+		return nil
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return nil, ErrAssetMetaNotFound
+	case dbErr != nil:
+		return nil, fmt.Errorf("unable to fetch asset meta: %w", dbErr)
+	}
+
+	return metadata, nil
+}
+
 // A compile-time constraint to ensure that AssetStore meets the proof.Archiver
 // interface.
 var _ proof.Archiver = (*AssetStore)(nil)
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -178,7 +180,7 @@ func randAsset(t *testing.T, genOpts ...assetGenOpt) *asset.Asset {
 	famKey, sig, err := genSigner.SignGenesis(
 		keychain.KeyDescriptor{
 			PubKey: famPriv.PubKey(),
-		}, genesis,
+		}, genesis, nil,
 	)
 	require.NoError(t, err)
 
@@ -406,6 +408,39 @@ func TestImportAssetProof(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, selectedAssets, 1)
 	assertAssetEqual(t, testAsset, selectedAssets[0].Asset)
+
+	// The metadata of the asset should be revealed both by its asset ID
+	// and by the metadata hash committed to in the asset ID.
+	metadata, err := assetStore.FetchAssetMetaForAsset(ctx, assetID)
+	require.NoError(t, err)
+	require.Equal(t, testAsset.Genesis.Metadata, metadata)
+
+	metadata, err = assetStore.FetchAssetMetaByHash(
+		ctx, testAsset.Genesis.MetadataHash(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, testAsset.Genesis.Metadata, metadata)
+
+	_, err = assetStore.FetchAssetMetaByHash(ctx, sha256.Sum256([]byte{1}))
+	require.ErrorIs(t, err, ErrAssetMetaNotFound)
+
+	// Assets stored before the meta hash was added don't have one, until
+	// it's filled in on the next start.
+	_, err = db.ExecContext(
+		ctx, "UPDATE genesis_assets SET meta_hash = NULL",
+	)
+	require.NoError(t, err)
+	_, err = assetStore.FetchAssetMetaByHash(
+		ctx, testAsset.Genesis.MetadataHash(),
+	)
+	require.ErrorIs(t, err, ErrAssetMetaNotFound)
+
+	require.NoError(t, backfillMetaHashes(db.DB, db.Queries))
+	metadata, err = assetStore.FetchAssetMetaByHash(
+		ctx, testAsset.Genesis.MetadataHash(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, testAsset.Genesis.Metadata, metadata)
 }
 
 // TestInternalKeyUpsert tests that if we insert an internal key that's a
@@ -581,7 +616,7 @@ func (a *assetGenerator) bindKeyFamily(i int, op wire.OutPoint) *btcec.PublicKey
 	famPriv := *a.familyKeys[i]
 
 	tweakedPriv := txscript.TweakTaprootPrivKey(
-		&famPriv, gen.FamilyKeyTweak(),
+		famPriv, gen.FamilyKeyTweak(),
 	)
 
 	pub, _ := schnorr.ParsePubKey(
@@ -707,6 +742,57 @@ func TestSelectCommitment(t *testing.T) {
 			},
 			numAssets: 1,
 		},
+
+		// The asset matches all the constraints, and is anchored at
+		// one of the explicitly requested anchor points.
+		{
+			name: "asset at requested anchor point",
+			assets: []assetDesc{
+				{
+					assetGen: assetGen.assetGens[0],
+					amt:      5,
+
+					anchorPoint: assetGen.anchorPoints[0],
+				},
+			},
+			constraints: tarofreighter.CommitmentConstraints{
+				AssetID: assetGen.bindAssetID(
+					0, assetGen.anchorPoints[0],
+				),
+				MinAmt: 2,
+				AnchorPoints: []wire.OutPoint{
+					assetGen.anchorPoints[1],
+					assetGen.anchorPoints[0],
+				},
+				Strategy: tarofreighter.CoinSelectLargestFirst,
+			},
+			numAssets: 1,
+		},
+
+		// The asset matches all the constraints, but isn't anchored at
+		// any of the explicitly requested anchor points.
+		{
+			name: "asset not at requested anchor point",
+			assets: []assetDesc{
+				{
+					assetGen: assetGen.assetGens[0],
+					amt:      5,
+
+					anchorPoint: assetGen.anchorPoints[0],
+				},
+			},
+			constraints: tarofreighter.CommitmentConstraints{
+				AssetID: assetGen.bindAssetID(
+					0, assetGen.anchorPoints[0],
+				),
+				MinAmt: 2,
+				AnchorPoints: []wire.OutPoint{
+					assetGen.anchorPoints[1],
+				},
+			},
+			numAssets: 0,
+			err:       tarofreighter.ErrNoPossibleAssetInputs,
+		},
 	}
 
 	ctx := context.Background()
@@ -736,6 +822,352 @@ func TestSelectCommitment(t *testing.T) {
 	}
 }
 
+// TestUtxoLeases tests that leased UTXOs are skipped by coin selection, and
+// that leases can only be taken over by their owner until they expire.
+func TestUtxoLeases(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll start by inserting two assets, each anchored at a distinct
+	// UTXO.
+	assetGen := newAssetGenerator(t, 2, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[1],
+			amt:         20,
+		},
+	})
+
+	selectAnchorPoints := func() []wire.OutPoint {
+		commitments, err := assetsStore.SelectCommitment(
+			ctx, tarofreighter.CommitmentConstraints{
+				MinAmt: 1,
+			},
+		)
+		if errors.Is(err, tarofreighter.ErrNoPossibleAssetInputs) {
+			return nil
+		}
+		require.NoError(t, err)
+
+		anchorPoints := make([]wire.OutPoint, len(commitments))
+		for i, c := range commitments {
+			anchorPoints[i] = c.AnchorPoint
+		}
+
+		return anchorPoints
+	}
+	require.ElementsMatch(t, assetGen.anchorPoints, selectAnchorPoints())
+
+	// Once we lease the first UTXO, only the second one should be
+	// selectable.
+	ownerA := sha256.Sum256([]byte("a"))
+	ownerB := sha256.Sum256([]byte("b"))
+	expiry := time.Now().Add(time.Hour)
+	err := assetsStore.LeaseCoins(
+		ctx, ownerA, expiry, assetGen.anchorPoints[0],
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, []wire.OutPoint{assetGen.anchorPoints[1]},
+		selectAnchorPoints(),
+	)
+
+	leases, err := assetsStore.ListLeases(ctx)
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	require.Equal(t, assetGen.anchorPoints[0], leases[0].OutPoint)
+	require.Equal(t, ownerA[:], leases[0].LeaseOwner)
+
+	// A different owner can't lease the same UTXO, and none of the UTXOs
+	// passed are leased if one of them fails.
+	err = assetsStore.LeaseCoins(
+		ctx, ownerB, expiry, assetGen.anchorPoints[1],
+		assetGen.anchorPoints[0],
+	)
+	require.ErrorIs(t, err, tarofreighter.ErrCoinAlreadyLeased)
+	require.Equal(
+		t, []wire.OutPoint{assetGen.anchorPoints[1]},
+		selectAnchorPoints(),
+	)
+
+	// The owner itself can extend the lease though.
+	err = assetsStore.LeaseCoins(
+		ctx, ownerA, expiry.Add(time.Hour), assetGen.anchorPoints[0],
+	)
+	require.NoError(t, err)
+
+	// Once the lease is released, the UTXO can be selected again.
+	err = assetsStore.ReleaseCoins(ctx, assetGen.anchorPoints[0])
+	require.NoError(t, err)
+	require.ElementsMatch(t, assetGen.anchorPoints, selectAnchorPoints())
+
+	leases, err = assetsStore.ListLeases(ctx)
+	require.NoError(t, err)
+	require.Empty(t, leases)
+
+	// Selecting a commitment and leasing it happens in one step, so the
+	// same commitment can't be leased twice. The preferred commitment is
+	// the one with the larger amount.
+	largestFirst := tarofreighter.CommitmentConstraints{
+		MinAmt:   1,
+		Strategy: tarofreighter.CoinSelectLargestFirst,
+	}
+	selected, err := assetsStore.LeaseCommitment(
+		ctx, largestFirst, ownerA, expiry,
+	)
+	require.NoError(t, err)
+	require.Equal(t, assetGen.anchorPoints[1], selected.AnchorPoint)
+	require.Equal(
+		t, []wire.OutPoint{assetGen.anchorPoints[0]},
+		selectAnchorPoints(),
+	)
+
+	selected, err = assetsStore.LeaseCommitment(
+		ctx, largestFirst, ownerB, expiry,
+	)
+	require.NoError(t, err)
+	require.Equal(t, assetGen.anchorPoints[0], selected.AnchorPoint)
+	require.Empty(t, selectAnchorPoints())
+
+	_, err = assetsStore.LeaseCommitment(
+		ctx, largestFirst, ownerA, expiry,
+	)
+	require.ErrorIs(t, err, tarofreighter.ErrNoPossibleAssetInputs)
+
+	// Neither UTXO is the input of a transfer, so the user can release
+	// both leases.
+	for _, anchorPoint := range assetGen.anchorPoints {
+		require.NoError(t, assetsStore.ReleaseLease(ctx, anchorPoint))
+	}
+	require.ElementsMatch(t, assetGen.anchorPoints, selectAnchorPoints())
+
+	// An expired lease doesn't prevent the UTXO from being selected, and
+	// can be taken over by a different owner.
+	err = assetsStore.LeaseCoins(
+		ctx, ownerA, time.Now().Add(-time.Minute),
+		assetGen.anchorPoints[1],
+	)
+	require.NoError(t, err)
+	require.ElementsMatch(t, assetGen.anchorPoints, selectAnchorPoints())
+
+	err = assetsStore.LeaseCoins(
+		ctx, ownerB, expiry, assetGen.anchorPoints[1],
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, []wire.OutPoint{assetGen.anchorPoints[0]},
+		selectAnchorPoints(),
+	)
+}
+
+// TestFetchAllAssetsFilters tests that the set of assets returned by
+// FetchAllAssets can be narrowed down by the type, anchor point and
+// confirmation status of an asset, and that the results can be paginated.
+func TestFetchAllAssetsFilters(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll generate 4 assets, the first three share the same anchor
+	// transaction and the last one is anchored in its own transaction.
+	const numAssets = 4
+	assetGen := newAssetGenerator(t, numAssets, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         20,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         30,
+		},
+		{
+			assetGen:    assetGen.assetGens[3],
+			anchorPoint: assetGen.anchorPoints[1],
+			amt:         40,
+		},
+	})
+
+	normal, collectible := asset.Normal, asset.Collectible
+	tests := []struct {
+		name string
+
+		filters *AssetQueryFilters
+
+		numAssets int
+	}{
+		{
+			name:      "no filters",
+			numAssets: numAssets,
+		},
+		{
+			name: "normal asset type",
+			filters: &AssetQueryFilters{
+				AssetType: &normal,
+			},
+			numAssets: numAssets,
+		},
+		{
+			name: "collectible asset type",
+			filters: &AssetQueryFilters{
+				AssetType: &collectible,
+			},
+			numAssets: 0,
+		},
+		{
+			name: "anchor point",
+			filters: &AssetQueryFilters{
+				AnchorPoint: &assetGen.anchorPoints[0],
+			},
+			numAssets: 3,
+		},
+		{
+			name: "min amount",
+			filters: &AssetQueryFilters{
+				CommitmentConstraints: tarofreighter.CommitmentConstraints{
+					MinAmt: 30,
+				},
+			},
+			numAssets: 2,
+		},
+		{
+			name: "confirmed only",
+			filters: &AssetQueryFilters{
+				ConfirmedOnly: true,
+			},
+			numAssets: numAssets,
+		},
+		{
+			name: "unconfirmed only",
+			filters: &AssetQueryFilters{
+				UnconfirmedOnly: true,
+			},
+			numAssets: 0,
+		},
+		{
+			name: "limit",
+			filters: &AssetQueryFilters{
+				Limit: 3,
+			},
+			numAssets: 3,
+		},
+		{
+			name: "limit and offset",
+			filters: &AssetQueryFilters{
+				Offset: 3,
+				Limit:  3,
+			},
+			numAssets: 1,
+		},
+		{
+			name: "offset past end",
+			filters: &AssetQueryFilters{
+				Offset: numAssets,
+			},
+			numAssets: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			assets, err := assetsStore.FetchAllAssets(
+				ctx, test.filters,
+			)
+			require.NoError(t, err)
+			require.Len(t, assets, test.numAssets)
+		})
+	}
+
+	// Paging through all assets one by one should return each asset
+	// exactly once.
+	seen := make(map[asset.ID]struct{})
+	for i := int32(0); i < numAssets; i++ {
+		assets, err := assetsStore.FetchAllAssets(
+			ctx, &AssetQueryFilters{
+				Offset: i,
+				Limit:  1,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, assets, 1)
+
+		seen[assets[0].Genesis.ID()] = struct{}{}
+	}
+	require.Len(t, seen, numAssets)
+
+	// Both confirmation filters together are invalid.
+	_, err := assetsStore.FetchAllAssets(ctx, &AssetQueryFilters{
+		ConfirmedOnly:   true,
+		UnconfirmedOnly: true,
+	})
+	require.Error(t, err)
+}
+
+// TestFetchManagedUTXOs tests that we're able to fetch all the managed UTXOs
+// that anchor our assets, with each UTXO only being returned once, even if it
+// anchors several assets.
+func TestFetchManagedUTXOs(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll generate 3 assets, the first two share the same anchor
+	// transaction and the last one is anchored in its own transaction.
+	const numAssets = 3
+	assetGen := newAssetGenerator(t, numAssets, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         20,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+			amt:         30,
+		},
+	})
+
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 2)
+
+	for i, utxo := range utxos {
+		anchorTx := assetGen.anchorTxs[i]
+
+		require.Equal(t, assetGen.anchorPoints[i], utxo.OutPoint)
+		require.EqualValues(
+			t, anchorTx.TxOut[0].Value, utxo.OutputValue,
+		)
+		require.NotNil(t, utxo.InternalKey.PubKey)
+		require.Len(t, utxo.TaroRoot, sha256.Size)
+	}
+}
+
 // TestAssetExportLog tests that were able to properly spend/transfer assets on
 // disk. This ensures we can properly commit the end result of an asset
 // transfer initiated at a higher level.
@@ -841,6 +1273,7 @@ func TestAssetExportLog(t *testing.T) {
 		AssetSpendDeltas: []tarofreighter.AssetSpendDelta{
 			{
 				OldScriptKey: *targetScriptKey.PubKey,
+				OldAmt:       16,
 				NewAmt:       uint64(newAmt),
 				NewScriptKey: newScriptKey,
 				SplitCommitmentRoot: mssmt.NewComputedNode(
@@ -853,7 +1286,32 @@ func TestAssetExportLog(t *testing.T) {
 		},
 		ChainFees: int64(chainFees),
 	}
-	require.NoError(t, assetsStore.LogPendingParcel(ctx, spendDelta))
+	leaseOwner := sha256.Sum256(test.RandBytes(32))
+	leaseExpiry := time.Now().Add(time.Hour)
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, spendDelta, leaseOwner, leaseExpiry,
+	))
+
+	// The old anchor point should now be leased until the transfer
+	// confirms, so none of the assets anchored there can be selected.
+	leases, err := assetsStore.ListLeases(ctx)
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	require.Equal(t, spendDelta.OldAnchorPoint, leases[0].OutPoint)
+	require.Equal(t, leaseOwner[:], leases[0].LeaseOwner)
+	require.Equal(t, leaseExpiry.Unix(), leases[0].LeaseExpiry.Unix())
+
+	_, err = assetsStore.SelectCommitment(
+		ctx, tarofreighter.CommitmentConstraints{
+			MinAmt: 1,
+		},
+	)
+	require.ErrorIs(t, err, tarofreighter.ErrNoPossibleAssetInputs)
+
+	// The lease on the input of the logged transfer can't be released by
+	// the user, as the transfer might already be broadcast.
+	err = assetsStore.ReleaseLease(ctx, spendDelta.OldAnchorPoint)
+	require.ErrorIs(t, err, ErrLeaseInUse)
 
 	// At this point, we should be able to query for the log parcel, by
 	// looking for all unconfirmed transfers.
@@ -900,6 +1358,26 @@ func TestAssetExportLog(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// Now that the transfer confirmed, the lease on the old anchor point
+	// should be released.
+	leases, err = assetsStore.ListLeases(ctx)
+	require.NoError(t, err)
+	require.Empty(t, leases)
+
+	// The parcel should now be found along with its confirmation when
+	// querying for parcels confirmed at or above its block height.
+	parcels, err = assetsStore.ConfirmedParcels(ctx, uint32(blockHeight))
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Equal(t, fakeBlockHash, *parcels[0].AnchorTxBlockHash)
+	require.Equal(t, uint32(blockHeight), parcels[0].AnchorTxHeight)
+
+	parcels, err = assetsStore.ConfirmedParcels(
+		ctx, uint32(blockHeight+1),
+	)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
 	// We'll now fetch all the assets to verify that they were updated
 	// properly on disk.
 	chainAssets, err := assetsStore.FetchAllAssets(ctx, nil)
@@ -950,6 +1428,302 @@ func TestAssetExportLog(t *testing.T) {
 	parcels, err = assetsStore.PendingParcels(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(parcels))
+
+	// The prior state of the asset we modified should now show up as a
+	// confirmed spent asset, anchored at the old anchor point.
+	spentAssets, err := assetsStore.FetchSpentAssets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, spentAssets, 1)
+
+	spentAsset := spentAssets[0]
+	require.NotNil(t, spentAsset.Genesis)
+	require.Equal(
+		t, *assetGen.bindAssetID(0, assetGen.anchorPoints[0]),
+		spentAsset.Genesis.ID(),
+	)
+	require.True(t, spentAsset.ScriptKey.IsEqual(targetScriptKey.PubKey))
+	require.Equal(t, uint64(16), spentAsset.Amount)
+	require.Equal(t, uint64(newAmt), spentAsset.ChangeAmount)
+	require.Equal(t, spendDelta.OldAnchorPoint, spentAsset.AnchorOutpoint)
+	require.Equal(t, anchorTxHash, spentAsset.SpendTxid)
+	require.True(t, spentAsset.Confirmed)
+
+	// Filtering for unconfirmed spends shouldn't return anything.
+	spentAssets, err = assetsStore.FetchSpentAssets(
+		ctx, &AssetQueryFilters{
+			UnconfirmedOnly: true,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, spentAssets)
+
+	// When listing the spent assets along with the unspent ones, the
+	// pagination applies to the combined list of the three unspent assets
+	// followed by the single spent asset.
+	paginationCases := []struct {
+		offset, limit        int32
+		numUnspent, numSpent int
+	}{
+		{offset: 0, limit: 0, numUnspent: 3, numSpent: 1},
+		{offset: 0, limit: 10, numUnspent: 3, numSpent: 1},
+		{offset: 0, limit: 3, numUnspent: 3, numSpent: 0},
+		{offset: 2, limit: 2, numUnspent: 1, numSpent: 1},
+		{offset: 3, limit: 1, numUnspent: 0, numSpent: 1},
+		{offset: 4, limit: 0, numUnspent: 0, numSpent: 0},
+	}
+	for _, testCase := range paginationCases {
+		assets, spentAssets, err := assetsStore.FetchAssetsWithSpent(
+			ctx, &AssetQueryFilters{
+				Offset: testCase.offset,
+				Limit:  testCase.limit,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, assets, testCase.numUnspent)
+		require.Len(t, spentAssets, testCase.numSpent)
+	}
+
+	// A delta of an older transfer whose asset couldn't be recovered should
+	// still be returned, just without its genesis information.
+	_, err = db.ExecContext(ctx, "UPDATE asset_deltas SET asset_id = NULL")
+	require.NoError(t, err)
+	spentAssets, err = assetsStore.FetchSpentAssets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, spentAssets, 1)
+	require.Nil(t, spentAssets[0].Genesis)
+	require.True(
+		t, spentAssets[0].ScriptKey.IsEqual(targetScriptKey.PubKey),
+	)
+
+	// If the block that confirmed the transfer is disconnected, the parcel
+	// should become pending again.
+	err = assetsStore.ReorgParcelDelivery(ctx, spendDelta.NewAnchorPoint)
+	require.NoError(t, err)
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+
+	// Once the transfer is re-confirmed in a different block, only the
+	// chain information and the sender proof should be updated, the delta
+	// must not be applied a second time.
+	reorgBlockHash := chainhash.Hash(sha256.Sum256([]byte("reorg")))
+	reorgSenderBlob := bytes.Repeat([]byte{0x04}, 100)
+	err = assetsStore.ConfirmParcelDelivery(ctx, &tarofreighter.AssetConfirmEvent{
+		AnchorPoint:      spendDelta.NewAnchorPoint,
+		TxIndex:          txIndex + 1,
+		BlockHeight:      blockHeight,
+		BlockHash:        reorgBlockHash,
+		FinalSenderProof: reorgSenderBlob,
+	})
+	require.NoError(t, err)
+
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	anchorTx, err = db.FetchChainTx(ctx, anchorTxHash[:])
+	require.NoError(t, err)
+	require.Equal(t, reorgBlockHash[:], anchorTx.BlockHash[:])
+	require.Equal(
+		t, uint32(txIndex+1), extractSqlInt32[uint32](anchorTx.TxIndex),
+	)
+
+	diskSenderBlob, err = db.FetchAssetProof(
+		ctx, newScriptKey.PubKey.SerializeCompressed(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, reorgSenderBlob, diskSenderBlob.ProofFile)
+
+	chainAssets, err = assetsStore.FetchAllAssets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, chainAssets, numAssets)
+	for _, chainAsset := range chainAssets {
+		if chainAsset.ScriptKey.PubKey.IsEqual(newScriptKey.PubKey) {
+			require.Equal(t, uint64(newAmt), chainAsset.Amount)
+		}
+	}
+}
+
+// TestAssetBurns tests that asset burns are logged along with a pending
+// parcel, and that they show up in the transfer history of the asset.
+func TestAssetBurns(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	targetScriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: randPubKey(t),
+	})
+	burnKey := asset.DeriveBurnKey(asset.PrevID{
+		OutPoint: test.RandOp(t),
+		ID:       randAssetID(t),
+		ScriptKey: asset.ToSerialized(
+			targetScriptKey.PubKey,
+		),
+	})
+	burnScriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: randPubKey(t),
+	})
+	burnScriptKey.PubKey = burnKey
+
+	// We'll generate a single asset that we'll burn a part of.
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			scriptKey:   &targetScriptKey,
+			noFamKey:    true,
+			amt:         16,
+		},
+	})
+	assetID := assetGen.bindAssetID(0, assetGen.anchorPoints[0])
+
+	balances, err := assetsStore.QueryBalancesByAsset(ctx, assetID)
+	require.NoError(t, err)
+	require.EqualValues(t, 16, balances[*assetID].Balance)
+
+	newAnchorTx := wire.NewMsgTx(2)
+	newAnchorTx.AddTxIn(&wire.TxIn{})
+	newAnchorTx.TxIn[0].SignatureScript = []byte{}
+	newAnchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+
+	newScriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: randPubKey(t),
+	})
+	newRootHash := sha256.Sum256([]byte("kek"))
+
+	// The receiver proof of the parcel proves that the burned units were
+	// sent to the burn key.
+	burnedAsset := randAsset(
+		t, withAssetGen(assetGen.assetGens[0]),
+		withAssetGenPoint(assetGen.anchorPoints[0]),
+		withScriptKey(burnScriptKey), withAssetGenAmt(6),
+	)
+
+	// We'll now log a parcel that burns the 6 units.
+	spendDelta := &tarofreighter.OutboundParcelDelta{
+		OldAnchorPoint: assetGen.anchorPoints[0],
+		NewAnchorPoint: wire.OutPoint{
+			Hash:  newAnchorTx.TxHash(),
+			Index: 0,
+		},
+		NewInternalKey: keychain.KeyDescriptor{
+			PubKey: randPubKey(t),
+		},
+		TaroRoot: bytes.Repeat([]byte{0x01}, 100),
+		AnchorTx: newAnchorTx,
+		AssetSpendDeltas: []tarofreighter.AssetSpendDelta{{
+			OldScriptKey: *targetScriptKey.PubKey,
+			NewAmt:       10,
+			NewScriptKey: newScriptKey,
+			SplitCommitmentRoot: mssmt.NewComputedNode(
+				newRootHash, 16,
+			),
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}, {0x02}},
+			}},
+			SenderAssetProof: bytes.Repeat([]byte{0x01}, 100),
+			ReceiverAssetProof: encodeTransitionProof(
+				t, burnedAsset, newAnchorTx,
+			),
+		}},
+		Burn: &tarofreighter.AssetBurn{
+			AssetID:   *assetID,
+			Amount:    6,
+			ScriptKey: burnKey,
+		},
+	}
+	leaseOwner := sha256.Sum256(test.RandBytes(32))
+	leaseExpiry := time.Now().Add(time.Hour)
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, spendDelta, leaseOwner, leaseExpiry,
+	))
+
+	// The burn should be part of the pending parcel.
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+	require.Equal(t, spendDelta.Burn, parcels[0].Burn)
+
+	// We should also be able to query for the burn, which isn't confirmed
+	// yet.
+	burns, err := assetsStore.QueryBurns(ctx, assetID)
+	require.NoError(t, err)
+	require.Len(t, burns, 1)
+	require.Equal(t, *spendDelta.Burn, burns[0].AssetBurn)
+	require.Equal(t, newAnchorTx.TxHash(), burns[0].AnchorTxid)
+	require.Nil(t, burns[0].AnchorBlockHash)
+
+	// Burns of other assets shouldn't be returned.
+	otherAssetID := randAssetID(t)
+	burns, err = assetsStore.QueryBurns(ctx, &otherAssetID)
+	require.NoError(t, err)
+	require.Empty(t, burns)
+
+	// The burn should also show up in the transfer history of the asset,
+	// with the burned amount taken from the receiver proof.
+	sends, err := assetsStore.QueryAssetSends(ctx, assetID, nil)
+	require.NoError(t, err)
+	require.Len(t, sends, 1)
+	require.True(t, sends[0].Burn)
+	require.Equal(t, *assetID, sends[0].AssetID)
+	require.EqualValues(t, 6, sends[0].Amount)
+	require.True(t, sends[0].ReceiverScriptKey.IsEqual(burnKey))
+	require.Equal(t, newAnchorTx.TxHash(), sends[0].AnchorTxid)
+	require.Zero(t, sends[0].BlockHeight)
+
+	sends, err = assetsStore.QueryAssetSends(ctx, &otherAssetID, nil)
+	require.NoError(t, err)
+	require.Empty(t, sends)
+
+	// Once the burn confirms, the burned units are subtracted from our
+	// balance, as only the change of the burn is left.
+	err = assetsStore.ConfirmParcelDelivery(
+		ctx, &tarofreighter.AssetConfirmEvent{
+			AnchorPoint:      spendDelta.NewAnchorPoint,
+			BlockHeight:      100,
+			BlockHash:        chainhash.Hash{1},
+			FinalSenderProof: bytes.Repeat([]byte{0x02}, 100),
+		},
+	)
+	require.NoError(t, err)
+
+	balances, err = assetsStore.QueryBalancesByAsset(ctx, assetID)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, balances[*assetID].Balance)
+
+	famBalances, err := assetsStore.QueryAssetBalancesByFamily(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, famBalances, 1)
+	for _, balance := range famBalances {
+		require.EqualValues(t, 10, balance.Balance)
+	}
+}
+
+// encodeTransitionProof encodes a minimal transition proof for the passed
+// asset that's anchored in the passed transaction.
+func encodeTransitionProof(t *testing.T, a *asset.Asset,
+	anchorTx *wire.MsgTx) []byte {
+
+	transitionProof := proof.Proof{
+		PrevOut:  test.RandOp(t),
+		AnchorTx: *anchorTx,
+		Asset:    *a,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: randPubKey(t),
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, transitionProof.Encode(&b))
+
+	return b.Bytes()
 }
 
 // TestAssetFamilySigUpsert tests that if you try to insert another asset
@@ -2,6 +2,7 @@ package tarodb
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"fmt"
 	"net/http"
@@ -133,6 +134,14 @@ func NewSqliteStore(cfg *SqliteConfig) (*SqliteStore, error) {
 
 	queries := sqlite.New(db)
 
+	// The meta hashes of the assets that were stored before the meta_hash
+	// column was added can only be computed in Go, so we fill them in
+	// once all migrations are applied.
+	if err := backfillMetaHashes(db, queries); err != nil {
+		return nil, fmt.Errorf("unable to backfill asset meta "+
+			"hashes: %w", err)
+	}
+
 	return &SqliteStore{
 		DB:      db,
 		cfg:     cfg,
@@ -140,6 +149,42 @@ func NewSqliteStore(cfg *SqliteConfig) (*SqliteStore, error) {
 	}, nil
 }
 
+// backfillMetaHashes computes and stores the meta hash of all genesis assets
+// that don't have one yet. This is a no-op once all meta hashes are filled in.
+func backfillMetaHashes(db *sql.DB, queries *sqlite.Queries) error {
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rolling back a committed transaction is a no-op.
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	q := queries.WithTx(tx)
+	genAssets, err := q.FetchGenesisAssetsWithoutMetaHash(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, genAsset := range genAssets {
+		metaHash := sha256.Sum256(genAsset.MetaData)
+		err := q.UpdateGenesisAssetMetaHash(
+			ctx, sqlite.UpdateGenesisAssetMetaHashParams{
+				MetaHash:   metaHash[:],
+				GenAssetID: genAsset.GenAssetID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 // BeginTx wraps the normal sql specific BeginTx method with the TxOptions
 // interface. This interface is then mapped to the concrete sql tx options
 // struct.
@@ -11,10 +11,27 @@ import (
 	"time"
 )
 
+const failAddrEvent = `-- name: FailAddrEvent :exec
+UPDATE addr_events
+SET status = $1, failure_reason = $2
+WHERE id = $3
+`
+
+type FailAddrEventParams struct {
+	Status        int16
+	FailureReason string
+	ID            int32
+}
+
+func (q *Queries) FailAddrEvent(ctx context.Context, arg FailAddrEventParams) error {
+	_, err := q.db.ExecContext(ctx, failAddrEvent, arg.Status, arg.FailureReason, arg.ID)
+	return err
+}
+
 const fetchAddrByTaprootOutputKey = `-- name: FetchAddrByTaprootOutputKey :one
 SELECT
-    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    addrs.id, version, genesis_asset_id, fam_key, taproot_output_key, amount,
+    asset_type, creation_time, managed_from, expiry, max_receives, label,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key as raw_script_key,
@@ -34,6 +51,7 @@ WHERE taproot_output_key = ?
 `
 
 type FetchAddrByTaprootOutputKeyRow struct {
+	ID               int32
 	Version          int16
 	GenesisAssetID   int32
 	FamKey           []byte
@@ -42,6 +60,9 @@ type FetchAddrByTaprootOutputKeyRow struct {
 	AssetType        int16
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
+	Expiry           sql.NullTime
+	MaxReceives      int32
+	Label            string
 	TweakedScriptKey []byte
 	ScriptKeyTweak   []byte
 	RawScriptKey     []byte
@@ -56,6 +77,7 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 	row := q.db.QueryRowContext(ctx, fetchAddrByTaprootOutputKey, taprootOutputKey)
 	var i FetchAddrByTaprootOutputKeyRow
 	err := row.Scan(
+		&i.ID,
 		&i.Version,
 		&i.GenesisAssetID,
 		&i.FamKey,
@@ -64,6 +86,9 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.AssetType,
 		&i.CreationTime,
 		&i.ManagedFrom,
+		&i.Expiry,
+		&i.MaxReceives,
+		&i.Label,
 		&i.TweakedScriptKey,
 		&i.ScriptKeyTweak,
 		&i.RawScriptKey,
@@ -78,7 +103,8 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 
 const fetchAddrEvent = `-- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, asset_amount,
+    failure_reason,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
@@ -100,6 +126,8 @@ type FetchAddrEventRow struct {
 	Status             int16
 	AssetProofID       sql.NullInt32
 	AssetID            sql.NullInt32
+	AssetAmount        int64
+	FailureReason      string
 	Txid               []byte
 	ConfirmationHeight sql.NullInt32
 	OutputIndex        int32
@@ -116,6 +144,8 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventR
 		&i.Status,
 		&i.AssetProofID,
 		&i.AssetID,
+		&i.AssetAmount,
+		&i.FailureReason,
 		&i.Txid,
 		&i.ConfirmationHeight,
 		&i.OutputIndex,
@@ -126,10 +156,45 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventR
 	return i, err
 }
 
+const fetchAddrTags = `-- name: FetchAddrTags :many
+SELECT tag_key, tag_value
+FROM addr_tags
+WHERE addr_id = ?
+ORDER BY tag_key
+`
+
+type FetchAddrTagsRow struct {
+	TagKey   string
+	TagValue string
+}
+
+func (q *Queries) FetchAddrTags(ctx context.Context, addrID int32) ([]FetchAddrTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAddrTags, addrID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAddrTagsRow
+	for rows.Next() {
+		var i FetchAddrTagsRow
+		if err := rows.Scan(&i.TagKey, &i.TagValue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAddrs = `-- name: FetchAddrs :many
 SELECT 
-    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    addrs.id, version, genesis_asset_id, fam_key, taproot_output_key, amount,
+    asset_type, creation_time, managed_from, expiry, max_receives, label,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key AS raw_script_key,
@@ -145,22 +210,59 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE creation_time >= $1
-    AND creation_time <= $2
+WHERE addrs.creation_time >= $1
+    AND addrs.creation_time <= $2
     AND ($3 = false OR IFNULL(managed_from, true) = $3) 
+    AND ($4 = false OR addrs.expiry <= $5 OR (
+        addrs.max_receives > 0 AND (
+            SELECT COUNT(*)
+            FROM addr_events
+            WHERE addr_events.addr_id = addrs.id
+                AND addr_events.status <= $6
+        ) >= addrs.max_receives
+    ))
+    AND (addrs.label = $7 OR $7 IS NULL)
+    AND (EXISTS (
+        SELECT 1
+        FROM addr_tags
+        WHERE addr_tags.addr_id = addrs.id
+            AND addr_tags.tag_key = $8
+            AND (
+                addr_tags.tag_value = $9 OR
+                $9 IS NULL
+            )
+    ) OR $8 IS NULL)
+    AND ($10 = false OR (
+        (addrs.expiry > $5 OR addrs.expiry IS NULL) AND (
+            addrs.max_receives = 0 OR (
+                SELECT COUNT(*)
+                FROM addr_events
+                WHERE addr_events.addr_id = addrs.id
+                    AND addr_events.status <= $6
+            ) < addrs.max_receives
+        )
+    ))
 ORDER BY addrs.creation_time
-LIMIT $5 OFFSET $4
+LIMIT $12 OFFSET $11
 `
 
 type FetchAddrsParams struct {
-	CreatedAfter  time.Time
-	CreatedBefore time.Time
-	UnmanagedOnly interface{}
-	NumOffset     int32
-	NumLimit      int32
+	CreatedAfter     time.Time
+	CreatedBefore    time.Time
+	UnmanagedOnly    interface{}
+	ExpiredOnly      interface{}
+	Now              sql.NullTime
+	MaxReceiveStatus int16
+	Label            sql.NullString
+	TagKey           sql.NullString
+	TagValue         sql.NullString
+	ActiveOnly       interface{}
+	NumOffset        int32
+	NumLimit         int32
 }
 
 type FetchAddrsRow struct {
+	ID               int32
 	Version          int16
 	GenesisAssetID   int32
 	FamKey           []byte
@@ -169,6 +271,9 @@ type FetchAddrsRow struct {
 	AssetType        int16
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
+	Expiry           sql.NullTime
+	MaxReceives      int32
+	Label            string
 	TweakedScriptKey []byte
 	ScriptKeyTweak   []byte
 	RawScriptKey     []byte
@@ -184,6 +289,13 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 		arg.CreatedAfter,
 		arg.CreatedBefore,
 		arg.UnmanagedOnly,
+		arg.ExpiredOnly,
+		arg.Now,
+		arg.MaxReceiveStatus,
+		arg.Label,
+		arg.TagKey,
+		arg.TagValue,
+		arg.ActiveOnly,
 		arg.NumOffset,
 		arg.NumLimit,
 	)
@@ -195,6 +307,7 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 	for rows.Next() {
 		var i FetchAddrsRow
 		if err := rows.Scan(
+			&i.ID,
 			&i.Version,
 			&i.GenesisAssetID,
 			&i.FamKey,
@@ -203,6 +316,9 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.AssetType,
 			&i.CreationTime,
 			&i.ManagedFrom,
+			&i.Expiry,
+			&i.MaxReceives,
+			&i.Label,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyTweak,
 			&i.RawScriptKey,
@@ -228,8 +344,9 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 const insertAddr = `-- name: InsertAddr :one
 INSERT INTO addrs (
     version, genesis_asset_id, fam_key, script_key_id, taproot_key_id,
-    taproot_output_key, amount, asset_type, creation_time
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id
+    taproot_output_key, amount, asset_type, creation_time, expiry,
+    max_receives, label
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id
 `
 
 type InsertAddrParams struct {
@@ -242,6 +359,9 @@ type InsertAddrParams struct {
 	Amount           int64
 	AssetType        int16
 	CreationTime     time.Time
+	Expiry           sql.NullTime
+	MaxReceives      int32
+	Label            string
 }
 
 func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int32, error) {
@@ -255,12 +375,34 @@ func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int32,
 		arg.Amount,
 		arg.AssetType,
 		arg.CreationTime,
+		arg.Expiry,
+		arg.MaxReceives,
+		arg.Label,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
+const insertAddrTag = `-- name: InsertAddrTag :exec
+INSERT INTO addr_tags (
+    addr_id, tag_key, tag_value
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type InsertAddrTagParams struct {
+	AddrID   int32
+	TagKey   string
+	TagValue string
+}
+
+func (q *Queries) InsertAddrTag(ctx context.Context, arg InsertAddrTagParams) error {
+	_, err := q.db.ExecContext(ctx, insertAddrTag, arg.AddrID, arg.TagKey, arg.TagValue)
+	return err
+}
+
 const queryEventIDs = `-- name: QueryEventIDs :many
 SELECT
     addr_events.id as event_id, addrs.taproot_output_key as taproot_output_key
@@ -332,47 +474,58 @@ const upsertAddrEvent = `-- name: UpsertAddrEvent :one
 WITH target_addr(addr_id) AS (
     SELECT id
     FROM addrs
-    WHERE addrs.taproot_output_key = ?
+    WHERE addrs.taproot_output_key = $9
 ), target_chain_txn(txn_id) AS (
     SELECT txn_id
     FROM chain_txns
-    WHERE chain_txns.txid = ?
+    WHERE chain_txns.txid = $10
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, asset_amount
 ) VALUES (
-    ?, (SELECT addr_id FROM target_addr), ?,
-    (SELECT txn_id FROM target_chain_txn), ?, ?, ?, ?
+    $1, (SELECT addr_id FROM target_addr), $2,
+    (SELECT txn_id FROM target_chain_txn), $3,
+    $4, $5, $6, $7
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
-    DO UPDATE SET status = EXCLUDED.status,
+    -- Events that reached the final status threshold, which covers the
+    -- completed status and all failure statuses, are never updated again.
+    DO UPDATE SET status = CASE
+                      WHEN addr_events.status >= $8
+                          THEN addr_events.status
+                      ELSE EXCLUDED.status
+                  END,
                   asset_proof_id = IFNULL(EXCLUDED.asset_proof_id, asset_proof_id),
                   asset_id = IFNULL(EXCLUDED.asset_id, asset_id)
 RETURNING id
 `
 
 type UpsertAddrEventParams struct {
-	TaprootOutputKey    []byte
-	Txid                []byte
 	CreationTime        time.Time
 	Status              int16
 	ChainTxnOutputIndex int32
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	AssetAmount         int64
+	MinFinalStatus      int16
+	TaprootOutputKey    []byte
+	Txid                []byte
 }
 
 func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error) {
 	row := q.db.QueryRowContext(ctx, upsertAddrEvent,
-		arg.TaprootOutputKey,
-		arg.Txid,
 		arg.CreationTime,
 		arg.Status,
 		arg.ChainTxnOutputIndex,
 		arg.ManagedUtxoID,
 		arg.AssetProofID,
 		arg.AssetID,
+		arg.AssetAmount,
+		arg.MinFinalStatus,
+		arg.TaprootOutputKey,
+		arg.Txid,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -86,7 +86,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, batch_fee_rate, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -99,6 +99,7 @@ type AllMintingBatchesRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	BatchFeeRate       sql.NullInt64
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -121,6 +122,7 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.BatchFeeRate,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -186,7 +188,7 @@ func (q *Queries) AnchorPendingAssets(ctx context.Context, arg AnchorPendingAsse
 }
 
 const assetsByGenesisPoint = `-- name: AssetsByGenesisPoint :many
-SELECT assets.asset_id, assets.genesis_id, version, script_key_id, asset_family_sig_id, script_version, amount, lock_time, relative_lock_time, split_commitment_root_hash, split_commitment_root_value, anchor_utxo_id, gen_asset_id, genesis_assets.asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id, genesis_points.genesis_id, prev_out, anchor_tx_id
+SELECT assets.asset_id, assets.genesis_id, version, script_key_id, asset_family_sig_id, script_version, amount, lock_time, relative_lock_time, split_commitment_root_hash, split_commitment_root_value, anchor_utxo_id, gen_asset_id, genesis_assets.asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id, meta_hash, decimal_display, genesis_points.genesis_id, prev_out, anchor_tx_id
 FROM assets 
 JOIN genesis_assets 
     ON assets.genesis_id = genesis_assets.gen_asset_id
@@ -215,6 +217,8 @@ type AssetsByGenesisPointRow struct {
 	OutputIndex              int32
 	AssetType                int16
 	GenesisPointID           int32
+	MetaHash                 []byte
+	DecimalDisplay           int32
 	GenesisID_2              int32
 	PrevOut                  []byte
 	AnchorTxID               sql.NullInt32
@@ -249,6 +253,8 @@ func (q *Queries) AssetsByGenesisPoint(ctx context.Context, prevOut []byte) ([]A
 			&i.OutputIndex,
 			&i.AssetType,
 			&i.GenesisPointID,
+			&i.MetaHash,
+			&i.DecimalDisplay,
 			&i.GenesisID_2,
 			&i.PrevOut,
 			&i.AnchorTxID,
@@ -413,6 +419,19 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const countTransfersByOldAnchorPoint = `-- name: CountTransfersByOldAnchorPoint :one
+SELECT COUNT(*)
+FROM asset_transfers
+WHERE old_anchor_point = $1
+`
+
+func (q *Queries) CountTransfersByOldAnchorPoint(ctx context.Context, oldAnchorPoint []byte) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTransfersByOldAnchorPoint, oldAnchorPoint)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteManagedUTXO = `-- name: DeleteManagedUTXO :exec
 DELETE FROM managed_utxos
 WHERE outpoint = ?
@@ -423,6 +442,130 @@ func (q *Queries) DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	return err
 }
 
+const deleteSeedlingFromBatch = `-- name: DeleteSeedlingFromBatch :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = ?
+)
+DELETE FROM asset_seedlings
+WHERE asset_name = ?
+    AND batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type DeleteSeedlingFromBatchParams struct {
+	RawKey    []byte
+	AssetName string
+}
+
+func (q *Queries) DeleteSeedlingFromBatch(ctx context.Context, arg DeleteSeedlingFromBatchParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeedlingFromBatch, arg.RawKey, arg.AssetName)
+	return err
+}
+
+const deleteUTXOLease = `-- name: DeleteUTXOLease :exec
+UPDATE managed_utxos
+SET lease_owner = NULL, lease_expiry = NULL
+WHERE outpoint = $1
+`
+
+func (q *Queries) DeleteUTXOLease(ctx context.Context, outpoint []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteUTXOLease, outpoint)
+	return err
+}
+
+const fetchAssetFamily = `-- name: FetchAssetFamily :one
+SELECT fams.family_id, keys.raw_key, keys.key_family, keys.key_index,
+    points.prev_out AS genesis_prev_out, gen.asset_tag, gen.meta_data,
+    gen.output_index, gen.asset_type
+FROM asset_families fams
+JOIN internal_keys keys
+    ON fams.internal_key_id = keys.key_id
+JOIN genesis_points points
+    ON fams.genesis_point_id = points.genesis_id
+JOIN asset_family_sigs sigs
+    ON sigs.key_fam_id = fams.family_id
+JOIN genesis_assets gen
+    ON sigs.gen_asset_id = gen.gen_asset_id AND
+        gen.genesis_point_id = fams.genesis_point_id
+WHERE fams.tweaked_fam_key = ?
+ORDER BY gen.gen_asset_id
+LIMIT 1
+`
+
+type FetchAssetFamilyRow struct {
+	FamilyID       int32
+	RawKey         []byte
+	KeyFamily      int32
+	KeyIndex       int32
+	GenesisPrevOut []byte
+	AssetTag       string
+	MetaData       []byte
+	OutputIndex    int32
+	AssetType      int16
+}
+
+// The family key is tweaked with the genesis of the first asset issued into
+// the family, so we'll fetch that genesis along with the raw family key.
+func (q *Queries) FetchAssetFamily(ctx context.Context, tweakedFamKey []byte) (FetchAssetFamilyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetFamily, tweakedFamKey)
+	var i FetchAssetFamilyRow
+	err := row.Scan(
+		&i.FamilyID,
+		&i.RawKey,
+		&i.KeyFamily,
+		&i.KeyIndex,
+		&i.GenesisPrevOut,
+		&i.AssetTag,
+		&i.MetaData,
+		&i.OutputIndex,
+		&i.AssetType,
+	)
+	return i, err
+}
+
+const fetchAssetMetaByHash = `-- name: FetchAssetMetaByHash :one
+SELECT asset_id, meta_data, meta_hash
+FROM genesis_assets
+WHERE meta_hash = ?
+ORDER BY gen_asset_id
+LIMIT 1
+`
+
+type FetchAssetMetaByHashRow struct {
+	AssetID  []byte
+	MetaData []byte
+	MetaHash []byte
+}
+
+func (q *Queries) FetchAssetMetaByHash(ctx context.Context, metaHash []byte) (FetchAssetMetaByHashRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetMetaByHash, metaHash)
+	var i FetchAssetMetaByHashRow
+	err := row.Scan(&i.AssetID, &i.MetaData, &i.MetaHash)
+	return i, err
+}
+
+const fetchAssetMetaForAsset = `-- name: FetchAssetMetaForAsset :one
+SELECT asset_id, meta_data, meta_hash
+FROM genesis_assets
+WHERE asset_id = ?
+`
+
+type FetchAssetMetaForAssetRow struct {
+	AssetID  []byte
+	MetaData []byte
+	MetaHash []byte
+}
+
+func (q *Queries) FetchAssetMetaForAsset(ctx context.Context, assetID []byte) (FetchAssetMetaForAssetRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetMetaForAsset, assetID)
+	var i FetchAssetMetaForAssetRow
+	err := row.Scan(&i.AssetID, &i.MetaData, &i.MetaHash)
+	return i, err
+}
+
 const fetchAssetProof = `-- name: FetchAssetProof :one
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key
@@ -740,6 +883,116 @@ func (q *Queries) FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, erro
 	return i, err
 }
 
+const fetchConfirmedMintingBatches = `-- name: FetchConfirmedMintingBatches :many
+SELECT
+    batches.batch_id, batches.batch_state, batches.minting_tx_psbt, batches.minting_output_index, batches.genesis_id, batches.creation_time_unix, batches.batch_fee_rate, keys.key_id, keys.raw_key, keys.key_family, keys.key_index, txns.block_height AS minting_tx_block_height,
+    txns.block_hash AS minting_tx_block_hash,
+    txns.tx_index AS minting_tx_index
+FROM asset_minting_batches batches
+JOIN internal_keys keys
+    ON batches.batch_id = keys.key_id
+JOIN genesis_points points
+    ON batches.genesis_id = points.genesis_id
+JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+WHERE batches.batch_state = $1 AND
+    txns.block_height >= $2
+`
+
+type FetchConfirmedMintingBatchesParams struct {
+	BatchState     int16
+	MinBlockHeight sql.NullInt32
+}
+
+type FetchConfirmedMintingBatchesRow struct {
+	BatchID              int32
+	BatchState           int16
+	MintingTxPsbt        []byte
+	MintingOutputIndex   sql.NullInt16
+	GenesisID            sql.NullInt32
+	CreationTimeUnix     time.Time
+	BatchFeeRate         sql.NullInt64
+	KeyID                int32
+	RawKey               []byte
+	KeyFamily            int32
+	KeyIndex             int32
+	MintingTxBlockHeight sql.NullInt32
+	MintingTxBlockHash   []byte
+	MintingTxIndex       sql.NullInt32
+}
+
+func (q *Queries) FetchConfirmedMintingBatches(ctx context.Context, arg FetchConfirmedMintingBatchesParams) ([]FetchConfirmedMintingBatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchConfirmedMintingBatches, arg.BatchState, arg.MinBlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchConfirmedMintingBatchesRow
+	for rows.Next() {
+		var i FetchConfirmedMintingBatchesRow
+		if err := rows.Scan(
+			&i.BatchID,
+			&i.BatchState,
+			&i.MintingTxPsbt,
+			&i.MintingOutputIndex,
+			&i.GenesisID,
+			&i.CreationTimeUnix,
+			&i.BatchFeeRate,
+			&i.KeyID,
+			&i.RawKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
+			&i.MintingTxBlockHeight,
+			&i.MintingTxBlockHash,
+			&i.MintingTxIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchGenesisAssetsWithoutMetaHash = `-- name: FetchGenesisAssetsWithoutMetaHash :many
+SELECT gen_asset_id, meta_data
+FROM genesis_assets
+WHERE meta_hash IS NULL
+`
+
+type FetchGenesisAssetsWithoutMetaHashRow struct {
+	GenAssetID int32
+	MetaData   []byte
+}
+
+func (q *Queries) FetchGenesisAssetsWithoutMetaHash(ctx context.Context) ([]FetchGenesisAssetsWithoutMetaHashRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchGenesisAssetsWithoutMetaHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchGenesisAssetsWithoutMetaHashRow
+	for rows.Next() {
+		var i FetchGenesisAssetsWithoutMetaHashRow
+		if err := rows.Scan(&i.GenAssetID, &i.MetaData); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchGenesisByID = `-- name: FetchGenesisByID :one
 SELECT
     asset_id, asset_tag, meta_data, output_index, asset_type,
@@ -787,7 +1040,7 @@ func (q *Queries) FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sq
 }
 
 const fetchManagedUTXO = `-- name: FetchManagedUTXO :one
-SELECT utxo_id, outpoint, amt_sats, internal_key_id, tapscript_sibling, taro_root, txn_id, key_id, raw_key, key_family, key_index
+SELECT utxo_id, outpoint, amt_sats, internal_key_id, tapscript_sibling, taro_root, txn_id, lease_owner, lease_expiry, key_id, raw_key, key_family, key_index
 FROM managed_utxos utxos
 JOIN internal_keys keys
     ON utxos.internal_key_id = keys.key_id
@@ -810,6 +1063,8 @@ type FetchManagedUTXORow struct {
 	TapscriptSibling []byte
 	TaroRoot         []byte
 	TxnID            int32
+	LeaseOwner       []byte
+	LeaseExpiry      sql.NullTime
 	KeyID            int32
 	RawKey           []byte
 	KeyFamily        int32
@@ -827,6 +1082,8 @@ func (q *Queries) FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOPara
 		&i.TapscriptSibling,
 		&i.TaroRoot,
 		&i.TxnID,
+		&i.LeaseOwner,
+		&i.LeaseExpiry,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -835,8 +1092,69 @@ func (q *Queries) FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOPara
 	return i, err
 }
 
+const fetchManagedUTXOs = `-- name: FetchManagedUTXOs :many
+SELECT utxo_id, outpoint, amt_sats, internal_key_id, tapscript_sibling, taro_root, txn_id, lease_owner, lease_expiry, key_id, raw_key, key_family, key_index
+FROM managed_utxos utxos
+JOIN internal_keys keys
+    ON utxos.internal_key_id = keys.key_id
+ORDER BY utxos.utxo_id
+`
+
+type FetchManagedUTXOsRow struct {
+	UtxoID           int32
+	Outpoint         []byte
+	AmtSats          int64
+	InternalKeyID    int32
+	TapscriptSibling []byte
+	TaroRoot         []byte
+	TxnID            int32
+	LeaseOwner       []byte
+	LeaseExpiry      sql.NullTime
+	KeyID            int32
+	RawKey           []byte
+	KeyFamily        int32
+	KeyIndex         int32
+}
+
+func (q *Queries) FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchManagedUTXOs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchManagedUTXOsRow
+	for rows.Next() {
+		var i FetchManagedUTXOsRow
+		if err := rows.Scan(
+			&i.UtxoID,
+			&i.Outpoint,
+			&i.AmtSats,
+			&i.InternalKeyID,
+			&i.TapscriptSibling,
+			&i.TaroRoot,
+			&i.TxnID,
+			&i.LeaseOwner,
+			&i.LeaseExpiry,
+			&i.KeyID,
+			&i.RawKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchMintingBatch = `-- name: FetchMintingBatch :one
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, batch_fee_rate, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -850,6 +1168,7 @@ type FetchMintingBatchRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	BatchFeeRate       sql.NullInt64
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -866,6 +1185,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.MintingOutputIndex,
 		&i.GenesisID,
 		&i.CreationTimeUnix,
+		&i.BatchFeeRate,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -875,7 +1195,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 }
 
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, batch_fee_rate, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -889,6 +1209,7 @@ type FetchMintingBatchesByInverseStateRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	BatchFeeRate       sql.NullInt64
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -911,6 +1232,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.BatchFeeRate,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -930,7 +1252,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 }
 
 const fetchMintingBatchesByState = `-- name: FetchMintingBatchesByState :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, batch_fee_rate, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -944,6 +1266,7 @@ type FetchMintingBatchesByStateRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	BatchFeeRate       sql.NullInt64
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -966,6 +1289,7 @@ func (q *Queries) FetchMintingBatchesByState(ctx context.Context, batchState int
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.BatchFeeRate,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -993,20 +1317,35 @@ WITH target_batch(batch_id) AS (
     WHERE keys.raw_key = ?
 )
 SELECT seedling_id, asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, genesis_id, batch_id
+    emission_enabled, genesis_id, batch_id,
+    fams.tweaked_fam_key AS family_key
 FROM asset_seedlings 
+LEFT JOIN asset_families fams
+    ON asset_seedlings.asset_family_id = fams.family_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch)
 `
 
-func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]AssetSeedling, error) {
+type FetchSeedlingsForBatchRow struct {
+	SeedlingID      int32
+	AssetName       string
+	AssetType       int16
+	AssetSupply     int64
+	AssetMeta       []byte
+	EmissionEnabled bool
+	GenesisID       sql.NullInt32
+	BatchID         int32
+	FamilyKey       []byte
+}
+
+func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error) {
 	rows, err := q.db.QueryContext(ctx, fetchSeedlingsForBatch, rawKey)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AssetSeedling
+	var items []FetchSeedlingsForBatchRow
 	for rows.Next() {
-		var i AssetSeedling
+		var i FetchSeedlingsForBatchRow
 		if err := rows.Scan(
 			&i.SeedlingID,
 			&i.AssetName,
@@ -1016,6 +1355,7 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 			&i.EmissionEnabled,
 			&i.GenesisID,
 			&i.BatchID,
+			&i.FamilyKey,
 		); err != nil {
 			return nil, err
 		}
@@ -1031,7 +1371,7 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 }
 
 const genesisAssets = `-- name: GenesisAssets :many
-SELECT gen_asset_id, asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id 
+SELECT gen_asset_id, asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id, meta_hash, decimal_display 
 FROM genesis_assets
 `
 
@@ -1052,6 +1392,8 @@ func (q *Queries) GenesisAssets(ctx context.Context) ([]GenesisAsset, error) {
 			&i.OutputIndex,
 			&i.AssetType,
 			&i.GenesisPointID,
+			&i.MetaHash,
+			&i.DecimalDisplay,
 		); err != nil {
 			return nil, err
 		}
@@ -1097,9 +1439,9 @@ func (q *Queries) GenesisPoints(ctx context.Context) ([]GenesisPoint, error) {
 const insertAssetSeedling = `-- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, asset_family_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?
 )
 `
 
@@ -1110,6 +1452,7 @@ type InsertAssetSeedlingParams struct {
 	AssetMeta       []byte
 	EmissionEnabled bool
 	BatchID         int32
+	AssetFamilyID   sql.NullInt32
 }
 
 func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error {
@@ -1120,6 +1463,7 @@ func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedli
 		arg.AssetMeta,
 		arg.EmissionEnabled,
 		arg.BatchID,
+		arg.AssetFamilyID,
 	)
 	return err
 }
@@ -1137,9 +1481,9 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, asset_family_id
 ) VALUES (
-    ?, ?, ?, ?, ?, (SELECT key_id FROM target_key_id)
+    ?, ?, ?, ?, ?, (SELECT key_id FROM target_key_id), ?
 )
 `
 
@@ -1150,6 +1494,7 @@ type InsertAssetSeedlingIntoBatchParams struct {
 	AssetSupply     int64
 	AssetMeta       []byte
 	EmissionEnabled bool
+	AssetFamilyID   sql.NullInt32
 }
 
 func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error {
@@ -1160,6 +1505,7 @@ func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAs
 		arg.AssetSupply,
 		arg.AssetMeta,
 		arg.EmissionEnabled,
+		arg.AssetFamilyID,
 	)
 	return err
 }
@@ -1253,6 +1599,7 @@ SELECT
     genesis_info_view.asset_id, version, SUM(amount) balance,
     genesis_info_view.asset_tag, genesis_info_view.meta_data,
     genesis_info_view.asset_type, genesis_info_view.output_index,
+    genesis_info_view.decimal_display,
     genesis_info_view.prev_out AS genesis_point
 FROM assets
 JOIN genesis_info_view
@@ -1264,14 +1611,15 @@ GROUP BY assets.genesis_id
 `
 
 type QueryAssetBalancesByAssetRow struct {
-	AssetID      []byte
-	Version      int32
-	Balance      int64
-	AssetTag     string
-	MetaData     []byte
-	AssetType    int16
-	OutputIndex  int32
-	GenesisPoint []byte
+	AssetID        []byte
+	Version        int32
+	Balance        int64
+	AssetTag       string
+	MetaData       []byte
+	AssetType      int16
+	OutputIndex    int32
+	DecimalDisplay int32
+	GenesisPoint   []byte
 }
 
 // We use a LEFT JOIN here as not every asset has a family key, so this'll
@@ -1295,6 +1643,7 @@ func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter i
 			&i.MetaData,
 			&i.AssetType,
 			&i.OutputIndex,
+			&i.DecimalDisplay,
 			&i.GenesisPoint,
 		); err != nil {
 			return nil, err
@@ -1312,19 +1661,31 @@ func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter i
 
 const queryAssetBalancesByFamily = `-- name: QueryAssetBalancesByFamily :many
 SELECT
-    key_fam_info_view.tweaked_fam_key, SUM(amount) balance
+    key_fam_info_view.tweaked_fam_key, SUM(amount) balance,
+    CAST(MAX(genesis_info_view.decimal_display) AS INTEGER) AS decimal_display
 FROM assets
-JOIN key_fam_info_view
-    ON assets.genesis_id = key_fam_info_view.gen_asset_id AND
-        (length(hex($1)) == 0 OR key_fam_info_view.tweaked_fam_key = $1)
+JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+WHERE (
+    length(hex($1)) == 0 OR
+        key_fam_info_view.tweaked_fam_key = $1
+)
 GROUP BY key_fam_info_view.tweaked_fam_key
 `
 
 type QueryAssetBalancesByFamilyRow struct {
-	TweakedFamKey []byte
-	Balance       int64
+	TweakedFamKey  []byte
+	Balance        int64
+	DecimalDisplay int32
 }
 
+// We use a LEFT JOIN here, so all assets that don't have a family key are
+// aggregated into a single balance with a NULL family key. As assets issued
+// into an existing family share the same tweaked family key, their balances
+// are summed up across all their distinct asset IDs. All assets of a family
+// share the same decimal display, so we can just pick the largest one.
 func (q *Queries) QueryAssetBalancesByFamily(ctx context.Context, keyFamFilter interface{}) ([]QueryAssetBalancesByFamilyRow, error) {
 	rows, err := q.db.QueryContext(ctx, queryAssetBalancesByFamily, keyFamFilter)
 	if err != nil {
@@ -1334,7 +1695,82 @@ func (q *Queries) QueryAssetBalancesByFamily(ctx context.Context, keyFamFilter i
 	var items []QueryAssetBalancesByFamilyRow
 	for rows.Next() {
 		var i QueryAssetBalancesByFamilyRow
-		if err := rows.Scan(&i.TweakedFamKey, &i.Balance); err != nil {
+		if err := rows.Scan(&i.TweakedFamKey, &i.Balance, &i.DecimalDisplay); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryAssetMints = `-- name: QueryAssetMints :many
+SELECT
+    gen.asset_id, key_fam_info_view.tweaked_fam_key,
+    CAST(CASE WHEN gen.asset_type = 1 THEN 1 ELSE seedlings.asset_supply END
+        AS BIGINT) AS amount,
+    batches.creation_time_unix AS mint_time,
+    txns.txid AS anchor_txid, txns.block_height AS anchor_block_height
+FROM genesis_assets gen
+JOIN genesis_points points
+    ON gen.genesis_point_id = points.genesis_id
+JOIN asset_minting_batches batches
+    ON batches.genesis_id = points.genesis_id
+JOIN asset_seedlings seedlings
+    ON seedlings.batch_id = batches.batch_id AND
+        seedlings.asset_name = gen.asset_tag
+LEFT JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+LEFT JOIN key_fam_info_view
+    ON gen.gen_asset_id = key_fam_info_view.gen_asset_id
+WHERE (
+    (length(hex($1)) == 0 OR
+        gen.asset_id = $1) AND
+    (length(hex($2)) == 0 OR
+        key_fam_info_view.tweaked_fam_key = $2)
+)
+ORDER BY batches.creation_time_unix, gen.gen_asset_id
+`
+
+type QueryAssetMintsParams struct {
+	AssetIDFilter interface{}
+	KeyFamFilter  interface{}
+}
+
+type QueryAssetMintsRow struct {
+	AssetID           []byte
+	TweakedFamKey     []byte
+	Amount            int64
+	MintTime          time.Time
+	AnchorTxid        []byte
+	AnchorBlockHeight sql.NullInt32
+}
+
+// Only assets minted by this daemon have a minting batch. The batch also holds
+// the seedling of the asset, which contains the amount that was minted. Just
+// like the caretaker does, we mint a single unit of collectible assets.
+func (q *Queries) QueryAssetMints(ctx context.Context, arg QueryAssetMintsParams) ([]QueryAssetMintsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetMints, arg.AssetIDFilter, arg.KeyFamFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetMintsRow
+	for rows.Next() {
+		var i QueryAssetMintsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.TweakedFamKey,
+			&i.Amount,
+			&i.MintTime,
+			&i.AnchorTxid,
+			&i.AnchorBlockHeight,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1369,6 +1805,7 @@ SELECT
     genesis_info_view.asset_type,
     genesis_info_view.prev_out AS genesis_prev_out,
     txns.raw_tx AS anchor_tx, txns.txid AS anchor_txid, txns.block_hash AS anchor_block_hash,
+    txns.block_height AS anchor_block_height,
     utxos.outpoint AS anchor_outpoint,
     utxo_internal_keys.raw_key AS anchor_internal_key,
     split_commitment_root_hash, split_commitment_root_value
@@ -1393,15 +1830,33 @@ JOIN chain_txns txns
     ON utxos.txn_id = txns.txn_id
 WHERE (
     assets.amount >= COALESCE($3, assets.amount) AND
-    (key_fam_info_view.tweaked_fam_key = $4 OR $4 IS NULL)
+    (key_fam_info_view.tweaked_fam_key = $4 OR $4 IS NULL) AND
+    genesis_info_view.asset_type = COALESCE($5, genesis_info_view.asset_type) AND
+    -- An asset is confirmed once the transaction that anchors it has been
+    -- included in a block. A confirmed_filter of 1 only selects confirmed
+    -- assets, 2 only selects unconfirmed assets, and 0 selects both.
+    (($6 == 0 OR $6 IS NULL) OR
+        (($6 == 1) == (length(hex(txns.block_hash)) != 0))) AND
+    -- If a lease time is specified, then assets anchored at a UTXO that's
+    -- leased beyond that time are skipped, so they can't be selected twice.
+    (utxos.lease_expiry <= $7 OR
+        $7 IS NULL OR utxos.lease_owner IS NULL OR
+        utxos.lease_expiry IS NULL)
 )
+ORDER BY assets.asset_id
+LIMIT $9 OFFSET $8
 `
 
 type QueryAssetsParams struct {
-	AssetIDFilter interface{}
-	AnchorPoint   interface{}
-	MinAmt        sql.NullInt64
-	KeyFamFilter  []byte
+	AssetIDFilter   interface{}
+	AnchorPoint     interface{}
+	MinAmt          sql.NullInt64
+	KeyFamFilter    []byte
+	AssetType       sql.NullInt16
+	ConfirmedFilter interface{}
+	LeaseTime       sql.NullTime
+	NumOffset       int32
+	NumLimit        int32
 }
 
 type QueryAssetsRow struct {
@@ -1431,6 +1886,7 @@ type QueryAssetsRow struct {
 	AnchorTx                 []byte
 	AnchorTxid               []byte
 	AnchorBlockHash          []byte
+	AnchorBlockHeight        sql.NullInt32
 	AnchorOutpoint           []byte
 	AnchorInternalKey        []byte
 	SplitCommitmentRootHash  []byte
@@ -1445,12 +1901,18 @@ type QueryAssetsRow struct {
 // channel balances, and also coin selection. We use the sqlc.narg feature to
 // make the entire statement evaluate to true, if none of these extra args are
 // specified.
+// A limit of -1 means that all matching assets are returned.
 func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error) {
 	rows, err := q.db.QueryContext(ctx, queryAssets,
 		arg.AssetIDFilter,
 		arg.AnchorPoint,
 		arg.MinAmt,
 		arg.KeyFamFilter,
+		arg.AssetType,
+		arg.ConfirmedFilter,
+		arg.LeaseTime,
+		arg.NumOffset,
+		arg.NumLimit,
 	)
 	if err != nil {
 		return nil, err
@@ -1486,6 +1948,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 			&i.AnchorTx,
 			&i.AnchorTxid,
 			&i.AnchorBlockHash,
+			&i.AnchorBlockHeight,
 			&i.AnchorOutpoint,
 			&i.AnchorInternalKey,
 			&i.SplitCommitmentRootHash,
@@ -1527,6 +1990,45 @@ func (q *Queries) UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenes
 	return err
 }
 
+const updateGenesisAssetMetaHash = `-- name: UpdateGenesisAssetMetaHash :exec
+UPDATE genesis_assets
+SET meta_hash = $1
+WHERE gen_asset_id = $2
+`
+
+type UpdateGenesisAssetMetaHashParams struct {
+	MetaHash   []byte
+	GenAssetID int32
+}
+
+func (q *Queries) UpdateGenesisAssetMetaHash(ctx context.Context, arg UpdateGenesisAssetMetaHashParams) error {
+	_, err := q.db.ExecContext(ctx, updateGenesisAssetMetaHash, arg.MetaHash, arg.GenAssetID)
+	return err
+}
+
+const updateMintingBatchFeeRate = `-- name: UpdateMintingBatchFeeRate :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = ?
+)
+UPDATE asset_minting_batches
+SET batch_fee_rate = ?
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+`
+
+type UpdateMintingBatchFeeRateParams struct {
+	RawKey       []byte
+	BatchFeeRate sql.NullInt64
+}
+
+func (q *Queries) UpdateMintingBatchFeeRate(ctx context.Context, arg UpdateMintingBatchFeeRateParams) error {
+	_, err := q.db.ExecContext(ctx, updateMintingBatchFeeRate, arg.RawKey, arg.BatchFeeRate)
+	return err
+}
+
 const updateMintingBatchState = `-- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -1554,15 +2056,33 @@ func (q *Queries) UpdateMintingBatchState(ctx context.Context, arg UpdateMinting
 	return err
 }
 
+const updateUTXOLease = `-- name: UpdateUTXOLease :exec
+UPDATE managed_utxos
+SET lease_owner = $1, lease_expiry = $2
+WHERE outpoint = $3
+`
+
+type UpdateUTXOLeaseParams struct {
+	LeaseOwner  []byte
+	LeaseExpiry sql.NullTime
+	Outpoint    []byte
+}
+
+func (q *Queries) UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error {
+	_, err := q.db.ExecContext(ctx, updateUTXOLease, arg.LeaseOwner, arg.LeaseExpiry, arg.Outpoint)
+	return err
+}
+
 const upsertAssetFamilyKey = `-- name: UpsertAssetFamilyKey :one
 INSERT INTO asset_families (
     tweaked_fam_key, internal_key_id, genesis_point_id 
 ) VALUES (
     ?, ?, ?
 ) ON CONFLICT (tweaked_fam_key)
-    -- This is not a NOP, update the genesis point ID in case it wasn't set
-    -- before.
-    DO UPDATE SET genesis_point_id = EXCLUDED.genesis_point_id
+    -- This is a NOP, the genesis point of a family is the one of the first
+    -- asset issued into it, which must not be overwritten by any later
+    -- issuance.
+    DO UPDATE SET tweaked_fam_key = EXCLUDED.tweaked_fam_key
 RETURNING family_id
 `
 
@@ -1669,9 +2189,10 @@ func (q *Queries) UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (i
 
 const upsertGenesisAsset = `-- name: UpsertGenesisAsset :one
 INSERT INTO genesis_assets (
-    asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id
+    asset_id, asset_tag, meta_data, meta_hash, decimal_display, output_index,
+    asset_type, genesis_point_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?
 ) ON CONFLICT (asset_tag)
     -- This is a NOP, asset_tag is the unique field that caused the conflict.
     DO UPDATE SET asset_tag = EXCLUDED.asset_tag
@@ -1682,6 +2203,8 @@ type UpsertGenesisAssetParams struct {
 	AssetID        []byte
 	AssetTag       string
 	MetaData       []byte
+	MetaHash       []byte
+	DecimalDisplay int32
 	OutputIndex    int32
 	AssetType      int16
 	GenesisPointID int32
@@ -1692,6 +2215,8 @@ func (q *Queries) UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAsset
 		arg.AssetID,
 		arg.AssetTag,
 		arg.MetaData,
+		arg.MetaHash,
+		arg.DecimalDisplay,
 		arg.OutputIndex,
 		arg.AssetType,
 		arg.GenesisPointID,
@@ -0,0 +1,25 @@
+-- asset_burns tracks all asset units that were destroyed by sending them to a
+-- provably unspendable burn key as part of an outbound transfer.
+CREATE TABLE IF NOT EXISTS asset_burns (
+    burn_id INTEGER PRIMARY KEY,
+
+    -- transfer_id is a reference to the outbound transfer that burned the
+    -- asset units.
+    transfer_id INTEGER NOT NULL REFERENCES asset_transfers(id),
+
+    -- asset_id is the ID of the asset that was burned.
+    asset_id BLOB NOT NULL,
+
+    -- tweaked_fam_key is the family key of the asset that was burned, if the
+    -- asset has one.
+    tweaked_fam_key BLOB,
+
+    -- amount is the number of asset units that were burned.
+    amount BIGINT NOT NULL,
+
+    -- burn_script_key is the serialized burn key the asset units were sent
+    -- to. This key commits to the asset input that was spent in the transfer.
+    burn_script_key BLOB UNIQUE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS asset_burns_asset_id_idx ON asset_burns(asset_id);
+CREATE INDEX IF NOT EXISTS asset_burns_transfer_id_idx ON asset_burns(transfer_id);
@@ -0,0 +1 @@
+ALTER TABLE asset_seedlings DROP COLUMN asset_family_id;
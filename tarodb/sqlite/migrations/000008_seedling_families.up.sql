@@ -0,0 +1,4 @@
+-- asset_family_id references the existing asset family a seedling should be
+-- issued into. This is only set for seedlings that re-use the family key of a
+-- previously minted asset, allowing on going emission of that asset.
+ALTER TABLE asset_seedlings ADD COLUMN asset_family_id INTEGER REFERENCES asset_families(family_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_meta_hashes;
+ALTER TABLE genesis_assets DROP COLUMN meta_hash;
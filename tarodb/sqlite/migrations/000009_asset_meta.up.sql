@@ -0,0 +1,6 @@
+-- meta_hash is the sha256 hash of the meta_data of a genesis asset, which
+-- allows the revealed metadata of an asset to be looked up by the hash that's
+-- committed to in its asset ID.
+ALTER TABLE genesis_assets ADD COLUMN meta_hash BLOB;
+
+CREATE INDEX IF NOT EXISTS asset_meta_hashes on genesis_assets(meta_hash);
@@ -0,0 +1,10 @@
+DROP VIEW IF EXISTS genesis_info_view;
+CREATE VIEW genesis_info_view AS
+    SELECT
+        gen_asset_id, asset_id, asset_tag, meta_data, output_index, asset_type,
+        genesis_points.prev_out prev_out
+    FROM genesis_assets
+    JOIN genesis_points
+        ON genesis_assets.genesis_point_id = genesis_points.genesis_id;
+
+ALTER TABLE genesis_assets DROP COLUMN decimal_display;
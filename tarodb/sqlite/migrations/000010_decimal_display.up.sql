@@ -0,0 +1,14 @@
+-- decimal_display is the number of decimal places the amounts of an asset
+-- should be displayed with, as committed to in the metadata of the asset.
+ALTER TABLE genesis_assets ADD COLUMN decimal_display INTEGER NOT NULL DEFAULT 0;
+
+-- We re-create the genesis info view so it includes the decimal display of
+-- an asset.
+DROP VIEW IF EXISTS genesis_info_view;
+CREATE VIEW genesis_info_view AS
+    SELECT
+        gen_asset_id, asset_id, asset_tag, meta_data, output_index, asset_type,
+        decimal_display, genesis_points.prev_out prev_out
+    FROM genesis_assets
+    JOIN genesis_points
+        ON genesis_assets.genesis_point_id = genesis_points.genesis_id;
@@ -0,0 +1,2 @@
+ALTER TABLE asset_deltas DROP COLUMN asset_id;
+ALTER TABLE asset_deltas DROP COLUMN old_amt;
@@ -0,0 +1,39 @@
+-- old_amt is the amount of the asset before it was spent in the transfer the
+-- delta belongs to.
+ALTER TABLE asset_deltas ADD COLUMN old_amt BIGINT;
+
+-- asset_id references the asset the delta was applied to. As an asset is
+-- updated in place when it's spent, this lets us reconstruct the history of
+-- prior, now spent, states of the asset.
+ALTER TABLE asset_deltas ADD COLUMN asset_id INTEGER REFERENCES assets(asset_id);
+
+-- For existing deltas, we'll walk the chain of deltas backwards from the
+-- current state of each asset. The latest delta of an asset either already
+-- moved the asset to its new script key, or, if its transfer is still
+-- pending, spends the script key the asset still carries. Each earlier delta
+-- created the script key that is spent by the delta that followed it.
+WITH RECURSIVE delta_assets(delta_id, asset_id) AS (
+    SELECT deltas.id, assets.asset_id
+    FROM asset_deltas deltas
+    LEFT JOIN script_keys
+        ON deltas.old_script_key = script_keys.tweaked_script_key
+    JOIN assets
+        ON assets.script_key_id = deltas.new_script_key OR
+            assets.script_key_id = script_keys.script_key_id
+
+    UNION
+
+    SELECT prev_deltas.id, delta_assets.asset_id
+    FROM delta_assets
+    JOIN asset_deltas next_deltas
+        ON next_deltas.id = delta_assets.delta_id
+    JOIN script_keys
+        ON next_deltas.old_script_key = script_keys.tweaked_script_key
+    JOIN asset_deltas prev_deltas
+        ON prev_deltas.new_script_key = script_keys.script_key_id
+)
+UPDATE asset_deltas SET asset_id = (
+    SELECT delta_assets.asset_id
+    FROM delta_assets
+    WHERE delta_assets.delta_id = asset_deltas.id
+);
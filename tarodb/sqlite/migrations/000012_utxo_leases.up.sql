@@ -0,0 +1,8 @@
+-- lease_owner is the ID of the owner of the lease on a managed UTXO. While a
+-- UTXO is leased, the assets anchored at it can't be selected as inputs for a
+-- new send.
+ALTER TABLE managed_utxos ADD COLUMN lease_owner BLOB;
+
+-- lease_expiry is the time at which the lease on a managed UTXO expires. Once
+-- the lease expired, the UTXO can be selected again.
+ALTER TABLE managed_utxos ADD COLUMN lease_expiry TIMESTAMP;
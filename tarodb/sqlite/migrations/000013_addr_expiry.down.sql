@@ -0,0 +1,34 @@
+-- Events that were flagged with one of the new statuses can't be represented
+-- anymore, so we drop them before restoring the old CHECK constraint.
+DELETE FROM addr_events WHERE status NOT IN (0, 1, 2, 3);
+
+CREATE TABLE IF NOT EXISTS addr_events_old (
+    id INTEGER PRIMARY KEY,
+    creation_time TIMESTAMP NOT NULL,
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+    status SMALLINT NOT NULL CHECK (status IN (0, 1, 2, 3)),
+    chain_txn_id INTEGER NOT NULL REFERENCES chain_txns(txn_id),
+    chain_txn_output_index INTEGER NOT NULL,
+    managed_utxo_id INTEGER NOT NULL REFERENCES managed_utxos(utxo_id),
+    asset_proof_id INTEGER REFERENCES asset_proofs(proof_id),
+    asset_id INTEGER REFERENCES assets(asset_id),
+    UNIQUE(addr_id, chain_txn_id, chain_txn_output_index)
+);
+
+INSERT INTO addr_events_old SELECT * FROM addr_events;
+
+DROP INDEX IF EXISTS creation_time_idx;
+DROP INDEX IF EXISTS status_idx;
+DROP INDEX IF EXISTS asset_proof_id_idx;
+DROP INDEX IF EXISTS asset_id_idx;
+DROP TABLE addr_events;
+
+ALTER TABLE addr_events_old RENAME TO addr_events;
+
+CREATE INDEX IF NOT EXISTS creation_time_idx ON addr_events(creation_time);
+CREATE INDEX IF NOT EXISTS status_idx ON addr_events(status);
+CREATE INDEX IF NOT EXISTS asset_proof_id_idx ON addr_events(asset_proof_id);
+CREATE INDEX IF NOT EXISTS asset_id_idx ON addr_events(asset_id);
+
+ALTER TABLE addrs DROP COLUMN max_receives;
+ALTER TABLE addrs DROP COLUMN expiry;
@@ -0,0 +1,11 @@
+-- asset_amount is the amount of asset units that were received with an
+-- address event. This is usually the amount of the address, but addresses
+-- without an amount accept any amount chosen by the sender.
+ALTER TABLE addr_events ADD COLUMN asset_amount BIGINT NOT NULL DEFAULT 0;
+
+-- All existing events were received with fixed amount addresses.
+UPDATE addr_events SET asset_amount = (
+    SELECT amount
+    FROM addrs
+    WHERE addrs.id = addr_events.addr_id
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS addr_tag_keys;
+DROP TABLE IF EXISTS addr_tags;
+
+DROP INDEX IF EXISTS addr_labels;
+ALTER TABLE addrs DROP COLUMN label;
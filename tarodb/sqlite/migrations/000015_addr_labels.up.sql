@@ -0,0 +1,23 @@
+-- label is an optional user provided label of an address.
+ALTER TABLE addrs ADD COLUMN label TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS addr_labels ON addrs (label);
+
+-- addr_tags stores arbitrary user provided key/value tags of addresses. Each
+-- address can have at most one value per tag key.
+CREATE TABLE IF NOT EXISTS addr_tags (
+    id INTEGER PRIMARY KEY,
+
+    -- addr_id is the reference to the address the tag belongs to.
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+
+    -- tag_key is the key of the tag.
+    tag_key TEXT NOT NULL CHECK(length(tag_key) > 0),
+
+    -- tag_value is the value of the tag.
+    tag_value TEXT NOT NULL,
+
+    UNIQUE(addr_id, tag_key)
+);
+
+CREATE INDEX IF NOT EXISTS addr_tag_keys ON addr_tags (tag_key, tag_value);
@@ -0,0 +1,8 @@
+-- Cancelled batches (6) are unknown to older versions, which would attempt to
+-- resume them, so we remove them along with their seedlings.
+DELETE FROM asset_seedlings WHERE batch_id IN (
+    SELECT batch_id FROM asset_minting_batches WHERE batch_state = 6
+);
+DELETE FROM asset_minting_batches WHERE batch_state = 6;
+
+ALTER TABLE asset_minting_batches DROP COLUMN batch_fee_rate;
@@ -0,0 +1,4 @@
+-- batch_fee_rate is the fee rate in sat/kw the genesis transaction of a
+-- minting batch is funded with. If this is NULL, the fee rate is estimated
+-- when the batch is funded.
+ALTER TABLE asset_minting_batches ADD COLUMN batch_fee_rate BIGINT;
@@ -21,6 +21,9 @@ type Addr struct {
 	AssetType        int16
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
+	Expiry           sql.NullTime
+	MaxReceives      int32
+	Label            string
 }
 
 type AddrEvent struct {
@@ -33,6 +36,15 @@ type AddrEvent struct {
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	AssetAmount         int64
+	FailureReason       string
+}
+
+type AddrTag struct {
+	ID       int32
+	AddrID   int32
+	TagKey   string
+	TagValue string
 }
 
 type Asset struct {
@@ -50,6 +62,15 @@ type Asset struct {
 	AnchorUtxoID             sql.NullInt32
 }
 
+type AssetBurn struct {
+	BurnID        int32
+	TransferID    int32
+	AssetID       []byte
+	TweakedFamKey []byte
+	Amount        int64
+	BurnScriptKey []byte
+}
+
 type AssetDelta struct {
 	ID                       int32
 	OldScriptKey             []byte
@@ -60,6 +81,8 @@ type AssetDelta struct {
 	SplitCommitmentRootValue sql.NullInt64
 	TransferID               int32
 	ProofID                  int32
+	OldAmt                   sql.NullInt64
+	AssetID                  sql.NullInt32
 }
 
 type AssetFamily struct {
@@ -83,6 +106,7 @@ type AssetMintingBatch struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	BatchFeeRate       sql.NullInt64
 }
 
 type AssetProof struct {
@@ -100,6 +124,7 @@ type AssetSeedling struct {
 	EmissionEnabled bool
 	GenesisID       sql.NullInt32
 	BatchID         int32
+	AssetFamilyID   sql.NullInt32
 }
 
 type AssetTransfer struct {
@@ -138,16 +163,19 @@ type GenesisAsset struct {
 	OutputIndex    int32
 	AssetType      int16
 	GenesisPointID int32
+	MetaHash       []byte
+	DecimalDisplay int32
 }
 
 type GenesisInfoView struct {
-	GenAssetID  int32
-	AssetID     []byte
-	AssetTag    string
-	MetaData    []byte
-	OutputIndex int32
-	AssetType   int16
-	PrevOut     []byte
+	GenAssetID     int32
+	AssetID        []byte
+	AssetTag       string
+	MetaData       []byte
+	OutputIndex    int32
+	AssetType      int16
+	DecimalDisplay int32
+	PrevOut        []byte
 }
 
 type GenesisPoint struct {
@@ -186,6 +214,8 @@ type ManagedUtxo struct {
 	TapscriptSibling []byte
 	TaroRoot         []byte
 	TxnID            int32
+	LeaseOwner       []byte
+	LeaseExpiry      sql.NullTime
 }
 
 type MssmtNode struct {
@@ -21,15 +21,27 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	CountTransfersByOldAnchorPoint(ctx context.Context, oldAnchorPoint []byte) (int64, error)
 	DeleteAssetWitnesses(ctx context.Context, assetID int32) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteSeedlingFromBatch(ctx context.Context, arg DeleteSeedlingFromBatchParams) error
 	DeleteSpendProofs(ctx context.Context, transferID int32) error
+	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+	FailAddrEvent(ctx context.Context, arg FailAddrEventParams) error
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventRow, error)
+	FetchAddrTags(ctx context.Context, addrID int32) ([]FetchAddrTagsRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
+	FetchAssetBurns(ctx context.Context, transferID int32) ([]FetchAssetBurnsRow, error)
 	FetchAssetDeltas(ctx context.Context, transferID int32) ([]FetchAssetDeltasRow, error)
 	FetchAssetDeltasWithProofs(ctx context.Context, transferID int32) ([]FetchAssetDeltasWithProofsRow, error)
+	// The family key is tweaked with the genesis of the first asset issued into
+	// the family, so we'll fetch that genesis along with the raw family key.
+	FetchAssetFamily(ctx context.Context, tweakedFamKey []byte) (FetchAssetFamilyRow, error)
+	FetchAssetIDByScriptKey(ctx context.Context, tweakedScriptKey []byte) (int32, error)
+	FetchAssetMetaByHash(ctx context.Context, metaHash []byte) (FetchAssetMetaByHashRow, error)
+	FetchAssetMetaForAsset(ctx context.Context, assetID []byte) (FetchAssetMetaForAssetRow, error)
 	FetchAssetProof(ctx context.Context, tweakedScriptKey []byte) (FetchAssetProofRow, error)
 	FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error)
 	FetchAssetWitnesses(ctx context.Context, assetID sql.NullInt32) ([]FetchAssetWitnessesRow, error)
@@ -42,19 +54,24 @@ type Querier interface {
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
+	FetchConfirmedMintingBatches(ctx context.Context, arg FetchConfirmedMintingBatchesParams) ([]FetchConfirmedMintingBatchesRow, error)
+	FetchGenesisAssetsWithoutMetaHash(ctx context.Context) ([]FetchGenesisAssetsWithoutMetaHashRow, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int32) (FetchGenesisByIDRow, error)
 	FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sql.NullInt32) (GenesisPoint, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
+	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
 	FetchMintingBatchesByState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByStateRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
-	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]AssetSeedling, error)
+	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
 	FetchSpendProofs(ctx context.Context, transferID int32) (FetchSpendProofsRow, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
 	InsertAddr(ctx context.Context, arg InsertAddrParams) (int32, error)
+	InsertAddrTag(ctx context.Context, arg InsertAddrTagParams) error
+	InsertAssetBurn(ctx context.Context, arg InsertAssetBurnParams) error
 	InsertAssetDelta(ctx context.Context, arg InsertAssetDeltaParams) error
 	InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error
 	InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error
@@ -72,7 +89,17 @@ type Querier interface {
 	// doesn't have a family key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter interface{}) ([]QueryAssetBalancesByAssetRow, error)
+	// We use a LEFT JOIN here, so all assets that don't have a family key are
+	// aggregated into a single balance with a NULL family key. As assets issued
+	// into an existing family share the same tweaked family key, their balances
+	// are summed up across all their distinct asset IDs. All assets of a family
+	// share the same decimal display, so we can just pick the largest one.
 	QueryAssetBalancesByFamily(ctx context.Context, keyFamFilter interface{}) ([]QueryAssetBalancesByFamilyRow, error)
+	QueryAssetBurns(ctx context.Context, assetIDFilter interface{}) ([]QueryAssetBurnsRow, error)
+	// Only assets minted by this daemon have a minting batch. The batch also holds
+	// the seedling of the asset, which contains the amount that was minted. Just
+	// like the caretaker does, we mint a single unit of collectible assets.
+	QueryAssetMints(ctx context.Context, arg QueryAssetMintsParams) ([]QueryAssetMintsRow, error)
 	QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error)
 	// We use a LEFT JOIN here as not every asset has a family key, so this'll
 	// generate rows that have NULL values for the family key fields if an asset
@@ -82,12 +109,27 @@ type Querier interface {
 	// channel balances, and also coin selection. We use the sqlc.narg feature to
 	// make the entire statement evaluate to true, if none of these extra args are
 	// specified.
+	// A limit of -1 means that all matching assets are returned.
 	QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error)
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
+	// Every asset delta describes a prior state of an asset that was spent by an
+	// outbound transfer. We join the delta with the asset it was applied to, to
+	// obtain the genesis information of the spent asset. We use a LEFT JOIN here,
+	// so deltas whose asset couldn't be recovered when migrating older transfers
+	// are still returned, just without any genesis information.
+	QuerySpentAssets(ctx context.Context, arg QuerySpentAssetsParams) ([]QuerySpentAssetsRow, error)
+	// Every asset delta describes an asset that was spent by an outbound transfer.
+	// The receiver proof of the delta holds the asset that was sent to the
+	// receiver. If the transfer burned the asset, then the receiver is the burn
+	// key.
+	QueryTransferHistory(ctx context.Context, arg QueryTransferHistoryParams) ([]QueryTransferHistoryRow, error)
 	ReanchorAssets(ctx context.Context, arg ReanchorAssetsParams) error
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateGenesisAssetMetaHash(ctx context.Context, arg UpdateGenesisAssetMetaHashParams) error
+	UpdateMintingBatchFeeRate(ctx context.Context, arg UpdateMintingBatchFeeRateParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error)
 	UpsertAssetFamilyKey(ctx context.Context, arg UpsertAssetFamilyKeyParams) (int32, error)
 	UpsertAssetFamilySig(ctx context.Context, arg UpsertAssetFamilySigParams) (int32, error)
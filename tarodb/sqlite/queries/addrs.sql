@@ -1,13 +1,27 @@
 -- name: InsertAddr :one
 INSERT INTO addrs (
     version, genesis_asset_id, fam_key, script_key_id, taproot_key_id,
-    taproot_output_key, amount, asset_type, creation_time
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id;
+    taproot_output_key, amount, asset_type, creation_time, expiry,
+    max_receives, label
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id;
+
+-- name: InsertAddrTag :exec
+INSERT INTO addr_tags (
+    addr_id, tag_key, tag_value
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: FetchAddrTags :many
+SELECT tag_key, tag_value
+FROM addr_tags
+WHERE addr_id = ?
+ORDER BY tag_key;
 
 -- name: FetchAddrs :many
 SELECT 
-    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    addrs.id, version, genesis_asset_id, fam_key, taproot_output_key, amount,
+    asset_type, creation_time, managed_from, expiry, max_receives, label,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key AS raw_script_key,
@@ -23,16 +37,45 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE creation_time >= @created_after
-    AND creation_time <= @created_before
+WHERE addrs.creation_time >= @created_after
+    AND addrs.creation_time <= @created_before
     AND (@unmanaged_only = false OR IFNULL(managed_from, true) = @unmanaged_only) 
+    AND (@expired_only = false OR addrs.expiry <= @now OR (
+        addrs.max_receives > 0 AND (
+            SELECT COUNT(*)
+            FROM addr_events
+            WHERE addr_events.addr_id = addrs.id
+                AND addr_events.status <= @max_receive_status
+        ) >= addrs.max_receives
+    ))
+    AND (addrs.label = sqlc.narg('label') OR sqlc.narg('label') IS NULL)
+    AND (EXISTS (
+        SELECT 1
+        FROM addr_tags
+        WHERE addr_tags.addr_id = addrs.id
+            AND addr_tags.tag_key = sqlc.narg('tag_key')
+            AND (
+                addr_tags.tag_value = sqlc.narg('tag_value') OR
+                sqlc.narg('tag_value') IS NULL
+            )
+    ) OR sqlc.narg('tag_key') IS NULL)
+    AND (@active_only = false OR (
+        (addrs.expiry > @now OR addrs.expiry IS NULL) AND (
+            addrs.max_receives = 0 OR (
+                SELECT COUNT(*)
+                FROM addr_events
+                WHERE addr_events.addr_id = addrs.id
+                    AND addr_events.status <= @max_receive_status
+            ) < addrs.max_receives
+        )
+    ))
 ORDER BY addrs.creation_time
 LIMIT @num_limit OFFSET @num_offset;
 
 -- name: FetchAddrByTaprootOutputKey :one
 SELECT
-    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    addrs.id, version, genesis_asset_id, fam_key, taproot_output_key, amount,
+    asset_type, creation_time, managed_from, expiry, max_receives, label,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
     raw_script_keys.raw_key as raw_script_key,
@@ -64,28 +107,41 @@ WHERE id = (SELECT addr_id FROM target_addr);
 WITH target_addr(addr_id) AS (
     SELECT id
     FROM addrs
-    WHERE addrs.taproot_output_key = ?
+    WHERE addrs.taproot_output_key = @taproot_output_key
 ), target_chain_txn(txn_id) AS (
     SELECT txn_id
     FROM chain_txns
-    WHERE chain_txns.txid = ?
+    WHERE chain_txns.txid = @txid
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, asset_amount
 ) VALUES (
-    ?, (SELECT addr_id FROM target_addr), ?,
-    (SELECT txn_id FROM target_chain_txn), ?, ?, ?, ?
+    @creation_time, (SELECT addr_id FROM target_addr), @status,
+    (SELECT txn_id FROM target_chain_txn), @chain_txn_output_index,
+    @managed_utxo_id, @asset_proof_id, @asset_id, @asset_amount
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
-    DO UPDATE SET status = EXCLUDED.status,
+    -- Events that reached the final status threshold, which covers the
+    -- completed status and all failure statuses, are never updated again.
+    DO UPDATE SET status = CASE
+                      WHEN addr_events.status >= @min_final_status
+                          THEN addr_events.status
+                      ELSE EXCLUDED.status
+                  END,
                   asset_proof_id = IFNULL(EXCLUDED.asset_proof_id, asset_proof_id),
                   asset_id = IFNULL(EXCLUDED.asset_id, asset_id)
 RETURNING id;
 
+-- name: FailAddrEvent :exec
+UPDATE addr_events
+SET status = @status, failure_reason = @failure_reason
+WHERE id = @id;
+
 -- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, asset_amount,
+    failure_reason,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
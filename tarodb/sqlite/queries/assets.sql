@@ -34,6 +34,21 @@ JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
 WHERE batches.batch_state != ?;
 
+-- name: FetchConfirmedMintingBatches :many
+SELECT
+    batches.*, keys.*, txns.block_height AS minting_tx_block_height,
+    txns.block_hash AS minting_tx_block_hash,
+    txns.tx_index AS minting_tx_index
+FROM asset_minting_batches batches
+JOIN internal_keys keys
+    ON batches.batch_id = keys.key_id
+JOIN genesis_points points
+    ON batches.genesis_id = points.genesis_id
+JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+WHERE batches.batch_state = @batch_state AND
+    txns.block_height >= @min_block_height;
+
 -- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -50,12 +65,36 @@ UPDATE asset_minting_batches
 SET batch_state = ? 
 WHERE batch_id in (SELECT batch_id FROM target_batch);
 
+-- name: UpdateMintingBatchFeeRate :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = ?
+)
+UPDATE asset_minting_batches
+SET batch_fee_rate = ?
+WHERE batch_id in (SELECT batch_id FROM target_batch);
+
+-- name: DeleteSeedlingFromBatch :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = ?
+)
+DELETE FROM asset_seedlings
+WHERE asset_name = ?
+    AND batch_id in (SELECT batch_id FROM target_batch);
+
 -- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, asset_family_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?
 );
 
 -- name: AllInternalKeys :many
@@ -81,9 +120,9 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, asset_family_id
 ) VALUES (
-    ?, ?, ?, ?, ?, (SELECT key_id FROM target_key_id)
+    ?, ?, ?, ?, ?, (SELECT key_id FROM target_key_id), ?
 );
 
 -- name: FetchSeedlingsForBatch :many
@@ -95,8 +134,11 @@ WITH target_batch(batch_id) AS (
     WHERE keys.raw_key = ?
 )
 SELECT seedling_id, asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, genesis_id, batch_id
+    emission_enabled, genesis_id, batch_id,
+    fams.tweaked_fam_key AS family_key
 FROM asset_seedlings 
+LEFT JOIN asset_families fams
+    ON asset_seedlings.asset_family_id = fams.family_id
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch);
 
 -- name: UpsertGenesisPoint :one
@@ -115,11 +157,32 @@ INSERT INTO asset_families (
 ) VALUES (
     ?, ?, ?
 ) ON CONFLICT (tweaked_fam_key)
-    -- This is not a NOP, update the genesis point ID in case it wasn't set
-    -- before.
-    DO UPDATE SET genesis_point_id = EXCLUDED.genesis_point_id
+    -- This is a NOP, the genesis point of a family is the one of the first
+    -- asset issued into it, which must not be overwritten by any later
+    -- issuance.
+    DO UPDATE SET tweaked_fam_key = EXCLUDED.tweaked_fam_key
 RETURNING family_id;
 
+-- name: FetchAssetFamily :one
+-- The family key is tweaked with the genesis of the first asset issued into
+-- the family, so we'll fetch that genesis along with the raw family key.
+SELECT fams.family_id, keys.raw_key, keys.key_family, keys.key_index,
+    points.prev_out AS genesis_prev_out, gen.asset_tag, gen.meta_data,
+    gen.output_index, gen.asset_type
+FROM asset_families fams
+JOIN internal_keys keys
+    ON fams.internal_key_id = keys.key_id
+JOIN genesis_points points
+    ON fams.genesis_point_id = points.genesis_id
+JOIN asset_family_sigs sigs
+    ON sigs.key_fam_id = fams.family_id
+JOIN genesis_assets gen
+    ON sigs.gen_asset_id = gen.gen_asset_id AND
+        gen.genesis_point_id = fams.genesis_point_id
+WHERE fams.tweaked_fam_key = ?
+ORDER BY gen.gen_asset_id
+LIMIT 1;
+
 -- name: UpsertAssetFamilySig :one
 INSERT INTO asset_family_sigs (
     genesis_sig, gen_asset_id, key_fam_id
@@ -131,14 +194,37 @@ RETURNING sig_id;
 
 -- name: UpsertGenesisAsset :one
 INSERT INTO genesis_assets (
-    asset_id, asset_tag, meta_data, output_index, asset_type, genesis_point_id
+    asset_id, asset_tag, meta_data, meta_hash, decimal_display, output_index,
+    asset_type, genesis_point_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?
 ) ON CONFLICT (asset_tag)
     -- This is a NOP, asset_tag is the unique field that caused the conflict.
     DO UPDATE SET asset_tag = EXCLUDED.asset_tag
 RETURNING gen_asset_id;
 
+-- name: FetchAssetMetaForAsset :one
+SELECT asset_id, meta_data, meta_hash
+FROM genesis_assets
+WHERE asset_id = ?;
+
+-- name: FetchAssetMetaByHash :one
+SELECT asset_id, meta_data, meta_hash
+FROM genesis_assets
+WHERE meta_hash = ?
+ORDER BY gen_asset_id
+LIMIT 1;
+
+-- name: FetchGenesisAssetsWithoutMetaHash :many
+SELECT gen_asset_id, meta_data
+FROM genesis_assets
+WHERE meta_hash IS NULL;
+
+-- name: UpdateGenesisAssetMetaHash :exec
+UPDATE genesis_assets
+SET meta_hash = @meta_hash
+WHERE gen_asset_id = @gen_asset_id;
+
 -- name: InsertNewAsset :one
 INSERT INTO assets (
     genesis_id, version, script_key_id, asset_family_sig_id, script_version, 
@@ -210,6 +296,7 @@ SELECT
     genesis_info_view.asset_id, version, SUM(amount) balance,
     genesis_info_view.asset_tag, genesis_info_view.meta_data,
     genesis_info_view.asset_type, genesis_info_view.output_index,
+    genesis_info_view.decimal_display,
     genesis_info_view.prev_out AS genesis_point
 FROM assets
 JOIN genesis_info_view
@@ -224,12 +311,23 @@ LEFT JOIN key_fam_info_view
 GROUP BY assets.genesis_id;
 
 -- name: QueryAssetBalancesByFamily :many
+-- We use a LEFT JOIN here, so all assets that don't have a family key are
+-- aggregated into a single balance with a NULL family key. As assets issued
+-- into an existing family share the same tweaked family key, their balances
+-- are summed up across all their distinct asset IDs. All assets of a family
+-- share the same decimal display, so we can just pick the largest one.
 SELECT
-    key_fam_info_view.tweaked_fam_key, SUM(amount) balance
+    key_fam_info_view.tweaked_fam_key, SUM(amount) balance,
+    CAST(MAX(genesis_info_view.decimal_display) AS INTEGER) AS decimal_display
 FROM assets
-JOIN key_fam_info_view
-    ON assets.genesis_id = key_fam_info_view.gen_asset_id AND
-        (length(hex(sqlc.narg('key_fam_filter'))) == 0 OR key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter'))
+JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+WHERE (
+    length(hex(sqlc.narg('key_fam_filter'))) == 0 OR
+        key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter')
+)
 GROUP BY key_fam_info_view.tweaked_fam_key;
 
 -- name: QueryAssets :many
@@ -253,6 +351,7 @@ SELECT
     genesis_info_view.asset_type,
     genesis_info_view.prev_out AS genesis_prev_out,
     txns.raw_tx AS anchor_tx, txns.txid AS anchor_txid, txns.block_hash AS anchor_block_hash,
+    txns.block_height AS anchor_block_height,
     utxos.outpoint AS anchor_outpoint,
     utxo_internal_keys.raw_key AS anchor_internal_key,
     split_commitment_root_hash, split_commitment_root_value
@@ -285,8 +384,22 @@ JOIN chain_txns txns
 -- specified.
 WHERE (
     assets.amount >= COALESCE(sqlc.narg('min_amt'), assets.amount) AND
-    (key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter') OR sqlc.narg('key_fam_filter') IS NULL)
-);
+    (key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter') OR sqlc.narg('key_fam_filter') IS NULL) AND
+    genesis_info_view.asset_type = COALESCE(sqlc.narg('asset_type'), genesis_info_view.asset_type) AND
+    -- An asset is confirmed once the transaction that anchors it has been
+    -- included in a block. A confirmed_filter of 1 only selects confirmed
+    -- assets, 2 only selects unconfirmed assets, and 0 selects both.
+    ((@confirmed_filter == 0 OR @confirmed_filter IS NULL) OR
+        ((@confirmed_filter == 1) == (length(hex(txns.block_hash)) != 0))) AND
+    -- If a lease time is specified, then assets anchored at a UTXO that's
+    -- leased beyond that time are skipped, so they can't be selected twice.
+    (utxos.lease_expiry <= sqlc.narg('lease_time') OR
+        sqlc.narg('lease_time') IS NULL OR utxos.lease_owner IS NULL OR
+        utxos.lease_expiry IS NULL)
+)
+ORDER BY assets.asset_id
+-- A limit of -1 means that all matching assets are returned.
+LIMIT @num_limit OFFSET @num_offset;
 
 -- name: AllAssets :many
 SELECT * 
@@ -374,6 +487,28 @@ WHERE (
     (length(hex(sqlc.narg('outpoint'))) == 0 OR utxos.outpoint = sqlc.narg('outpoint'))
 );
 
+-- name: FetchManagedUTXOs :many
+SELECT *
+FROM managed_utxos utxos
+JOIN internal_keys keys
+    ON utxos.internal_key_id = keys.key_id
+ORDER BY utxos.utxo_id;
+
+-- name: UpdateUTXOLease :exec
+UPDATE managed_utxos
+SET lease_owner = @lease_owner, lease_expiry = @lease_expiry
+WHERE outpoint = @outpoint;
+
+-- name: DeleteUTXOLease :exec
+UPDATE managed_utxos
+SET lease_owner = NULL, lease_expiry = NULL
+WHERE outpoint = @outpoint;
+
+-- name: CountTransfersByOldAnchorPoint :one
+SELECT COUNT(*)
+FROM asset_transfers
+WHERE old_anchor_point = @old_anchor_point;
+
 -- name: AnchorPendingAssets :exec
 WITH assets_to_update AS (
     SELECT script_key_id
@@ -535,3 +670,33 @@ INSERT INTO script_keys (
     -- conflict.
     DO UPDATE SET tweaked_script_key = EXCLUDED.tweaked_script_key
 RETURNING script_key_id;
+
+-- name: QueryAssetMints :many
+-- Only assets minted by this daemon have a minting batch. The batch also holds
+-- the seedling of the asset, which contains the amount that was minted. Just
+-- like the caretaker does, we mint a single unit of collectible assets.
+SELECT
+    gen.asset_id, key_fam_info_view.tweaked_fam_key,
+    CAST(CASE WHEN gen.asset_type = 1 THEN 1 ELSE seedlings.asset_supply END
+        AS BIGINT) AS amount,
+    batches.creation_time_unix AS mint_time,
+    txns.txid AS anchor_txid, txns.block_height AS anchor_block_height
+FROM genesis_assets gen
+JOIN genesis_points points
+    ON gen.genesis_point_id = points.genesis_id
+JOIN asset_minting_batches batches
+    ON batches.genesis_id = points.genesis_id
+JOIN asset_seedlings seedlings
+    ON seedlings.batch_id = batches.batch_id AND
+        seedlings.asset_name = gen.asset_tag
+LEFT JOIN chain_txns txns
+    ON points.anchor_tx_id = txns.txn_id
+LEFT JOIN key_fam_info_view
+    ON gen.gen_asset_id = key_fam_info_view.gen_asset_id
+WHERE (
+    (length(hex(sqlc.narg('asset_id_filter'))) == 0 OR
+        gen.asset_id = sqlc.narg('asset_id_filter')) AND
+    (length(hex(sqlc.narg('key_fam_filter'))) == 0 OR
+        key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter'))
+)
+ORDER BY batches.creation_time_unix, gen.gen_asset_id;
@@ -7,12 +7,22 @@ INSERT INTO asset_transfers (
 
 -- name: InsertAssetDelta :exec
 INSERT INTO asset_deltas (
-    old_script_key, new_amt, new_script_key, serialized_witnesses, transfer_id,
-    proof_id, split_commitment_root_hash, split_commitment_root_value
+    old_script_key, old_amt, new_amt, new_script_key, serialized_witnesses,
+    transfer_id, proof_id, split_commitment_root_hash,
+    split_commitment_root_value, asset_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?
+    @old_script_key, @old_amt, @new_amt, @new_script_key,
+    @serialized_witnesses, @transfer_id, @proof_id,
+    @split_commitment_root_hash, @split_commitment_root_value, @asset_id
 );
 
+-- name: FetchAssetIDByScriptKey :one
+SELECT assets.asset_id
+FROM assets
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+WHERE script_keys.tweaked_script_key = @tweaked_script_key;
+
 -- name: InsertSpendProofs :one
 INSERT INTO transfer_proofs (
    transfer_id, sender_proof, receiver_proof 
@@ -26,16 +36,18 @@ SELECT
     utxos.taro_root, utxos.tapscript_sibling, 
     utxos.utxo_id AS new_anchor_utxo_id, txns.raw_tx AS anchor_tx_bytes, 
     txns.txid AS anchor_txid, txns.txn_id AS anchor_tx_primary_key, 
-    txns.chain_fees, transfer_time_unix, keys.raw_key AS internal_key_bytes,
-    keys.key_family AS internal_key_fam, keys.key_index AS internal_key_index,
-    id AS transfer_id, transfer_time_unix
+    txns.chain_fees, txns.block_height AS anchor_tx_block_height,
+    txns.block_hash AS anchor_tx_block_hash, transfer_time_unix,
+    keys.raw_key AS internal_key_bytes, keys.key_family AS internal_key_fam,
+    keys.key_index AS internal_key_index, id AS transfer_id,
+    transfer_time_unix
 FROM asset_transfers
 JOIN internal_keys keys
     ON asset_transfers.new_internal_key = keys.key_id
 JOIN managed_utxos utxos
     ON asset_transfers.new_anchor_utxo = utxos.utxo_id
 JOIN chain_txns txns
-    ON utxos.utxo_id = txns.txn_id
+    ON utxos.txn_id = txns.txn_id
 WHERE (
     -- We'll use this clause to filter out for only transfers that are
     -- unconfirmed. But only if the unconf_only field is set.
@@ -50,11 +62,19 @@ WHERE (
     -- based on the new_anchor_point, but only if it's specified.
     (length(hex(sqlc.narg('new_anchor_point'))) == 0 OR 
         utxos.outpoint = sqlc.narg('new_anchor_point'))
+
+    AND
+
+    -- This optional clause selects only transfers whose anchor transaction
+    -- was confirmed at or above the given block height.
+    ((@min_block_height == 0 OR @min_block_height IS NULL)
+        OR
+    (txns.block_height >= @min_block_height))
 );
 
 -- name: FetchAssetDeltas :many
 SELECT  
-    deltas.old_script_key, deltas.new_amt, 
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
     deltas.new_script_key AS new_script_key_id, 
@@ -72,7 +92,7 @@ WHERE transfer_id = ?;
 
 -- name: FetchAssetDeltasWithProofs :many
 SELECT  
-    deltas.old_script_key, deltas.new_amt, 
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
     deltas.new_script_key AS new_script_key_id, 
@@ -128,3 +148,117 @@ WHERE asset_id = ?;
 -- name: DeleteSpendProofs :exec
 DELETE FROM transfer_proofs
 WHERE transfer_id = ?;
+
+-- name: InsertAssetBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, asset_id, tweaked_fam_key, amount, burn_script_key
+) VALUES (
+    ?, ?, ?, ?, ?
+);
+
+-- name: FetchAssetBurns :many
+SELECT asset_id, tweaked_fam_key, amount, burn_script_key
+FROM asset_burns
+WHERE transfer_id = ?;
+
+-- name: QueryAssetBurns :many
+SELECT
+    burns.asset_id, burns.tweaked_fam_key, burns.amount,
+    burns.burn_script_key, txns.txid AS anchor_txid,
+    txns.block_hash AS anchor_block_hash, transfers.transfer_time_unix
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE (
+    length(hex(sqlc.narg('asset_id_filter'))) == 0 OR
+        burns.asset_id = sqlc.narg('asset_id_filter')
+)
+ORDER BY transfers.transfer_time_unix;
+
+-- name: QuerySpentAssets :many
+-- Every asset delta describes a prior state of an asset that was spent by an
+-- outbound transfer. We join the delta with the asset it was applied to, to
+-- obtain the genesis information of the spent asset. We use a LEFT JOIN here,
+-- so deltas whose asset couldn't be recovered when migrating older transfers
+-- are still returned, just without any genesis information.
+SELECT
+    genesis_info_view.asset_id, genesis_info_view.asset_tag,
+    genesis_info_view.meta_data, genesis_info_view.asset_type,
+    genesis_info_view.output_index AS genesis_output_index,
+    genesis_info_view.prev_out AS genesis_prev_out,
+    key_fam_info_view.tweaked_fam_key,
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt,
+    transfers.old_anchor_point, transfers.transfer_time_unix,
+    txns.txid AS spend_txid, txns.block_hash AS spend_block_hash
+FROM asset_deltas deltas
+JOIN asset_transfers transfers
+    ON deltas.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+LEFT JOIN assets
+    ON deltas.asset_id = assets.asset_id
+LEFT JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+WHERE (
+    (length(hex(sqlc.narg('asset_id_filter'))) == 0 OR
+        genesis_info_view.asset_id = sqlc.narg('asset_id_filter')) AND
+    (length(hex(sqlc.narg('key_fam_filter'))) == 0 OR
+        key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter')) AND
+    (length(hex(sqlc.narg('anchor_point'))) == 0 OR
+        transfers.old_anchor_point = sqlc.narg('anchor_point')) AND
+    -- Deltas without genesis information have no asset type, so they
+    -- only match if no type filter is set.
+    COALESCE(genesis_info_view.asset_type, -1) = COALESCE(
+        sqlc.narg('asset_type'), genesis_info_view.asset_type, -1
+    ) AND
+    COALESCE(deltas.old_amt, 0) >= COALESCE(sqlc.narg('min_amt'), 0) AND
+    -- A spent asset is confirmed once the transfer that spent it is.
+    ((@confirmed_filter == 0 OR @confirmed_filter IS NULL) OR
+        ((@confirmed_filter == 1) == (length(hex(txns.block_hash)) != 0)))
+)
+ORDER BY deltas.id
+LIMIT @num_limit OFFSET @num_offset;
+
+-- name: QueryTransferHistory :many
+-- Every asset delta describes an asset that was spent by an outbound transfer.
+-- The receiver proof of the delta holds the asset that was sent to the
+-- receiver. If the transfer burned the asset, then the receiver is the burn
+-- key.
+SELECT
+    genesis_info_view.asset_id, key_fam_info_view.tweaked_fam_key,
+    proofs.receiver_proof, burns.amount AS burn_amt,
+    transfers.transfer_time_unix, txns.txid AS anchor_txid,
+    txns.block_height AS anchor_block_height
+FROM asset_deltas deltas
+JOIN asset_transfers transfers
+    ON deltas.transfer_id = transfers.id
+JOIN transfer_proofs proofs
+    ON deltas.proof_id = proofs.proof_id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+LEFT JOIN assets
+    ON deltas.asset_id = assets.asset_id
+LEFT JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+LEFT JOIN asset_burns burns
+    ON burns.transfer_id = transfers.id AND
+        burns.asset_id = genesis_info_view.asset_id
+WHERE (
+    (length(hex(sqlc.narg('asset_id_filter'))) == 0 OR
+        genesis_info_view.asset_id = sqlc.narg('asset_id_filter')) AND
+    (length(hex(sqlc.narg('key_fam_filter'))) == 0 OR
+        key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter'))
+)
+ORDER BY transfers.transfer_time_unix, deltas.id;
@@ -66,9 +66,50 @@ func (q *Queries) DeleteSpendProofs(ctx context.Context, transferID int32) error
 	return err
 }
 
+const fetchAssetBurns = `-- name: FetchAssetBurns :many
+SELECT asset_id, tweaked_fam_key, amount, burn_script_key
+FROM asset_burns
+WHERE transfer_id = ?
+`
+
+type FetchAssetBurnsRow struct {
+	AssetID       []byte
+	TweakedFamKey []byte
+	Amount        int64
+	BurnScriptKey []byte
+}
+
+func (q *Queries) FetchAssetBurns(ctx context.Context, transferID int32) ([]FetchAssetBurnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAssetBurns, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAssetBurnsRow
+	for rows.Next() {
+		var i FetchAssetBurnsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.TweakedFamKey,
+			&i.Amount,
+			&i.BurnScriptKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAssetDeltas = `-- name: FetchAssetDeltas :many
 SELECT  
-    deltas.old_script_key, deltas.new_amt, 
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
     deltas.new_script_key AS new_script_key_id, 
@@ -87,6 +128,7 @@ WHERE transfer_id = ?
 
 type FetchAssetDeltasRow struct {
 	OldScriptKey             []byte
+	OldAmt                   sql.NullInt64
 	NewAmt                   int64
 	NewScriptKeyBytes        []byte
 	ScriptKeyTweak           []byte
@@ -110,6 +152,7 @@ func (q *Queries) FetchAssetDeltas(ctx context.Context, transferID int32) ([]Fet
 		var i FetchAssetDeltasRow
 		if err := rows.Scan(
 			&i.OldScriptKey,
+			&i.OldAmt,
 			&i.NewAmt,
 			&i.NewScriptKeyBytes,
 			&i.ScriptKeyTweak,
@@ -136,7 +179,7 @@ func (q *Queries) FetchAssetDeltas(ctx context.Context, transferID int32) ([]Fet
 
 const fetchAssetDeltasWithProofs = `-- name: FetchAssetDeltasWithProofs :many
 SELECT  
-    deltas.old_script_key, deltas.new_amt, 
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
     deltas.new_script_key AS new_script_key_id, 
@@ -158,6 +201,7 @@ WHERE deltas.transfer_id = ?
 
 type FetchAssetDeltasWithProofsRow struct {
 	OldScriptKey             []byte
+	OldAmt                   sql.NullInt64
 	NewAmt                   int64
 	NewScriptKeyBytes        []byte
 	ScriptKeyTweak           []byte
@@ -183,6 +227,7 @@ func (q *Queries) FetchAssetDeltasWithProofs(ctx context.Context, transferID int
 		var i FetchAssetDeltasWithProofsRow
 		if err := rows.Scan(
 			&i.OldScriptKey,
+			&i.OldAmt,
 			&i.NewAmt,
 			&i.NewScriptKeyBytes,
 			&i.ScriptKeyTweak,
@@ -209,6 +254,21 @@ func (q *Queries) FetchAssetDeltasWithProofs(ctx context.Context, transferID int
 	return items, nil
 }
 
+const fetchAssetIDByScriptKey = `-- name: FetchAssetIDByScriptKey :one
+SELECT assets.asset_id
+FROM assets
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+WHERE script_keys.tweaked_script_key = $1
+`
+
+func (q *Queries) FetchAssetIDByScriptKey(ctx context.Context, tweakedScriptKey []byte) (int32, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetIDByScriptKey, tweakedScriptKey)
+	var asset_id int32
+	err := row.Scan(&asset_id)
+	return asset_id, err
+}
+
 const fetchSpendProofs = `-- name: FetchSpendProofs :one
 SELECT sender_proof, receiver_proof
 FROM transfer_proofs
@@ -227,17 +287,48 @@ func (q *Queries) FetchSpendProofs(ctx context.Context, transferID int32) (Fetch
 	return i, err
 }
 
+const insertAssetBurn = `-- name: InsertAssetBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, asset_id, tweaked_fam_key, amount, burn_script_key
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+`
+
+type InsertAssetBurnParams struct {
+	TransferID    int32
+	AssetID       []byte
+	TweakedFamKey []byte
+	Amount        int64
+	BurnScriptKey []byte
+}
+
+func (q *Queries) InsertAssetBurn(ctx context.Context, arg InsertAssetBurnParams) error {
+	_, err := q.db.ExecContext(ctx, insertAssetBurn,
+		arg.TransferID,
+		arg.AssetID,
+		arg.TweakedFamKey,
+		arg.Amount,
+		arg.BurnScriptKey,
+	)
+	return err
+}
+
 const insertAssetDelta = `-- name: InsertAssetDelta :exec
 INSERT INTO asset_deltas (
-    old_script_key, new_amt, new_script_key, serialized_witnesses, transfer_id,
-    proof_id, split_commitment_root_hash, split_commitment_root_value
+    old_script_key, old_amt, new_amt, new_script_key, serialized_witnesses,
+    transfer_id, proof_id, split_commitment_root_hash,
+    split_commitment_root_value, asset_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?
+    $1, $2, $3, $4,
+    $5, $6, $7,
+    $8, $9, $10
 )
 `
 
 type InsertAssetDeltaParams struct {
 	OldScriptKey             []byte
+	OldAmt                   sql.NullInt64
 	NewAmt                   int64
 	NewScriptKey             int32
 	SerializedWitnesses      []byte
@@ -245,11 +336,13 @@ type InsertAssetDeltaParams struct {
 	ProofID                  int32
 	SplitCommitmentRootHash  []byte
 	SplitCommitmentRootValue sql.NullInt64
+	AssetID                  sql.NullInt32
 }
 
 func (q *Queries) InsertAssetDelta(ctx context.Context, arg InsertAssetDeltaParams) error {
 	_, err := q.db.ExecContext(ctx, insertAssetDelta,
 		arg.OldScriptKey,
+		arg.OldAmt,
 		arg.NewAmt,
 		arg.NewScriptKey,
 		arg.SerializedWitnesses,
@@ -257,6 +350,7 @@ func (q *Queries) InsertAssetDelta(ctx context.Context, arg InsertAssetDeltaPara
 		arg.ProofID,
 		arg.SplitCommitmentRootHash,
 		arg.SplitCommitmentRootValue,
+		arg.AssetID,
 	)
 	return err
 }
@@ -309,22 +403,84 @@ func (q *Queries) InsertSpendProofs(ctx context.Context, arg InsertSpendProofsPa
 	return proof_id, err
 }
 
+const queryAssetBurns = `-- name: QueryAssetBurns :many
+SELECT
+    burns.asset_id, burns.tweaked_fam_key, burns.amount,
+    burns.burn_script_key, txns.txid AS anchor_txid,
+    txns.block_hash AS anchor_block_hash, transfers.transfer_time_unix
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE (
+    length(hex($1)) == 0 OR
+        burns.asset_id = $1
+)
+ORDER BY transfers.transfer_time_unix
+`
+
+type QueryAssetBurnsRow struct {
+	AssetID          []byte
+	TweakedFamKey    []byte
+	Amount           int64
+	BurnScriptKey    []byte
+	AnchorTxid       []byte
+	AnchorBlockHash  []byte
+	TransferTimeUnix time.Time
+}
+
+func (q *Queries) QueryAssetBurns(ctx context.Context, assetIDFilter interface{}) ([]QueryAssetBurnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetBurns, assetIDFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetBurnsRow
+	for rows.Next() {
+		var i QueryAssetBurnsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.TweakedFamKey,
+			&i.Amount,
+			&i.BurnScriptKey,
+			&i.AnchorTxid,
+			&i.AnchorBlockHash,
+			&i.TransferTimeUnix,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT 
     asset_transfers.old_anchor_point, utxos.outpoint AS new_anchor_point,
     utxos.taro_root, utxos.tapscript_sibling, 
     utxos.utxo_id AS new_anchor_utxo_id, txns.raw_tx AS anchor_tx_bytes, 
     txns.txid AS anchor_txid, txns.txn_id AS anchor_tx_primary_key, 
-    txns.chain_fees, transfer_time_unix, keys.raw_key AS internal_key_bytes,
-    keys.key_family AS internal_key_fam, keys.key_index AS internal_key_index,
-    id AS transfer_id, transfer_time_unix
+    txns.chain_fees, txns.block_height AS anchor_tx_block_height,
+    txns.block_hash AS anchor_tx_block_hash, transfer_time_unix,
+    keys.raw_key AS internal_key_bytes, keys.key_family AS internal_key_fam,
+    keys.key_index AS internal_key_index, id AS transfer_id,
+    transfer_time_unix
 FROM asset_transfers
 JOIN internal_keys keys
     ON asset_transfers.new_internal_key = keys.key_id
 JOIN managed_utxos utxos
     ON asset_transfers.new_anchor_utxo = utxos.utxo_id
 JOIN chain_txns txns
-    ON utxos.utxo_id = txns.txn_id
+    ON utxos.txn_id = txns.txn_id
 WHERE (
     -- We'll use this clause to filter out for only transfers that are
     -- unconfirmed. But only if the unconf_only field is set.
@@ -339,34 +495,45 @@ WHERE (
     -- based on the new_anchor_point, but only if it's specified.
     (length(hex($2)) == 0 OR 
         utxos.outpoint = $2)
+
+    AND
+
+    -- This optional clause selects only transfers whose anchor transaction
+    -- was confirmed at or above the given block height.
+    (($3 == 0 OR $3 IS NULL)
+        OR
+    (txns.block_height >= $3))
 )
 `
 
 type QueryAssetTransfersParams struct {
 	UnconfOnly     interface{}
 	NewAnchorPoint interface{}
+	MinBlockHeight interface{}
 }
 
 type QueryAssetTransfersRow struct {
-	OldAnchorPoint     []byte
-	NewAnchorPoint     []byte
-	TaroRoot           []byte
-	TapscriptSibling   []byte
-	NewAnchorUtxoID    int32
-	AnchorTxBytes      []byte
-	AnchorTxid         []byte
-	AnchorTxPrimaryKey int32
-	ChainFees          int64
-	TransferTimeUnix   time.Time
-	InternalKeyBytes   []byte
-	InternalKeyFam     int32
-	InternalKeyIndex   int32
-	TransferID         int32
-	TransferTimeUnix_2 time.Time
+	OldAnchorPoint      []byte
+	NewAnchorPoint      []byte
+	TaroRoot            []byte
+	TapscriptSibling    []byte
+	NewAnchorUtxoID     int32
+	AnchorTxBytes       []byte
+	AnchorTxid          []byte
+	AnchorTxPrimaryKey  int32
+	ChainFees           int64
+	AnchorTxBlockHeight sql.NullInt32
+	AnchorTxBlockHash   []byte
+	TransferTimeUnix    time.Time
+	InternalKeyBytes    []byte
+	InternalKeyFam      int32
+	InternalKeyIndex    int32
+	TransferID          int32
+	TransferTimeUnix_2  time.Time
 }
 
 func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error) {
-	rows, err := q.db.QueryContext(ctx, queryAssetTransfers, arg.UnconfOnly, arg.NewAnchorPoint)
+	rows, err := q.db.QueryContext(ctx, queryAssetTransfers, arg.UnconfOnly, arg.NewAnchorPoint, arg.MinBlockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -384,6 +551,8 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.AnchorTxid,
 			&i.AnchorTxPrimaryKey,
 			&i.ChainFees,
+			&i.AnchorTxBlockHeight,
+			&i.AnchorTxBlockHash,
 			&i.TransferTimeUnix,
 			&i.InternalKeyBytes,
 			&i.InternalKeyFam,
@@ -404,6 +573,213 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 	return items, nil
 }
 
+const querySpentAssets = `-- name: QuerySpentAssets :many
+SELECT
+    genesis_info_view.asset_id, genesis_info_view.asset_tag,
+    genesis_info_view.meta_data, genesis_info_view.asset_type,
+    genesis_info_view.output_index AS genesis_output_index,
+    genesis_info_view.prev_out AS genesis_prev_out,
+    key_fam_info_view.tweaked_fam_key,
+    deltas.old_script_key, deltas.old_amt, deltas.new_amt,
+    transfers.old_anchor_point, transfers.transfer_time_unix,
+    txns.txid AS spend_txid, txns.block_hash AS spend_block_hash
+FROM asset_deltas deltas
+JOIN asset_transfers transfers
+    ON deltas.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+LEFT JOIN assets
+    ON deltas.asset_id = assets.asset_id
+LEFT JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+WHERE (
+    (length(hex($1)) == 0 OR
+        genesis_info_view.asset_id = $1) AND
+    (length(hex($2)) == 0 OR
+        key_fam_info_view.tweaked_fam_key = $2) AND
+    (length(hex($3)) == 0 OR
+        transfers.old_anchor_point = $3) AND
+    -- Deltas without genesis information have no asset type, so they
+    -- only match if no type filter is set.
+    COALESCE(genesis_info_view.asset_type, -1) = COALESCE(
+        $4, genesis_info_view.asset_type, -1
+    ) AND
+    COALESCE(deltas.old_amt, 0) >= COALESCE($5, 0) AND
+    -- A spent asset is confirmed once the transfer that spent it is.
+    (($6 == 0 OR $6 IS NULL) OR
+        (($6 == 1) == (length(hex(txns.block_hash)) != 0)))
+)
+ORDER BY deltas.id
+LIMIT $8 OFFSET $7
+`
+
+type QuerySpentAssetsParams struct {
+	AssetIDFilter   interface{}
+	KeyFamFilter    interface{}
+	AnchorPoint     interface{}
+	AssetType       sql.NullInt16
+	MinAmt          sql.NullInt64
+	ConfirmedFilter interface{}
+	NumOffset       int32
+	NumLimit        int32
+}
+
+type QuerySpentAssetsRow struct {
+	AssetID            []byte
+	AssetTag           sql.NullString
+	MetaData           []byte
+	AssetType          sql.NullInt16
+	GenesisOutputIndex sql.NullInt32
+	GenesisPrevOut     []byte
+	TweakedFamKey      []byte
+	OldScriptKey       []byte
+	OldAmt             sql.NullInt64
+	NewAmt             int64
+	OldAnchorPoint     []byte
+	TransferTimeUnix   time.Time
+	SpendTxid          []byte
+	SpendBlockHash     []byte
+}
+
+// Every asset delta describes a prior state of an asset that was spent by an
+// outbound transfer. We join the delta with the asset it was applied to, to
+// obtain the genesis information of the spent asset. We use a LEFT JOIN here,
+// so deltas whose asset couldn't be recovered when migrating older transfers
+// are still returned, just without any genesis information.
+func (q *Queries) QuerySpentAssets(ctx context.Context, arg QuerySpentAssetsParams) ([]QuerySpentAssetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, querySpentAssets,
+		arg.AssetIDFilter,
+		arg.KeyFamFilter,
+		arg.AnchorPoint,
+		arg.AssetType,
+		arg.MinAmt,
+		arg.ConfirmedFilter,
+		arg.NumOffset,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QuerySpentAssetsRow
+	for rows.Next() {
+		var i QuerySpentAssetsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.AssetTag,
+			&i.MetaData,
+			&i.AssetType,
+			&i.GenesisOutputIndex,
+			&i.GenesisPrevOut,
+			&i.TweakedFamKey,
+			&i.OldScriptKey,
+			&i.OldAmt,
+			&i.NewAmt,
+			&i.OldAnchorPoint,
+			&i.TransferTimeUnix,
+			&i.SpendTxid,
+			&i.SpendBlockHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryTransferHistory = `-- name: QueryTransferHistory :many
+SELECT
+    genesis_info_view.asset_id, key_fam_info_view.tweaked_fam_key,
+    proofs.receiver_proof, burns.amount AS burn_amt,
+    transfers.transfer_time_unix, txns.txid AS anchor_txid,
+    txns.block_height AS anchor_block_height
+FROM asset_deltas deltas
+JOIN asset_transfers transfers
+    ON deltas.transfer_id = transfers.id
+JOIN transfer_proofs proofs
+    ON deltas.proof_id = proofs.proof_id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+LEFT JOIN assets
+    ON deltas.asset_id = assets.asset_id
+LEFT JOIN genesis_info_view
+    ON assets.genesis_id = genesis_info_view.gen_asset_id
+LEFT JOIN key_fam_info_view
+    ON assets.genesis_id = key_fam_info_view.gen_asset_id
+LEFT JOIN asset_burns burns
+    ON burns.transfer_id = transfers.id AND
+        burns.asset_id = genesis_info_view.asset_id
+WHERE (
+    (length(hex($1)) == 0 OR
+        genesis_info_view.asset_id = $1) AND
+    (length(hex($2)) == 0 OR
+        key_fam_info_view.tweaked_fam_key = $2)
+)
+ORDER BY transfers.transfer_time_unix, deltas.id
+`
+
+type QueryTransferHistoryParams struct {
+	AssetIDFilter interface{}
+	KeyFamFilter  interface{}
+}
+
+type QueryTransferHistoryRow struct {
+	AssetID           []byte
+	TweakedFamKey     []byte
+	ReceiverProof     []byte
+	BurnAmt           sql.NullInt64
+	TransferTimeUnix  time.Time
+	AnchorTxid        []byte
+	AnchorBlockHeight sql.NullInt32
+}
+
+// Every asset delta describes an asset that was spent by an outbound transfer.
+// The receiver proof of the delta holds the asset that was sent to the
+// receiver. If the transfer burned the asset, then the receiver is the burn
+// key.
+func (q *Queries) QueryTransferHistory(ctx context.Context, arg QueryTransferHistoryParams) ([]QueryTransferHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryTransferHistory, arg.AssetIDFilter, arg.KeyFamFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryTransferHistoryRow
+	for rows.Next() {
+		var i QueryTransferHistoryRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.TweakedFamKey,
+			&i.ReceiverProof,
+			&i.BurnAmt,
+			&i.TransferTimeUnix,
+			&i.AnchorTxid,
+			&i.AnchorBlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const reanchorAssets = `-- name: ReanchorAssets :exec
 WITH assets_to_update AS (
     SELECT asset_id
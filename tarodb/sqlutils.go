@@ -30,6 +30,22 @@ func extractSqlInt32[T constraints.Integer](num sql.NullInt32) T {
 	return T(num.Int32)
 }
 
+// sqlInt64 turns a numerical integer type into the NullInt64 that sql/sqlc
+// uses when an integer field can be permitted to be NULL.
+func sqlInt64[T constraints.Integer](num T) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(num),
+		Valid: true,
+	}
+}
+
+// extractSqlInt64 turns a NullInt64 into a numerical type. This can be useful
+// when reading directly from the database, as this function handles extracting
+// the inner value from the "option"-like struct.
+func extractSqlInt64[T constraints.Integer](num sql.NullInt64) T {
+	return T(num.Int64)
+}
+
 // sqlInt16 turns a numerical integer type into the NullInt16 that sql/sqlc
 // uses when an integer field can be permitted to be NULL.
 //
@@ -52,6 +68,19 @@ func extractSqlInt16[T constraints.Integer](num sql.NullInt16) T {
 	return T(num.Int16)
 }
 
+// sqlStr turns a string into the NullString that sql/sqlc uses when a string
+// field can be permitted to be NULL. An empty string is mapped to NULL.
+func sqlStr(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: s,
+		Valid:  true,
+	}
+}
+
 // readOutPoint reads the next sequence of bytes from r as an OutPoint.
 //
 // NOTE: This function is intended to be used along with the wire.WriteOutPoint
@@ -2,12 +2,16 @@ package tarofreighter
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -21,6 +25,18 @@ import (
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// defaultCoinLeaseDuration is the duration the porter leases a
+	// selected input UTXO for while the send is being constructed.
+	defaultCoinLeaseDuration = 10 * time.Minute
+
+	// defaultBroadcastCoinLeaseDuration is the duration the porter leases
+	// an input UTXO for once the send was logged to disk. The lease is
+	// released once the send confirms, so this is effectively forever.
+	defaultBroadcastCoinLeaseDuration = 365 * 24 * time.Hour
 )
 
 // ChainPorterConfig is the main config for the chain porter.
@@ -43,6 +59,10 @@ type ChainPorterConfig struct {
 	// ChainBridge is our bridge to the chain we operate on.
 	ChainBridge ChainBridge
 
+	// ReorgWatcher is used to watch the confirmed transfer transactions
+	// for chain reorganizations until they're buried deep enough.
+	ReorgWatcher *tarogarden.ReorgWatcher
+
 	// Wallet is used to fund+sign PSBTs for the transfer transaction.
 	Wallet WalletAnchor
 
@@ -127,6 +147,38 @@ func (p *ChainPorter) Start() error {
 			go p.resumePendingParcel(parcel)
 		}
 
+		// The reorg watcher only keeps the transactions it watches in
+		// memory, so we need to watch the transfers that were
+		// confirmed before our last restart, but aren't buried deep
+		// enough yet, again.
+		unsafeHeight, err := p.cfg.ReorgWatcher.MinUnsafeHeight(ctx)
+		if err != nil {
+			startErr = err
+			return
+		}
+		confirmedParcels, err := p.cfg.ExportLog.ConfirmedParcels(
+			ctx, unsafeHeight,
+		)
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		log.Infof("Watching %v recently confirmed asset parcels for "+
+			"reorgs", len(confirmedParcels))
+
+		for _, parcel := range confirmedParcels {
+			conf := &chainntnfs.TxConfirmation{
+				BlockHash:   parcel.AnchorTxBlockHash,
+				BlockHeight: parcel.AnchorTxHeight,
+				Tx:          parcel.AnchorTx,
+			}
+			if err := p.watchForReorg(parcel, conf); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		p.Wg.Add(1)
 		go p.taroPorter()
 	})
@@ -169,6 +221,34 @@ func (p *ChainPorter) RequestShipment(req *AssetParcel) (*PendingParcel, error)
 	}
 }
 
+// EstimateSend runs the send state machine for the passed parcel up to the
+// point where the transfer transaction is funded, and returns the estimated
+// outputs and fees of the send. Nothing is committed to disk, no keys are
+// derived, and the wallet inputs used to fund the transfer transaction are
+// released again.
+func (p *ChainPorter) EstimateSend(req *AssetParcel) (*SendEstimate, error) {
+	req.errChan = make(chan error, 1)
+	req.estimateChan = make(chan *SendEstimate, 1)
+
+	log.Infof("New asset send estimate request to addr: %v",
+		spew.Sdump(req))
+
+	if !chanutils.SendOrQuit(p.exportReqs, req, p.Quit) {
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, fmt.Errorf("unable to estimate send: %w", err)
+
+	case estimate := <-req.estimateChan:
+		return estimate, nil
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+}
+
 // resumePendingParcel attempts to resume a pending parcel. A pending parcel
 // has already had its transfer transaction broadcast. In this state, we'll
 // rebroadcast and then wait for the transfer to confirm.
@@ -217,7 +297,27 @@ func (p *ChainPorter) taroPorter() {
 
 			// Initialize a package with the destination address.
 			sendPkg := sendPackage{
-				ReceiverAddr: req.Dest,
+				ReceiverAddr:       req.Dest,
+				Burn:               req.Burn,
+				InputAnchorPoints:  req.InputAnchorPoints,
+				CoinSelectStrategy: req.CoinSelectStrategy,
+				DryRun:             req.estimateChan != nil,
+			}
+
+			// In a dry run, the state machine loops back to the
+			// funding state once the transfer transaction is
+			// funded, which terminates it.
+			if sendPkg.DryRun {
+				estimatedPkg, err := p.advanceStateUntil(
+					&sendPkg, SendStatePsbtFund,
+				)
+				if err != nil {
+					req.errChan <- err
+					continue
+				}
+
+				req.estimateChan <- estimatedPkg.estimate()
+				continue
 			}
 
 			// Advance the state machine for this package until we
@@ -311,25 +411,79 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		return
 	}
 
+	ctx, cancel = p.CtxBlocking()
+	defer cancel()
+	if err := p.confirmParcel(ctx, pkg, confEvent); err != nil {
+		p.cfg.ErrChan <- mkErr("unable to confirm parcel: %w", err)
+		return
+	}
+
+	// The confirmation is only final once the transfer transaction is
+	// buried deep enough.
+	if err := p.watchForReorg(pkg, confEvent); err != nil {
+		p.cfg.ErrChan <- mkErr("unable to watch for reorgs: %w", err)
+	}
+}
+
+// watchForReorg hands the confirmed transfer transaction of the parcel over to
+// the reorg watcher. If it's reorged out, the parcel is marked as pending
+// until the transaction confirms again, at which point we re-create the proofs
+// with the new block.
+//
+// NOTE: The spend of the parcel stays applied while the transfer transaction
+// is out of the chain, so the passive and change assets keep their new anchor
+// point. Only the proofs need to change once it's mined in a new block.
+func (p *ChainPorter) watchForReorg(pkg *OutboundParcelDelta,
+	confEvent *chainntnfs.TxConfirmation) error {
+
+	txHash := pkg.AnchorTx.TxHash()
+
+	return p.cfg.ReorgWatcher.WatchTx(&tarogarden.WatchedTx{
+		Tx:   pkg.AnchorTx,
+		Conf: confEvent,
+		OnReorg: func(ctx context.Context) error {
+			log.Infof("Transfer tx %v reorged out", txHash)
+
+			return p.cfg.ExportLog.ReorgParcelDelivery(
+				ctx, pkg.NewAnchorPoint,
+			)
+		},
+		OnConf: func(ctx context.Context,
+			conf *chainntnfs.TxConfirmation) error {
+
+			log.Infof("Transfer tx %v re-confirmed at height %d",
+				txHash, conf.BlockHeight)
+
+			return p.confirmParcel(ctx, pkg, conf)
+		},
+	})
+}
+
+// confirmParcel creates the final sender and receiver proofs of the parcel for
+// the given confirmation of its transfer transaction, stores them, delivers
+// the receiver proof and marks the parcel as confirmed on disk. This is also
+// used to re-create the proofs once the transfer transaction was re-confirmed
+// in a different block after a reorg.
+func (p *ChainPorter) confirmParcel(ctx context.Context,
+	pkg *OutboundParcelDelta, confEvent *chainntnfs.TxConfirmation) error {
+
+	txHash := pkg.AnchorTx.TxHash()
+
 	// Now we'll enter the final phase of the send process, where we'll
 	// write the receiver's proof file to disk.
 	//
 	// First, we'll fetch the sender's current proof file.
-	ctx, cancel = p.CtxBlocking()
-	defer cancel()
 	senderFullProofBytes, err := p.cfg.AssetProofs.FetchProof(ctx, proof.Locator{
 		AssetID:   &pkg.AssetSpendDeltas[0].WitnessData[0].PrevID.ID,
 		ScriptKey: pkg.AssetSpendDeltas[0].OldScriptKey,
 	})
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error fetching proof: %v", err)
-		return
+		return fmt.Errorf("error fetching proof: %v", err)
 	}
 	senderProof := proof.NewEmptyFile(proof.V0)
 	err = senderProof.Decode(bytes.NewReader(senderFullProofBytes))
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error decoding proof: %v", err)
-		return
+		return fmt.Errorf("error decoding proof: %v", err)
 	}
 
 	// Now that we have the sender's proof file, we'll decode the new
@@ -339,8 +493,7 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		bytes.NewReader(pkg.AssetSpendDeltas[0].SenderAssetProof),
 	)
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error decoding proof suffix: %v", err)
-		return
+		return fmt.Errorf("error decoding proof suffix: %v", err)
 	}
 	err = senderProofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
 		Block:   confEvent.Block,
@@ -348,21 +501,18 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		TxIndex: int(confEvent.TxIndex),
 	})
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error updating sender transition "+
+		return fmt.Errorf("error updating sender transition "+
 			"proof: %v", err)
-		return
 	}
 
 	// With the proof suffix updated, we can append the proof, then encode
 	// it to get the final sender proof.
 	var updatedSenderProof bytes.Buffer
 	if err := senderProof.AppendProof(senderProofSuffix); err != nil {
-		p.cfg.ErrChan <- mkErr("error appending sender proof: %v", err)
-		return
+		return fmt.Errorf("error appending sender proof: %v", err)
 	}
 	if err := senderProof.Encode(&updatedSenderProof); err != nil {
-		p.cfg.ErrChan <- mkErr("error encoding sender proof: %v", err)
-		return
+		return fmt.Errorf("error encoding sender proof: %v", err)
 	}
 	newSenderProof := &proof.AnnotatedProof{
 		Locator: proof.Locator{
@@ -378,8 +528,7 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		bytes.NewReader(pkg.AssetSpendDeltas[0].ReceiverAssetProof),
 	)
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error decoding receiver proof: %v", err)
-		return
+		return fmt.Errorf("error decoding receiver proof: %v", err)
 	}
 	err = receiverProofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
 		Block:   confEvent.Block,
@@ -387,9 +536,8 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		TxIndex: int(confEvent.TxIndex),
 	})
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error updating receiver transition "+
+		return fmt.Errorf("error updating receiver transition "+
 			"proof: %v", err)
-		return
 	}
 
 	log.Infof("Importing receiver proof into local Proof Archive")
@@ -398,12 +546,10 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 	// archive.
 	var updatedReceiverProof bytes.Buffer
 	if err := senderProof.ReplaceLastProof(receiverProofSuffix); err != nil {
-		p.cfg.ErrChan <- mkErr("error replacing receiver proof: %v", err)
-		return
+		return fmt.Errorf("error replacing receiver proof: %v", err)
 	}
 	if err := senderProof.Encode(&updatedReceiverProof); err != nil {
-		p.cfg.ErrChan <- mkErr("error encoding receiver proof: %v", err)
-		return
+		return fmt.Errorf("error encoding receiver proof: %v", err)
 	}
 	receiverProof := &proof.AnnotatedProof{
 		Locator: proof.Locator{
@@ -416,18 +562,18 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		ctx, receiverProof, newSenderProof,
 	)
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("error importing proof: %v", err)
-		return
+		return fmt.Errorf("error importing proof: %v", err)
 	}
 
 	log.Debugf("Updated proofs for sender and receiver (new_len=%d)",
 		senderProof.NumProofs())
 
 	// If we have a proof courier instance active, then we'll launch a new
-	// goroutine to deliver the proof to the receiver.
+	// goroutine to deliver the proof to the receiver. Burns don't have a
+	// receiver, the burn proof is only kept in our local archive.
 	//
 	// TODO(roasbeef): move earlier?
-	if p.cfg.ProofCourier != nil {
+	if p.cfg.ProofCourier != nil && pkg.Burn == nil {
 		p.Wg.Add(1)
 		go func() {
 			defer p.Wg.Done()
@@ -462,11 +608,10 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		FinalSenderProof: updatedSenderProof.Bytes(),
 	})
 	if err != nil {
-		p.cfg.ErrChan <- mkErr("unable to log tx conf: %w", err)
-		return
+		return fmt.Errorf("unable to log tx conf: %w", err)
 	}
 
-	return
+	return nil
 }
 
 // advanceStateUntil will advance the state machine until the next state is the
@@ -485,6 +630,7 @@ func (p *ChainPorter) advanceStateUntil(currentPkg *sendPackage,
 		// aren't trying to shut down.
 		select {
 		case <-p.Quit:
+			p.releaseInputLease(currentPkg)
 			return nil, fmt.Errorf("porter shutting down")
 
 		default:
@@ -492,6 +638,7 @@ func (p *ChainPorter) advanceStateUntil(currentPkg *sendPackage,
 
 		updatedPkg, err := p.stateStep(*currentPkg)
 		if err != nil {
+			p.releaseInputLease(currentPkg)
 			return nil, err
 		}
 
@@ -508,6 +655,131 @@ func (p *ChainPorter) advanceStateUntil(currentPkg *sendPackage,
 	return currentPkg, nil
 }
 
+// selectInput selects the preferred asset input that satisfies the passed
+// constraints. We only need a single input as we don't merge inputs yet. Unless
+// this is a dry run, the input is leased under a new random lease owner in the
+// same step, so it can't be selected by any other send until this one either
+// completes or is abandoned.
+func (p *ChainPorter) selectInput(ctx context.Context, pkg *sendPackage,
+	constraints CommitmentConstraints) (*AnchoredCommitment, error) {
+
+	// A dry run doesn't lease its input, as it never spends it. The
+	// commitments are already ordered by the coin selection strategy, so
+	// the first one is the preferred one.
+	if pkg.DryRun {
+		commitments, err := p.cfg.CoinSelector.SelectCommitment(
+			ctx, constraints,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return commitments[0], nil
+	}
+
+	if _, err := rand.Read(pkg.LeaseOwner[:]); err != nil {
+		return nil, fmt.Errorf("unable to generate lease owner: %w",
+			err)
+	}
+
+	return p.cfg.CoinSelector.LeaseCommitment(
+		ctx, constraints, pkg.LeaseOwner,
+		time.Now().Add(defaultCoinLeaseDuration),
+	)
+}
+
+// importAnchorOutput imports the taproot output at the given index of the
+// anchor transaction into the wallet, so it watches it for spends and takes
+// account of the BTC it carries.
+func (p *ChainPorter) importAnchorOutput(ctx context.Context,
+	anchorTx *wire.MsgTx, outputIndex uint32) error {
+
+	if int(outputIndex) >= len(anchorTx.TxOut) {
+		return fmt.Errorf("anchor output index %d out of range",
+			outputIndex)
+	}
+
+	// We'll need to extract the output public key from the tx out that
+	// anchors the assets.
+	anchorOutput := anchorTx.TxOut[outputIndex]
+	_, witProgram, err := txscript.ExtractWitnessProgramInfo(
+		anchorOutput.PkScript,
+	)
+	if err != nil {
+		return err
+	}
+	anchorOutputKey, err := schnorr.ParsePubKey(witProgram)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.cfg.Wallet.ImportTaprootOutput(ctx, anchorOutputKey)
+	switch {
+	case err == nil:
+		return nil
+
+	// On restart, we'll get an error that the output has already been
+	// added to the wallet, so we'll catch this now and move along if so.
+	case strings.Contains(err.Error(), "already exists"):
+		return nil
+
+	default:
+		return err
+	}
+}
+
+// deriveNextKey derives the next key within the Taro key family for the given
+// send. A dry run never spends anything to the keys it uses, so we don't want
+// to advance the key index of the wallet for it and use a throwaway key
+// instead.
+func (p *ChainPorter) deriveNextKey(ctx context.Context,
+	pkg *sendPackage) (keychain.KeyDescriptor, error) {
+
+	if !pkg.DryRun {
+		return p.cfg.KeyRing.DeriveNextKey(
+			ctx, tarogarden.TaroKeyFamily,
+		)
+	}
+
+	privKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey: privKey.PubKey(),
+		KeyLocator: keychain.KeyLocator{
+			Family: tarogarden.TaroKeyFamily,
+		},
+	}, nil
+}
+
+// releaseInputLease releases the lease on the input UTXO of a send that was
+// abandoned before it was logged to disk, so the input can be selected again.
+// Once a send is logged to disk, the lease is kept until the send confirms.
+func (p *ChainPorter) releaseInputLease(pkg *sendPackage) {
+	// A dry run never leases its input, so there's nothing to release.
+	switch {
+	case pkg.DryRun || pkg.InputAsset == nil:
+		return
+
+	case pkg.SendState > SendStateLogCommit:
+		return
+	}
+
+	// We might be shutting down, so we don't use the quit channel here,
+	// otherwise we'd never be able to release the lease.
+	ctx, cancel := p.CtxBlocking()
+	defer cancel()
+
+	anchorPoint := pkg.InputAsset.AnchorPoint
+	err := p.cfg.CoinSelector.ReleaseCoins(ctx, anchorPoint)
+	if err != nil {
+		log.Errorf("Unable to release lease on input %v: %v",
+			anchorPoint, err)
+	}
+}
+
 // createDummyOutput creates a new Bitcoin transaction output that is later
 // used to embed a Taro commitment.
 func createDummyOutput() *wire.TxOut {
@@ -580,26 +852,22 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// enough to send
 		assetID := currentPkg.ReceiverAddr.ID()
 		constraints := CommitmentConstraints{
-			FamilyKey: currentPkg.ReceiverAddr.FamilyKey,
-			AssetID:   &assetID,
-			MinAmt:    currentPkg.ReceiverAddr.Amount,
+			FamilyKey:    currentPkg.ReceiverAddr.FamilyKey,
+			AssetID:      &assetID,
+			MinAmt:       currentPkg.ReceiverAddr.Amount,
+			AnchorPoints: currentPkg.InputAnchorPoints,
+			Strategy:     currentPkg.CoinSelectStrategy,
 		}
-		elgigibleCommitments, err := p.cfg.CoinSelector.SelectCommitment(
-			ctx, constraints,
-		)
+		assetInput, err := p.selectInput(ctx, &currentPkg, constraints)
 		if err != nil {
 			return nil, fmt.Errorf("unable to complete coin "+
 				"selection: %w", err)
 		}
 
-		log.Infof("Selected %v possible asset inputs for send to %x",
-			len(elgigibleCommitments),
+		log.Infof("Selected asset input %v for send to %x",
+			assetInput.AnchorPoint,
 			currentPkg.ReceiverAddr.ScriptKey.SerializeCompressed())
 
-		// We'll take just the first commitment here as we need enough
-		// to complete the send w/o merging inputs.
-		assetInput := elgigibleCommitments[0]
-
 		// If the key found for the input UTXO is not from the Taro
 		// keyfamily, something has gone wrong with the DB.
 		if assetInput.InternalKey.Family != tarogarden.TaroKeyFamily {
@@ -624,6 +892,29 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 		currentPkg.InputAsset = assetInput
 
+		// If we're burning the assets, we can only now derive the burn
+		// key, as it commits to the input we just selected. The burn
+		// output itself is anchored with one of our own internal keys.
+		if currentPkg.Burn {
+			burnInternalKey, err := p.deriveNextKey(
+				ctx, &currentPkg,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			burnKey := asset.DeriveBurnKey(currentPkg.InputAssetPrevID)
+
+			burnAddr := currentPkg.ReceiverAddr.Copy()
+			burnAddr.ScriptKey = *burnKey
+			burnAddr.InternalKey = *burnInternalKey.PubKey
+			currentPkg.ReceiverAddr = burnAddr
+
+			log.Infof("Burning %v units of asset %x using burn "+
+				"key %x", burnAddr.Amount, assetID[:],
+				burnKey.SerializeCompressed())
+		}
+
 		currentPkg.SendState = SendStateValidatedInput
 
 		return &currentPkg, nil
@@ -655,8 +946,8 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		//
 		// TODO(jhb): ScriptKey derivation instructions should be
 		// specified in the AssetParcel
-		currentPkg.SenderNewInternalKey, err = p.cfg.KeyRing.DeriveNextKey(
-			ctx, tarogarden.TaroKeyFamily,
+		currentPkg.SenderNewInternalKey, err = p.deriveNextKey(
+			ctx, &currentPkg,
 		)
 		if err != nil {
 			return nil, err
@@ -665,11 +956,12 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// If we are sending the full value of the input asset, or
 		// sending a collectible, we will need to create a split with
 		// unspendable change.
+		currentPkg.NeedsSplit = !fullValue
 		if fullValue {
 			currentPkg.SenderScriptKey = asset.NUMSScriptKey
 		} else {
-			senderScriptKey, err := p.cfg.KeyRing.DeriveNextKey(
-				ctx, tarogarden.TaroKeyFamily,
+			senderScriptKey, err := p.deriveNextKey(
+				ctx, &currentPkg,
 			)
 			if err != nil {
 				return nil, err
@@ -788,6 +1080,34 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to fund psbt: %w", err)
 		}
 
+		// For a dry run, we only wanted to know the fee of the
+		// transfer, so we'll release the wallet inputs again and
+		// remain in this state, which terminates the state machine.
+		if currentPkg.DryRun {
+			for _, lockedUTXO := range fundedSendPacket.LockedUTXOs {
+				err := p.cfg.Wallet.UnlockInput(ctx, lockedUTXO)
+				if err != nil {
+					return nil, fmt.Errorf("unable to "+
+						"unlock input %v: %w",
+						lockedUTXO, err)
+				}
+			}
+
+			chainFees, err := tarogarden.GetTxFee(
+				fundedSendPacket.Pkt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get "+
+					"on-chain fees for psbt: %w", err)
+			}
+
+			currentPkg.SendPkt = fundedSendPacket.Pkt
+			currentPkg.TargetFeeRate = feeRate
+			currentPkg.EstimatedFees = btcutil.Amount(chainFees)
+
+			return &currentPkg, nil
+		}
+
 		// TODO(roasbeef): also want to log the total fee to disk for
 		// accounting, etc.
 
@@ -946,6 +1266,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			AssetSpendDeltas: []AssetSpendDelta{
 				{
 					OldScriptKey:        *currentPkg.InputAsset.Asset.ScriptKey.PubKey,
+					OldAmt:              currentPkg.InputAsset.Asset.Amount,
 					NewAmt:              newAsset.Amount,
 					NewScriptKey:        currentPkg.SenderScriptKey,
 					WitnessData:         newAsset.PrevWitnesses,
@@ -960,6 +1281,17 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			ChainFees:    chainFees,
 		}
 
+		// If this send is a burn, we'll also record the burned units,
+		// so they can be accounted for.
+		if currentPkg.Burn {
+			currentPkg.OutboundPkg.Burn = &AssetBurn{
+				AssetID:   currentPkg.InputAssetPrevID.ID,
+				FamilyKey: currentPkg.ReceiverAddr.FamilyKey,
+				Amount:    currentPkg.ReceiverAddr.Amount,
+				ScriptKey: &currentPkg.ReceiverAddr.ScriptKey,
+			}
+		}
+
 		// Don't allow shutdown while we're attempting to store proofs.
 		ctx, cancel := p.CtxBlocking()
 		defer cancel()
@@ -967,7 +1299,8 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		log.Infof("Committing pending parcel to disk")
 
 		err = p.cfg.ExportLog.LogPendingParcel(
-			ctx, currentPkg.OutboundPkg,
+			ctx, currentPkg.OutboundPkg, currentPkg.LeaseOwner,
+			time.Now().Add(defaultBroadcastCoinLeaseDuration),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to write send pkg to "+
@@ -986,41 +1319,41 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		ctx, cancel := p.WithCtxQuitNoTimeout()
 		defer cancel()
 
-		// We'll need to extract the output public key from the tx out
-		// that does the send. We'll use this shortly below as a step
-		// before broadcast.
+		// Before we broadcast the transaction to the network, we'll
+		// import the new anchor output into the wallet so it watches
+		// it for spends and also takes account of the BTC we used in
+		// the transfer.
 		//
 		// TODO(roasbeef): cache before?
+		anchorTx := currentPkg.OutboundPkg.AnchorTx
 		anchorIndex := currentPkg.OutboundPkg.NewAnchorPoint.Index
-		anchorOutput := currentPkg.OutboundPkg.AnchorTx.TxOut[anchorIndex]
-		_, witProgram, err := txscript.ExtractWitnessProgramInfo(
-			anchorOutput.PkScript,
-		)
-		if err != nil {
-			return nil, err
-		}
-		anchorOutputKey, err := schnorr.ParsePubKey(witProgram)
+		err := p.importAnchorOutput(ctx, anchorTx, anchorIndex)
 		if err != nil {
 			return nil, err
 		}
+		anchorOutput := anchorTx.TxOut[anchorIndex]
+
+		// The output that anchors burned assets is also anchored with
+		// one of our internal keys, so we'll import it as well to not
+		// lose track of the BTC it carries. Its index isn't part of
+		// the parcel on restart, so we'll get it from the burn proof.
+		if currentPkg.OutboundPkg.Burn != nil {
+			spendDelta := currentPkg.OutboundPkg.AssetSpendDeltas[0]
+
+			var burnProof proof.Proof
+			err := burnProof.Decode(bytes.NewReader(
+				spendDelta.ReceiverAssetProof,
+			))
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode burn "+
+					"proof: %w", err)
+			}
 
-		// Before we broadcast the transaction to the network, we'll
-		// import the new anchor output into the wallet so it watches
-		// it for spends and also takes account of the BTC we used in
-		// the transfer.
-		_, err = p.cfg.Wallet.ImportTaprootOutput(ctx, anchorOutputKey)
-		switch {
-		case err == nil:
-			break
-
-		// On restart, we'll get an error that the output has already
-		// been added to the wallet, so we'll catch this now and move
-		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
-			break
-
-		case err != nil:
-			return nil, err
+			burnIndex := burnProof.InclusionProof.OutputIndex
+			err = p.importAnchorOutput(ctx, anchorTx, burnIndex)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		log.Infof("Broadcasting new transfer tx, taro_anchor_output=%v",
@@ -1,9 +1,193 @@
 package tarofreighter
 
 import (
+	"context"
 	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/address"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
 )
 
+var testTimeout = 5 * time.Second
+
 func TestRunChainPorter(t *testing.T) {
 	t.Parallel()
 }
+
+// mockCoinSelector is a CommitmentSelector that always selects the same
+// commitment.
+type mockCoinSelector struct {
+	commitment *AnchoredCommitment
+
+	numLeases int
+}
+
+// SelectCommitment returns the commitment of the mock.
+func (m *mockCoinSelector) SelectCommitment(context.Context,
+	CommitmentConstraints) ([]*AnchoredCommitment, error) {
+
+	return []*AnchoredCommitment{m.commitment}, nil
+}
+
+// LeaseCommitment returns the commitment of the mock and counts the lease.
+func (m *mockCoinSelector) LeaseCommitment(context.Context,
+	CommitmentConstraints, [32]byte, time.Time) (*AnchoredCommitment,
+	error) {
+
+	m.numLeases++
+
+	return m.commitment, nil
+}
+
+// ReleaseCoins is a no-op.
+func (m *mockCoinSelector) ReleaseCoins(context.Context,
+	...wire.OutPoint) error {
+
+	return nil
+}
+
+// mockWalletAnchor adds PSBT signing to the mock wallet of the tarogarden
+// package.
+type mockWalletAnchor struct {
+	*tarogarden.MockWalletAnchor
+}
+
+// SignPsbt returns the passed packet unchanged.
+func (m *mockWalletAnchor) SignPsbt(_ context.Context,
+	packet *psbt.Packet) (*psbt.Packet, error) {
+
+	return packet, nil
+}
+
+// mockTxValidator accepts any virtual transaction.
+type mockTxValidator struct{}
+
+// Execute accepts the virtual transaction.
+func (m *mockTxValidator) Execute(*asset.Asset, *commitment.SplitAsset,
+	commitment.InputSet) error {
+
+	return nil
+}
+
+// TestEstimateSend tests that a dry run of a send goes through the main porter
+// goroutine and returns the outputs and fees of the send, without leasing the
+// input or deriving any keys from the wallet.
+func TestEstimateSend(t *testing.T) {
+	t.Parallel()
+
+	// We'll create an input asset of 100 units that's anchored at a
+	// random outpoint.
+	inputPrivKey := test.RandPrivKey(t)
+	inputScriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: inputPrivKey.PubKey(),
+	})
+	genesis := asset.RandGenesis(t, asset.Normal)
+	inputAsset, err := asset.New(genesis, 100, 0, 0, inputScriptKey, nil)
+	require.NoError(t, err)
+
+	assetCommitment, err := commitment.NewAssetCommitment(inputAsset)
+	require.NoError(t, err)
+	taroCommitment, err := commitment.NewTaroCommitment(assetCommitment)
+	require.NoError(t, err)
+
+	coinSelector := &mockCoinSelector{
+		commitment: &AnchoredCommitment{
+			AnchorPoint:       test.RandOp(t),
+			AnchorOutputValue: 1000,
+			InternalKey: keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+				KeyLocator: keychain.KeyLocator{
+					Family: tarogarden.TaroKeyFamily,
+				},
+			},
+			Asset:      inputAsset,
+			Commitment: taroCommitment,
+		},
+	}
+	chainBridge := tarogarden.NewMockChainBridge()
+	wallet := &mockWalletAnchor{
+		MockWalletAnchor: tarogarden.NewMockWalletAnchor(),
+	}
+	keyRing := tarogarden.NewMockKeyRing()
+
+	porter := NewChainPorter(&ChainPorterConfig{
+		CoinSelector: coinSelector,
+		Signer:       taroscript.NewMockSigner(inputPrivKey),
+		TxValidator:  &mockTxValidator{},
+		ChainBridge:  chainBridge,
+		Wallet:       wallet,
+		KeyRing:      keyRing,
+		ChainParams:  &address.RegressionNetTaro,
+	})
+
+	// We only need the main goroutine of the porter, which handles the
+	// estimate requests.
+	porter.Wg.Add(1)
+	go porter.taroPorter()
+	t.Cleanup(func() {
+		require.NoError(t, porter.Stop())
+	})
+
+	// We'll now estimate a send of 40 units to a new address.
+	addr, err := address.New(
+		genesis, nil, *test.RandPubKey(t), *test.RandPubKey(t), 40,
+		&address.RegressionNetTaro,
+	)
+	require.NoError(t, err)
+
+	type estimateResult struct {
+		estimate *SendEstimate
+		err      error
+	}
+	resultChan := make(chan estimateResult, 1)
+	go func() {
+		estimate, err := porter.EstimateSend(&AssetParcel{
+			Dest: addr,
+		})
+		resultChan <- estimateResult{estimate, err}
+	}()
+
+	_, err = chanutils.RecvOrTimeout(
+		chainBridge.FeeEstimateSignal, testTimeout,
+	)
+	require.NoError(t, err)
+	_, err = chanutils.RecvOrTimeout(wallet.FundPsbtSignal, testTimeout)
+	require.NoError(t, err)
+
+	result, err := chanutils.RecvOrTimeout(resultChan, testTimeout)
+	require.NoError(t, err)
+	require.NoError(t, result.err)
+
+	estimate := result.estimate
+	require.Equal(t, inputAsset.ID(), estimate.Input.PrevID.ID)
+	require.EqualValues(t, 100, estimate.Input.Amount)
+	require.True(t, estimate.NeedsSplit)
+	require.Len(t, estimate.Outputs, 2)
+	require.True(t, estimate.Outputs[0].IsChange)
+	require.EqualValues(t, 60, estimate.Outputs[0].Amount)
+	require.EqualValues(t, 40, estimate.Outputs[1].Amount)
+	require.Equal(
+		t, asset.ToSerialized(&addr.ScriptKey),
+		estimate.Outputs[1].ScriptKey,
+	)
+	require.NotEqual(
+		t, estimate.Outputs[0].AnchorOutputIndex,
+		estimate.Outputs[1].AnchorOutputIndex,
+	)
+	require.EqualValues(t, 253, estimate.FeeRate)
+
+	// The dry run must neither lease the input nor derive any keys from
+	// the wallet.
+	require.Zero(t, coinSelector.numLeases)
+	require.Zero(t, keyRing.KeyIndex)
+}
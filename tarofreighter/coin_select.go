@@ -0,0 +1,144 @@
+package tarofreighter
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CoinSelectStrategy is the strategy used to pick the asset commitment that is
+// spent as the input of a send, among all commitments that satisfy the
+// CommitmentConstraints of the send.
+type CoinSelectStrategy uint8
+
+const (
+	// CoinSelectDefault picks the first commitment that satisfies the
+	// constraints, in the order the assets were stored.
+	CoinSelectDefault CoinSelectStrategy = 0
+
+	// CoinSelectSmallestSufficient picks the commitment holding the
+	// smallest amount of the asset that is still enough to satisfy the
+	// constraints. This reduces the amount of change created by a send.
+	CoinSelectSmallestSufficient CoinSelectStrategy = 1
+
+	// CoinSelectLargestFirst picks the commitment holding the largest
+	// amount of the asset. This consolidates the asset into fewer UTXOs
+	// over time.
+	CoinSelectLargestFirst CoinSelectStrategy = 2
+
+	// CoinSelectOldestFirst picks the commitment that was confirmed the
+	// earliest. Unconfirmed commitments are picked last.
+	CoinSelectOldestFirst CoinSelectStrategy = 3
+
+	// CoinSelectPrivacy picks the commitment that holds the fewest assets
+	// that are unrelated to the asset being sent, as spending a commitment
+	// reveals all the assets co-located in it to the receiver.
+	CoinSelectPrivacy CoinSelectStrategy = 4
+
+	// CoinSelectMinimizeSplit picks a commitment holding the exact amount
+	// being sent if there is one, which means no split is required. If
+	// there isn't, it falls back to CoinSelectSmallestSufficient.
+	CoinSelectMinimizeSplit CoinSelectStrategy = 5
+)
+
+// String returns a human readable string for the coin selection strategy.
+func (s CoinSelectStrategy) String() string {
+	switch s {
+	case CoinSelectDefault:
+		return "default"
+	case CoinSelectSmallestSufficient:
+		return "smallest_sufficient"
+	case CoinSelectLargestFirst:
+		return "largest_first"
+	case CoinSelectOldestFirst:
+		return "oldest_first"
+	case CoinSelectPrivacy:
+		return "privacy"
+	case CoinSelectMinimizeSplit:
+		return "minimize_split"
+	default:
+		return fmt.Sprintf("<unknown strategy %d>", uint8(s))
+	}
+}
+
+// unrelatedAssets returns the number of assets in the commitment that don't
+// share the asset ID of the asset that ratifies the constraints.
+func unrelatedAssets(c *AnchoredCommitment) int {
+	if c.Commitment == nil {
+		return 0
+	}
+
+	assetID := c.Asset.ID()
+
+	var numUnrelated int
+	for _, a := range c.Commitment.CommittedAssets() {
+		if a.ID() != assetID {
+			numUnrelated++
+		}
+	}
+
+	return numUnrelated
+}
+
+// OrderCommitments sorts the passed commitments in place, such that the
+// commitment that should be preferred according to the coin selection
+// strategy comes first. Commitments that are equal under the strategy keep
+// their original order.
+func OrderCommitments(commitments []*AnchoredCommitment,
+	strategy CoinSelectStrategy, amt uint64) error {
+
+	var less func(a, b *AnchoredCommitment) bool
+	switch strategy {
+	case CoinSelectDefault:
+		return nil
+
+	case CoinSelectSmallestSufficient:
+		less = func(a, b *AnchoredCommitment) bool {
+			return a.Asset.Amount < b.Asset.Amount
+		}
+
+	case CoinSelectLargestFirst:
+		less = func(a, b *AnchoredCommitment) bool {
+			return a.Asset.Amount > b.Asset.Amount
+		}
+
+	case CoinSelectOldestFirst:
+		less = func(a, b *AnchoredCommitment) bool {
+			// Unconfirmed commitments don't have a height yet, so
+			// they're always the youngest.
+			switch {
+			case a.AnchorHeight == 0:
+				return false
+			case b.AnchorHeight == 0:
+				return true
+			default:
+				return a.AnchorHeight < b.AnchorHeight
+			}
+		}
+
+	case CoinSelectPrivacy:
+		less = func(a, b *AnchoredCommitment) bool {
+			return unrelatedAssets(a) < unrelatedAssets(b)
+		}
+
+	case CoinSelectMinimizeSplit:
+		less = func(a, b *AnchoredCommitment) bool {
+			aExact := a.Asset.Amount == amt
+			bExact := b.Asset.Amount == amt
+			if aExact != bExact {
+				return aExact
+			}
+
+			return a.Asset.Amount < b.Asset.Amount
+		}
+
+	default:
+		return fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
+
+	sort.SliceStable(commitments, func(i, j int) bool {
+		return less(commitments[i], commitments[j])
+	})
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package tarofreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCommitment creates a new anchored commitment for an asset with the
+// given amount, confirmed at the given height, that is co-located with the
+// passed number of unrelated assets.
+func newTestCommitment(t *testing.T, amt uint64, height uint32,
+	numUnrelated int) *AnchoredCommitment {
+
+	newAsset := func(amt uint64) *asset.Asset {
+		a, err := asset.New(
+			asset.RandGenesis(t, asset.Normal), amt, 0, 0,
+			asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+			}), nil,
+		)
+		require.NoError(t, err)
+
+		return a
+	}
+
+	inputAsset := newAsset(amt)
+	inputCommitment, err := commitment.NewAssetCommitment(inputAsset)
+	require.NoError(t, err)
+
+	assetCommitments := []*commitment.AssetCommitment{inputCommitment}
+	for i := 0; i < numUnrelated; i++ {
+		assetCommitment, err := commitment.NewAssetCommitment(
+			newAsset(1),
+		)
+		require.NoError(t, err)
+
+		assetCommitments = append(assetCommitments, assetCommitment)
+	}
+
+	taroCommitment, err := commitment.NewTaroCommitment(
+		assetCommitments...,
+	)
+	require.NoError(t, err)
+
+	return &AnchoredCommitment{
+		AnchorPoint:  test.RandOp(t),
+		AnchorHeight: height,
+		Commitment:   taroCommitment,
+		Asset:        inputAsset,
+	}
+}
+
+// TestOrderCommitments tests that the commitments returned by coin selection
+// are ordered according to the selected coin selection strategy.
+func TestOrderCommitments(t *testing.T) {
+	t.Parallel()
+
+	// We'll create a set of commitments that each come out on top for a
+	// different strategy.
+	var (
+		first    = newTestCommitment(t, 20, 300, 1)
+		smallest = newTestCommitment(t, 15, 200, 1)
+		largest  = newTestCommitment(t, 100, 0, 2)
+		oldest   = newTestCommitment(t, 30, 100, 3)
+		private  = newTestCommitment(t, 40, 400, 0)
+		exact    = newTestCommitment(t, 25, 500, 1)
+	)
+	const sendAmt = 25
+
+	tests := []struct {
+		strategy CoinSelectStrategy
+		expected *AnchoredCommitment
+	}{
+		{
+			strategy: CoinSelectDefault,
+			expected: first,
+		},
+		{
+			strategy: CoinSelectSmallestSufficient,
+			expected: smallest,
+		},
+		{
+			strategy: CoinSelectLargestFirst,
+			expected: largest,
+		},
+		{
+			strategy: CoinSelectOldestFirst,
+			expected: oldest,
+		},
+		{
+			strategy: CoinSelectPrivacy,
+			expected: private,
+		},
+		{
+			strategy: CoinSelectMinimizeSplit,
+			expected: exact,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.strategy.String(), func(t *testing.T) {
+			commitments := []*AnchoredCommitment{
+				first, smallest, largest, oldest, private,
+				exact,
+			}
+			err := OrderCommitments(
+				commitments, testCase.strategy, sendAmt,
+			)
+			require.NoError(t, err)
+			require.Len(t, commitments, 6)
+			require.Equal(t, testCase.expected, commitments[0])
+		})
+	}
+
+	// An unknown strategy should be rejected.
+	err := OrderCommitments(nil, CoinSelectStrategy(99), sendAmt)
+	require.Error(t, err)
+}
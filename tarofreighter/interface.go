@@ -36,6 +36,14 @@ type CommitmentConstraints struct {
 	// MinAmt is the minimum amount that an asset commitment needs to hold
 	// to satisfy the constraints.
 	MinAmt uint64
+
+	// AnchorPoints is an optional set of anchor outpoints. If set, only
+	// commitments anchored at one of these outpoints can be selected.
+	AnchorPoints []wire.OutPoint
+
+	// Strategy is the coin selection strategy that determines the order
+	// in which the matching commitments are returned.
+	Strategy CoinSelectStrategy
 }
 
 // AnchoredCommitment is the response to satisfying the set of
@@ -49,6 +57,10 @@ type AnchoredCommitment struct {
 	// AnchorOutputValue is output value of the anchor output.
 	AnchorOutputValue btcutil.Amount
 
+	// AnchorHeight is the height of the block that confirmed the anchor
+	// output. This is zero if the anchor output isn't confirmed yet.
+	AnchorHeight uint32
+
 	// InternalKey is the internal key that's used to anchor the commitment
 	// in the above out point.
 	InternalKey keychain.KeyDescriptor
@@ -72,6 +84,11 @@ var (
 	// CommitmentSelector cannot satisfy the coin selection constraints.
 	ErrNoPossibleAssetInputs = fmt.Errorf("unable to satisfy coin " +
 		"selection constraints")
+
+	// ErrCoinAlreadyLeased is returned when a coin is leased that's
+	// already leased by a different lease owner.
+	ErrCoinAlreadyLeased = fmt.Errorf("coin already leased by a " +
+		"different owner")
 )
 
 // CommitmentSelector attracts over the coin selection process needed to be
@@ -79,12 +96,31 @@ var (
 type CommitmentSelector interface {
 	// SelectCommitment takes the set of commitment constraints and returns
 	// an AnchoredCommitment that returns all the information needed to use
-	// the commitment as an input to an on chain taro transaction.
+	// the commitment as an input to an on chain taro transaction. The
+	// commitments are ordered according to the coin selection strategy of
+	// the constraints, with the preferred commitment first.
 	//
 	// If coin selection cannot be completed, then ErrNoPossibleAssetInputs
 	// should be returned.
 	SelectCommitment(context.Context,
 		CommitmentConstraints) ([]*AnchoredCommitment, error)
+
+	// LeaseCommitment selects the preferred commitment that satisfies the
+	// constraints, just like SelectCommitment, and atomically leases the
+	// UTXO it's anchored at to the given lease owner until the expiry
+	// time. Commitments anchored at a leased UTXO aren't returned by
+	// either method, which prevents concurrent sends from selecting the
+	// same input.
+	//
+	// If coin selection cannot be completed, then ErrNoPossibleAssetInputs
+	// should be returned.
+	LeaseCommitment(ctx context.Context, constraints CommitmentConstraints,
+		leaseOwner [32]byte, expiry time.Time) (*AnchoredCommitment,
+		error)
+
+	// ReleaseCoins releases the leases on the managed UTXOs at the passed
+	// outpoints, which makes them available for coin selection again.
+	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
 }
 
 // AssetSpendDelta describes the mutation of an asset as part of an outbound
@@ -95,6 +131,9 @@ type AssetSpendDelta struct {
 	// spent asset on disk.
 	OldScriptKey btcec.PublicKey
 
+	// OldAmt is the amount of the asset before it was spent.
+	OldAmt uint64
+
 	// NewAmt is the new amount for the asset.
 	NewAmt uint64
 
@@ -120,6 +159,23 @@ type AssetSpendDelta struct {
 	ReceiverAssetProof []byte
 }
 
+// AssetBurn describes a number of asset units that were destroyed as part of
+// an outbound parcel, by sending them to a provably unspendable burn key.
+type AssetBurn struct {
+	// AssetID is the ID of the asset that was burned.
+	AssetID asset.ID
+
+	// FamilyKey is the optional family key of the asset that was burned.
+	FamilyKey *btcec.PublicKey
+
+	// Amount is the number of asset units that were burned.
+	Amount uint64
+
+	// ScriptKey is the burn key the units were sent to. This key commits
+	// to the asset input that was spent, see asset.DeriveBurnKey.
+	ScriptKey *btcec.PublicKey
+}
+
 // OutboundParcelDelta represents the database level delta of an outbound taro
 // parcel (outbound spend). A spend will destroy a series of assets at the old
 // anchor point, and re-create them at the new anchor point. Along the way some
@@ -160,6 +216,20 @@ type OutboundParcelDelta struct {
 	// ChainFees is the amount in sats paid in on-chain fees for the
 	// anchor transaction.
 	ChainFees int64
+
+	// Burn is set if the receiver of this parcel is a burn key, meaning
+	// the transferred units were destroyed.
+	Burn *AssetBurn
+
+	// AnchorTxBlockHash is the hash of the block the anchor transaction
+	// was confirmed in. This is nil if the transaction isn't confirmed
+	// yet.
+	AnchorTxBlockHash *chainhash.Hash
+
+	// AnchorTxHeight is the height of the block the anchor transaction
+	// was confirmed in. This is zero if the transaction isn't confirmed
+	// yet.
+	AnchorTxHeight uint32
 }
 
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
@@ -189,8 +259,11 @@ type AssetConfirmEvent struct {
 type ExportLog interface {
 	// LogPendingParcel marks an outbound parcel as pending on disk. This
 	// commits the set of changes to disk (the asset deltas) but doesn't
-	// mark the batched spend as being finalized.
-	LogPendingParcel(context.Context, *OutboundParcelDelta) error
+	// mark the batched spend as being finalized. The UTXO spent by the
+	// parcel is leased to the final lease owner until the final lease
+	// expiry, so it isn't selected again before the parcel is confirmed.
+	LogPendingParcel(ctx context.Context, parcel *OutboundParcelDelta,
+		finalLeaseOwner [32]byte, finalLeaseExpiry time.Time) error
 
 	// PendingParcels returns the set of parcels that haven't yet been
 	// finalized. This can be used to query the set of unconfirmed
@@ -199,8 +272,22 @@ type ExportLog interface {
 
 	// ConfirmParcelDelivery marks a spend event on disk as confirmed. This
 	// updates the on-chain reference information on disk to point to this
-	// new spend.
+	// new spend. If the parcel was already confirmed before, only the
+	// on-chain reference information and the final sender proof are
+	// updated.
 	ConfirmParcelDelivery(context.Context, *AssetConfirmEvent) error
+
+	// ConfirmedParcels returns the set of parcels whose transfer
+	// transaction was confirmed at or above the given block height.
+	ConfirmedParcels(ctx context.Context,
+		minHeight uint32) ([]*OutboundParcelDelta, error)
+
+	// ReorgParcelDelivery marks a confirmed parcel as pending again, after
+	// the block that confirmed its transfer transaction was disconnected
+	// from the main chain. The parcel is confirmed again with
+	// ConfirmParcelDelivery once the transaction is re-confirmed.
+	ReorgParcelDelivery(ctx context.Context,
+		anchorPoint wire.OutPoint) error
 }
 
 // ChainBridge aliases into the ChainBridge of the tarogarden package.
@@ -229,6 +316,11 @@ type Porter interface {
 	// returned with the pending transfer information.
 	RequestShipment(req *AssetParcel) (*PendingParcel, error)
 
+	// EstimateSend does a dry run of a send, which returns the selected
+	// input, the outputs and the estimated fees of the send without
+	// committing to it.
+	EstimateSend(req *AssetParcel) (*SendEstimate, error)
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -131,9 +131,29 @@ type AssetParcel struct {
 	// Dest is the address that should be used to satisfy the transfer.
 	Dest *address.Taro
 
+	// Burn indicates that the assets should be destroyed instead of sent
+	// to the script key of the Dest address. In that case, only the
+	// genesis, family key, amount and chain params of the Dest address
+	// need to be set. The assets are then sent to a provably unspendable
+	// burn key that commits to the asset input selected for the transfer.
+	Burn bool
+
+	// InputAnchorPoints is an optional set of anchor outpoints. If set,
+	// the asset input of the transfer is only selected from commitments
+	// anchored at these outpoints.
+	InputAnchorPoints []wire.OutPoint
+
+	// CoinSelectStrategy is the strategy used to select the asset input
+	// of the transfer.
+	CoinSelectStrategy CoinSelectStrategy
+
 	// respChan is the channel a response will be sent over.
 	respChan chan *PendingParcel
 
+	// estimateChan is the channel the estimate of a dry run of the send
+	// will be sent over. This is only set for a dry run.
+	estimateChan chan *SendEstimate
+
 	// errChan is the channel the error will be sent over.
 	errChan chan error
 }
@@ -187,6 +207,51 @@ type PendingParcel struct {
 	// TotalFees is the amount of on chain fees that the transfer
 	// transaction required.
 	TotalFees btcutil.Amount
+
+	// BurnOutput is the asset output that holds the burned units. This is
+	// only set if the parcel burned assets, in which case it's also part
+	// of AssetOutputs.
+	BurnOutput *AssetOutput
+}
+
+// EstimatedOutput is an asset output that would be created by a send.
+type EstimatedOutput struct {
+	// AnchorOutputIndex is the index of the output of the transfer
+	// transaction that would anchor the asset.
+	AnchorOutputIndex uint32
+
+	// ID is the asset ID of the output.
+	ID asset.ID
+
+	// ScriptKey is the script key of the output.
+	ScriptKey asset.SerializedKey
+
+	// Amount is the amount of the asset in the output.
+	Amount uint64
+
+	// IsChange is true if the output holds the change of the sender.
+	IsChange bool
+}
+
+// SendEstimate is the result of a dry run of a send. It describes the
+// transfer that would be created by the send, without committing to it.
+type SendEstimate struct {
+	// Input is the asset input that would be spent by the send.
+	Input AssetInput
+
+	// NeedsSplit is true if only a part of the input asset is sent, so
+	// the rest needs to be split off into a change output.
+	NeedsSplit bool
+
+	// Outputs is the set of asset outputs the send would create.
+	Outputs []EstimatedOutput
+
+	// FeeRate is the fee rate the transfer transaction was funded with.
+	FeeRate chainfee.SatPerKWeight
+
+	// TotalFees is the estimated on-chain fee of the transfer
+	// transaction.
+	TotalFees btcutil.Amount
 }
 
 // sendPackage houses the information we need to complete a package transfer.
@@ -217,6 +282,23 @@ type sendPackage struct {
 	// transfer.
 	ReceiverAddr *address.Taro
 
+	// Burn is true if the assets sent to the receiver address are being
+	// burned. The script key and internal key of the ReceiverAddr are
+	// only populated once the input to burn has been selected.
+	Burn bool
+
+	// InputAnchorPoints is the optional set of anchor outpoints the input
+	// asset must be selected from.
+	InputAnchorPoints []wire.OutPoint
+
+	// CoinSelectStrategy is the strategy used to select the input asset.
+	CoinSelectStrategy CoinSelectStrategy
+
+	// LeaseOwner is the random lease owner ID the input UTXO of this send
+	// is leased to. Each send uses its own lease owner, so it can never
+	// extend or take over the lease of another send.
+	LeaseOwner [32]byte
+
 	// SendDelta contains the information needed to craft a final transfer
 	// transaction.
 	SendDelta *taroscript.SpendDelta
@@ -238,6 +320,16 @@ type sendPackage struct {
 	// TargetFeeRate is the target fee rate for this send expressed in
 	// sat/kw.
 	TargetFeeRate chainfee.SatPerKWeight
+
+	// DryRun is true if the send should only be constructed up to the
+	// point where the transfer transaction is funded, to estimate its
+	// outputs and fees. A dry run never leases the asset input, releases
+	// the wallet inputs after funding and is never logged to disk.
+	DryRun bool
+
+	// EstimatedFees is the on-chain fee of the funded transfer
+	// transaction. This is only set for a dry run.
+	EstimatedFees btcutil.Amount
 }
 
 // inputAnchorPkScript returns the top-level Taproot output script of the input
@@ -572,6 +664,49 @@ func (s *sendPackage) createProofs() (spendProofs, error) {
 	}, nil
 }
 
+// estimate returns the estimate of a send package that was advanced up to the
+// point where its transfer transaction is funded.
+func (s *sendPackage) estimate() *SendEstimate {
+	senderCommitKey := asset.AssetCommitmentKey(
+		s.InputAssetPrevID.ID, s.SenderScriptKey.PubKey,
+		s.InputAsset.Asset.FamilyKey == nil,
+	)
+	receiverCommitKey := s.ReceiverAddr.AssetCommitmentKey()
+
+	locators := s.SendDelta.Locators
+	senderOutputIndex := locators[senderCommitKey].OutputIndex
+	receiverOutputIndex := locators[receiverCommitKey].OutputIndex
+
+	return &SendEstimate{
+		Input: AssetInput{
+			PrevID: s.InputAssetPrevID,
+			Amount: btcutil.Amount(s.InputAsset.Asset.Amount),
+		},
+		NeedsSplit: s.NeedsSplit,
+		Outputs: []EstimatedOutput{
+			{
+				AnchorOutputIndex: senderOutputIndex,
+				ID:                s.InputAssetPrevID.ID,
+				ScriptKey: asset.ToSerialized(
+					s.SenderScriptKey.PubKey,
+				),
+				Amount:   s.SendDelta.NewAsset.Amount,
+				IsChange: true,
+			},
+			{
+				AnchorOutputIndex: receiverOutputIndex,
+				ID:                s.ReceiverAddr.ID(),
+				ScriptKey: asset.ToSerialized(
+					&s.ReceiverAddr.ScriptKey,
+				),
+				Amount: s.ReceiverAddr.Amount,
+			},
+		},
+		FeeRate:   s.TargetFeeRate,
+		TotalFees: s.EstimatedFees,
+	}
+}
+
 // deliverResponse delivers a response for the parcel back to the receiver over
 // the specified response channel.
 func (s *sendPackage) deliverResponse(respChan chan<- *PendingParcel) {
@@ -585,7 +720,37 @@ func (s *sendPackage) deliverResponse(respChan chan<- *PendingParcel) {
 	receiverStateKey := s.ReceiverAddr.AssetCommitmentKey()
 	receiverIndex := s.SendDelta.Locators[receiverStateKey].OutputIndex
 
-	respChan <- &PendingParcel{
+	senderDelta := s.OutboundPkg.AssetSpendDeltas[0]
+	senderOutput := AssetOutput{
+		AssetInput: AssetInput{
+			PrevID: asset.PrevID{
+				OutPoint: s.OutboundPkg.NewAnchorPoint,
+				ID:       s.ReceiverAddr.ID(),
+				ScriptKey: asset.ToSerialized(
+					senderDelta.NewScriptKey.PubKey,
+				),
+			},
+			Amount: btcutil.Amount(senderDelta.NewAmt),
+		},
+	}
+	receiverOutPoint := wire.OutPoint{
+		Hash:  s.OutboundPkg.NewAnchorPoint.Hash,
+		Index: receiverIndex,
+	}
+	receiverOutput := AssetOutput{
+		AssetInput: AssetInput{
+			PrevID: asset.PrevID{
+				OutPoint: receiverOutPoint,
+				ID:       s.ReceiverAddr.ID(),
+				ScriptKey: asset.ToSerialized(
+					&s.ReceiverAddr.ScriptKey,
+				),
+			},
+			Amount: btcutil.Amount(s.ReceiverAddr.Amount),
+		},
+	}
+
+	parcel := &PendingParcel{
 		NewAnchorPoint: s.OutboundPkg.NewAnchorPoint,
 		TransferTx:     s.OutboundPkg.AnchorTx,
 		OldTaroRoot:    oldRoot[:],
@@ -598,39 +763,15 @@ func (s *sendPackage) deliverResponse(respChan chan<- *PendingParcel) {
 				),
 			},
 		},
-		AssetOutputs: []AssetOutput{
-			{
-				AssetInput: AssetInput{
-					PrevID: asset.PrevID{
-						OutPoint: s.OutboundPkg.NewAnchorPoint,
-						ID:       s.ReceiverAddr.ID(),
-						ScriptKey: asset.ToSerialized(
-							s.OutboundPkg.AssetSpendDeltas[0].NewScriptKey.PubKey,
-						),
-					},
-					Amount: btcutil.Amount(
-						s.OutboundPkg.AssetSpendDeltas[0].NewAmt,
-					),
-				},
-			},
-			{
-				AssetInput: AssetInput{
-					PrevID: asset.PrevID{
-						OutPoint: wire.OutPoint{
-							Hash:  s.OutboundPkg.NewAnchorPoint.Hash,
-							Index: receiverIndex,
-						},
-						ID: s.ReceiverAddr.ID(),
-						ScriptKey: asset.ToSerialized(
-							&s.ReceiverAddr.ScriptKey,
-						),
-					},
-					Amount: btcutil.Amount(
-						s.ReceiverAddr.Amount,
-					),
-				},
-			},
-		},
-		TotalFees: btcutil.Amount(s.OutboundPkg.ChainFees),
+		AssetOutputs: []AssetOutput{senderOutput, receiverOutput},
+		TotalFees:    btcutil.Amount(s.OutboundPkg.ChainFees),
 	}
+
+	// If the parcel burns assets, then the receiver output is the one
+	// that holds the burned units.
+	if s.Burn {
+		parcel.BurnOutput = &receiverOutput
+	}
+
+	respChan <- parcel
 }
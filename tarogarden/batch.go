@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // MintingBatch packages the pending state of a batch, this includes the batch
@@ -28,8 +30,9 @@ type MintingBatch struct {
 	// Seedlings is the set of seedlings for this batch. This maps an
 	// asset's name to the seedling itself.
 	//
-	// NOTE: This field is only set if the state is BatchStateFrozen or
-	// BatchStatePending.
+	// NOTE: This field is only set if the state is BatchStateFrozen,
+	// BatchStatePending, or BatchStateFinalized for batches read from
+	// disk.
 	Seedlings map[string]*Seedling
 
 	// GenesisPacket is the funded genesis packet that may or may not be
@@ -40,11 +43,17 @@ type MintingBatch struct {
 	// BatchStateCommitted.
 	GenesisPacket *FundedPsbt
 
+	// FeeRate is the fee rate the genesis transaction of this batch is
+	// funded with. If this is zero, the fee rate is estimated when the
+	// batch is funded.
+	FeeRate chainfee.SatPerKWeight
+
 	// RootAssetCommitment is the root Taro commitment for all the assets
 	// contained in this batch.
 	//
 	// NOTE: This field is only set if the state is beyond
-	// BatchStateCommitted.
+	// BatchStateCommitted, and not set for finalized batches read from
+	// disk.
 	RootAssetCommitment *commitment.TaroCommitment
 
 	// mintingPubKey is the top-level Taproot output key that will be
@@ -100,3 +109,20 @@ func (m *MintingBatch) genesisScript() ([]byte, error) {
 
 	return taroscript.PayToTaprootScript(mintingOutputKey)
 }
+
+// ConfirmedBatch is a finalized minting batch along with the location of its
+// confirmed minting transaction in the chain.
+type ConfirmedBatch struct {
+	*MintingBatch
+
+	// BlockHash is the hash of the block the minting transaction was
+	// confirmed in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the minting transaction was
+	// confirmed in.
+	BlockHeight uint32
+
+	// TxIndex is the index of the minting transaction within its block.
+	TxIndex uint32
+}
@@ -231,6 +231,14 @@ func (b *BatchCaretaker) taroCultivator() {
 				return
 			}
 
+			// The confirmation is only final once the minting
+			// transaction is buried deep enough, so we keep
+			// watching it for reorgs.
+			if err := b.watchForReorg(); err != nil {
+				log.Error(err)
+				return
+			}
+
 			// At this point we've advanced to the final state,
 			// which means we have a set of fully grown Taro
 			// assets! We'll report back to the planter out final
@@ -255,7 +263,10 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 		b.batchKey[:])
 
 	txTemplate := wire.NewMsgTx(2)
-	txTemplate.AddTxOut(&DummyGenesisTxOut)
+	txTemplate.AddTxOut(&wire.TxOut{
+		PkScript: GenesisDummyScript[:],
+		Value:    int64(GenesisAmtSats),
+	})
 	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
@@ -264,11 +275,18 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	log.Infof("BatchCaretaker(%x): creating skeleton PSBT: %v",
 		b.batchKey[:], spew.Sdump(genesisPkt))
 
-	feeRate, err := b.cfg.ChainBridge.EstimateFee(
-		ctx, GenesisConfTarget,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to estimate fee: %w", err)
+	// Unless the batch was finalized manually with a fee rate, we'll
+	// estimate the fee rate for the genesis transaction.
+	feeRate := b.cfg.Batch.FeeRate
+	if feeRate == 0 {
+		var err error
+		feeRate, err = b.cfg.ChainBridge.EstimateFee(
+			ctx, GenesisConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
 	}
 
 	fundedGenesisPkt, err := b.cfg.Wallet.FundPsbt(
@@ -322,10 +340,32 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		}
 
 		var familyKey *asset.FamilyKey
+		switch {
+		// If the asset is issued into an existing family, then we'll
+		// sign its genesis with the raw key of the family, tweaked by
+		// the genesis of the first asset of the family.
+		case seedling.FamilyInfo != nil:
+			famInfo := seedling.FamilyInfo
+			familyKey, err = asset.DeriveFamilyKey(
+				b.cfg.GenSigner, famInfo.RawKey,
+				famInfo.Genesis, &assetGen,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign genesis "+
+					"with family key: %w", err)
+			}
+
+			if !familyKey.FamKey.IsEqual(famInfo.FamKey) {
+				return nil, fmt.Errorf("derived family key %x "+
+					"doesn't match expected key %x",
+					familyKey.FamKey.SerializeCompressed(),
+					famInfo.FamKey.SerializeCompressed())
+			}
+
 		// If emission is enabled, then we'll need to generate another
 		// public key, then use that to derive the key family signature
 		// along with the tweaked key family.
-		if seedling.EnableEmission {
+		case seedling.EnableEmission:
 			rawFamilyKey, err := b.cfg.KeyRing.DeriveNextKey(
 				ctx, TaroKeyFamily,
 			)
@@ -334,7 +374,7 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 					"family key: %v", err)
 			}
 			familyKey, err = asset.DeriveFamilyKey(
-				b.cfg.GenSigner, rawFamilyKey, assetGen,
+				b.cfg.GenSigner, rawFamilyKey, assetGen, nil,
 			)
 			if err != nil {
 				return nil, fmt.Errorf("unable to tweak	family "+
@@ -566,6 +606,11 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 	// So we'll attempt to re-broadcast it, then wait for enough
 	// confirmations to pass.
 	case BatchStateBroadcast:
+		// The anchor output index is only derived when freezing the
+		// batch, so we need to restore it if we resume a batch that
+		// was already broadcast.
+		b.restoreAnchorOutputIndex()
+
 		// First, we'll re-extract the final signed minting transaction
 		// which once broadcast and confirmed will mark the creation of
 		// our assets.
@@ -668,60 +713,10 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 	// chain, so we'll need to commit the exact confirmation location to the
 	// log.
 	case BatchStateConfirmed:
-		confInfo := b.confInfo
 		ctx, cancel := b.WithCtxQuit()
 		defer cancel()
-
-		// Now that the minting transaction has been confirmed, we'll
-		// need to create the series of proof file blobs for each of
-		// the assets.
-		//
-		// TODO(guggero): Add exclusion proofs once FundPsbt actually
-		// returns a transaction with P2TR change outputs and also
-		// decorates the output with the internal key correctly.
-		mintingProofs, err := proof.NewMintingBlobs(&proof.MintParams{
-			BaseProofParams: proof.BaseProofParams{
-				Block:       confInfo.Block,
-				Tx:          confInfo.Tx,
-				TxIndex:     int(confInfo.TxIndex),
-				OutputIndex: int(b.anchorOutputIndex),
-				InternalKey: b.cfg.Batch.BatchKey.PubKey,
-				TaroRoot:    b.cfg.Batch.RootAssetCommitment,
-			},
-			GenesisPoint: extractGenesisOutpoint(
-				b.cfg.Batch.GenesisPacket.Pkt.UnsignedTx,
-			),
-		})
-		if err != nil {
-			return 0, fmt.Errorf("unable to construct minting "+
-				"proofs: %v", err)
-		}
-
-		// Before we confirm the batch, we'll also update the on disk
-		// file system as well.
-		//
-		// TODO(roasbeef): rely on the upsert here instead
-		for _, newAsset := range b.cfg.Batch.RootAssetCommitment.CommittedAssets() {
-			assetID := newAsset.ID()
-			scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
-			err := b.cfg.ProofFiles.ImportProofs(ctx, &proof.AnnotatedProof{
-				Locator: proof.Locator{
-					AssetID:   &assetID,
-					ScriptKey: *newAsset.ScriptKey.PubKey,
-				},
-				Blob: mintingProofs[scriptKey],
-			})
-			if err != nil {
-				return 0, fmt.Errorf("unable to insert proofs: %v", err)
-			}
-		}
-
-		err = b.cfg.Log.MarkBatchConfirmed(
-			ctx, b.cfg.Batch.BatchKey.PubKey, confInfo.BlockHash,
-			confInfo.BlockHeight, confInfo.TxIndex, mintingProofs,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to confirm batch: %w", err)
+		if err := b.confirmBatch(ctx, b.confInfo); err != nil {
+			return 0, err
 		}
 
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
@@ -748,6 +743,133 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 	}
 }
 
+// confirmBatch creates the proof files for all assets of the batch from the
+// given confirmation of the minting transaction, stores them, and marks the
+// batch as confirmed on disk.
+func (b *BatchCaretaker) confirmBatch(ctx context.Context,
+	confInfo *chainntnfs.TxConfirmation) error {
+
+	// Now that the minting transaction has been confirmed, we'll need to
+	// create the series of proof file blobs for each of the assets.
+	//
+	// TODO(guggero): Add exclusion proofs once FundPsbt actually returns a
+	// transaction with P2TR change outputs and also decorates the output
+	// with the internal key correctly.
+	mintingProofs, err := proof.NewMintingBlobs(&proof.MintParams{
+		BaseProofParams: proof.BaseProofParams{
+			Block:       confInfo.Block,
+			Tx:          confInfo.Tx,
+			TxIndex:     int(confInfo.TxIndex),
+			OutputIndex: int(b.anchorOutputIndex),
+			InternalKey: b.cfg.Batch.BatchKey.PubKey,
+			TaroRoot:    b.cfg.Batch.RootAssetCommitment,
+		},
+		GenesisPoint: extractGenesisOutpoint(
+			b.cfg.Batch.GenesisPacket.Pkt.UnsignedTx,
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to construct minting proofs: %v", err)
+	}
+
+	// Before we confirm the batch, we'll also update the on disk file
+	// system as well.
+	//
+	// TODO(roasbeef): rely on the upsert here instead
+	newAssets := b.cfg.Batch.RootAssetCommitment.CommittedAssets()
+	for _, newAsset := range newAssets {
+		assetID := newAsset.ID()
+		scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
+		err := b.cfg.ProofFiles.ImportProofs(ctx, &proof.AnnotatedProof{
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *newAsset.ScriptKey.PubKey,
+			},
+			Blob: mintingProofs[scriptKey],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert proofs: %v", err)
+		}
+	}
+
+	err = b.cfg.Log.MarkBatchConfirmed(
+		ctx, b.cfg.Batch.BatchKey.PubKey, confInfo.BlockHash,
+		confInfo.BlockHeight, confInfo.TxIndex, mintingProofs,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to confirm batch: %w", err)
+	}
+
+	return nil
+}
+
+// restoreAnchorOutputIndex restores the index of the minting output from the
+// change output index of the funded genesis packet.
+func (b *BatchCaretaker) restoreAnchorOutputIndex() {
+	b.anchorOutputIndex = 0
+	if b.cfg.Batch.GenesisPacket.ChangeOutputIndex == 0 {
+		b.anchorOutputIndex = 1
+	}
+}
+
+// resumeReorgWatch hands the minting transaction of a batch that was finalized
+// before our last restart back over to the reorg watcher, as the watcher
+// doesn't persist the transactions it watches. The caretaker doesn't need to
+// be started for this.
+func (b *BatchCaretaker) resumeReorgWatch(batch *ConfirmedBatch) error {
+	b.restoreAnchorOutputIndex()
+
+	mintingTx, err := psbt.Extract(b.cfg.Batch.GenesisPacket.Pkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract minting tx: %w", err)
+	}
+
+	b.confInfo = &chainntnfs.TxConfirmation{
+		BlockHash:   &batch.BlockHash,
+		BlockHeight: batch.BlockHeight,
+		TxIndex:     batch.TxIndex,
+		Tx:          mintingTx,
+	}
+
+	return b.watchForReorg()
+}
+
+// watchForReorg hands the confirmed minting transaction of the finalized batch
+// over to the reorg watcher. If the block that confirmed it is disconnected,
+// the batch goes back to the broadcast state until the minting transaction
+// confirms again, at which point the minting proofs are re-created.
+func (b *BatchCaretaker) watchForReorg() error {
+	batchKey := b.cfg.Batch.BatchKey.PubKey
+
+	return b.cfg.ReorgWatcher.WatchTx(&WatchedTx{
+		Tx:   b.confInfo.Tx,
+		Conf: b.confInfo,
+		OnReorg: func(ctx context.Context) error {
+			log.Infof("MintingBatch(%x): minting tx reorged out",
+				b.batchKey[:])
+
+			return b.cfg.Log.UpdateBatchState(
+				ctx, batchKey, BatchStateBroadcast,
+			)
+		},
+		OnConf: func(ctx context.Context,
+			conf *chainntnfs.TxConfirmation) error {
+
+			log.Infof("MintingBatch(%x): re-confirmed at block("+
+				"hash=%v, height=%v)", b.batchKey[:],
+				conf.BlockHash, conf.BlockHeight)
+
+			if err := b.confirmBatch(ctx, conf); err != nil {
+				return err
+			}
+
+			return b.cfg.Log.UpdateBatchState(
+				ctx, batchKey, BatchStateFinalized,
+			)
+		},
+	})
+}
+
 // GetTxFee returns the value of the on-chain fees paid by a finalized PSBT.
 func GetTxFee(pkt *psbt.Packet) (int64, error) {
 	inputValue, err := psbt.SumUtxoInputValues(pkt)
@@ -2,12 +2,16 @@ package tarogarden
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/lightninglabs/lndclient"
@@ -15,9 +19,14 @@ import (
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
+// rescanProgressInterval is the number of wallet transactions an address
+// rescan inspects between two progress reports.
+const rescanProgressInterval = 100
+
 // CustodianConfig houses all the items that the Custodian needs to carry out
 // its duties.
 type CustodianConfig struct {
@@ -38,15 +47,91 @@ type CustodianConfig struct {
 	// ProofArchive is the storage backend for proofs.
 	ProofArchive *proof.MultiArchiver
 
+	// ProofFiles is the on-disk proof file store. It's used to replace
+	// the proof files of received assets directly, without importing
+	// them as new assets.
+	ProofFiles proof.Archiver
+
+	// ReceiveLog is used to update the on-disk state of received assets
+	// after a reorg.
+	ReceiveLog ReceiveLog
+
 	// ProofCourier is used to optionally deliver the final proof to the
 	// user using an asynchronous transport mechanism.
 	ProofCourier proof.Courier[address.Taro]
 
+	// ReorgWatcher is used to watch the confirmed transactions of inbound
+	// asset transfers for chain reorganizations until they're buried deep
+	// enough.
+	ReorgWatcher *ReorgWatcher
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
 }
 
+// receiveFailure describes an inbound asset transfer that failed for a reason
+// the custodian only learns about outside its main event loop.
+type receiveFailure struct {
+	// op is the on-chain outpoint of the failed inbound asset transfer.
+	op wire.OutPoint
+
+	// status is the failure status the address event should be set to.
+	status address.Status
+
+	// reason is a human readable description of the failure.
+	reason string
+}
+
+// rescanTx is a wallet transaction that is replayed by an address rescan and
+// needs to be inspected by the main event loop.
+type rescanTx struct {
+	// tx is the wallet transaction to inspect.
+	tx *lndclient.Transaction
+
+	// errChan is used to return the result of the inspection.
+	errChan chan error
+}
+
+// receiveReorg describes an inbound asset transfer whose transaction was
+// reorged out of the main chain, or was confirmed again after a reorg.
+type receiveReorg struct {
+	// walletTx is the wallet transaction of the inbound asset transfer.
+	walletTx *lndclient.Transaction
+
+	// outputIdx is the index of the transaction output that received the
+	// assets.
+	outputIdx uint32
+
+	// addr is the address the assets were received with.
+	addr *address.AddrWithKeyInfo
+
+	// conf is the new confirmation of the transaction. This is nil if the
+	// transaction was reorged out.
+	conf *chainntnfs.TxConfirmation
+
+	// errChan is used to return the result of the update.
+	errChan chan error
+}
+
+// RescanProgress describes the progress of an ongoing address rescan.
+type RescanProgress struct {
+	// StartHeight is the block height the rescan started at.
+	StartHeight uint32
+
+	// NumTxns is the total number of wallet transactions that are
+	// inspected by the rescan.
+	NumTxns uint32
+
+	// NumInspected is the number of wallet transactions that were
+	// inspected so far.
+	NumInspected uint32
+
+	// Height is the block height of the last inspected transaction. This
+	// is zero if the transaction is unconfirmed.
+	Height uint32
+}
+
 // Custodian is responsible for taking custody of an asset that is transferred
 // to us on-chain. It watches the chain for incoming transfers defined by Taro
 // addresses and then takes full custody of the transferred assets by collecting
@@ -73,6 +158,19 @@ type Custodian struct {
 	// newProof is used to deliver a new proof to the custodian.
 	newProof chan *proof.Proof
 
+	// receiveFailures is used to deliver failed inbound asset transfers
+	// from the goroutines receiving proofs to the main event loop.
+	receiveFailures chan *receiveFailure
+
+	// rescanTxns is used to deliver the wallet transactions replayed by an
+	// address rescan to the main event loop.
+	rescanTxns chan *rescanTx
+
+	// receiveReorgs is used to deliver inbound asset transfers affected by
+	// a chain reorganization from the reorg watcher to the main event
+	// loop.
+	receiveReorgs chan *receiveReorg
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*chanutils.ContextGuard
@@ -91,6 +189,9 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 		addrSubscription:  addrSub,
 		proofSubscription: proofSub,
 		events:            make(map[wire.OutPoint]*address.Event),
+		receiveFailures:   make(chan *receiveFailure),
+		rescanTxns:        make(chan *rescanTx),
+		receiveReorgs:     make(chan *receiveReorg),
 		ContextGuard: &chanutils.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -212,13 +313,29 @@ func (c *Custodian) watchInboundAssets() {
 		}
 	}
 
+	// The reorg watcher only keeps the transactions it watches in memory.
+	// So we also need to load the transactions of all transfers that were
+	// confirmed before our last restart but aren't buried deep enough
+	// yet, for them to be watched again once they're inspected below.
+	ctxt, cancel = c.WithCtxQuit()
+	unsafeHeight, err := c.cfg.ReorgWatcher.MinUnsafeHeight(ctxt)
+	cancel()
+	if err != nil {
+		reportErr(err)
+		return
+	}
+	startHeight := lastDetectHeight
+	if unsafeHeight < startHeight {
+		startHeight = unsafeHeight
+	}
+
 	// Read all on-chain transactions and make sure they are mapped to an
 	// address event in the database.
 	log.Infof("Loading wallet transactions starting at block height %d",
-		lastDetectHeight)
+		startHeight)
 	ctxt, cancel = c.WithCtxQuit()
 	walletTxns, err := c.cfg.WalletAnchor.ListTransactions(
-		ctxt, int32(lastDetectHeight), -1,
+		ctxt, int32(startHeight), -1,
 		waddrmgr.ImportedAddrAccountName,
 	)
 	cancel()
@@ -230,7 +347,10 @@ func (c *Custodian) watchInboundAssets() {
 	// Keep a cache of all events that are currently ongoing.
 	log.Infof("Checking %d wallet transactions for inbound assets, this "+
 		"might take a while", len(walletTxns))
+	knownTxns := make(map[chainhash.Hash]struct{}, len(walletTxns))
 	for idx := range walletTxns {
+		knownTxns[walletTxns[idx].Tx.TxHash()] = struct{}{}
+
 		err := c.inspectWalletTx(&walletTxns[idx])
 		if err != nil {
 			reportErr(err)
@@ -238,6 +358,66 @@ func (c *Custodian) watchInboundAssets() {
 		}
 	}
 
+	// The wallet lists all unconfirmed transactions it still knows about.
+	// If an unconfirmed transfer isn't among them, its transaction was
+	// double spent or replaced and will never confirm.
+	for op, event := range c.events {
+		if event.ConfirmationHeight != 0 {
+			continue
+		}
+		if _, ok := knownTxns[op.Hash]; ok {
+			continue
+		}
+
+		err := c.failReceive(&receiveFailure{
+			op:     op,
+			status: address.StatusTransactionFailed,
+			reason: "transaction no longer known to the " +
+				"wallet, it was likely double spent or " +
+				"replaced",
+		})
+		if err != nil {
+			reportErr(err)
+			return
+		}
+	}
+
+	// The amount-less addresses we already imported before our last
+	// restart still need to wait for the proof of their transfer, unless
+	// they were already received to. Any address that isn't managed yet
+	// is delivered by the address subscription instead.
+	ctxt, cancel = c.WithCtxQuit()
+	activeAddrs, err := c.cfg.AddrBook.ListAddrs(
+		ctxt, address.QueryParams{
+			ActiveOnly: true,
+		},
+	)
+	cancel()
+	if err != nil {
+		reportErr(err)
+		return
+	}
+	for idx := range activeAddrs {
+		addr := &activeAddrs[idx]
+		if !addr.IsAmountless() || addr.ManagedAfter.IsZero() {
+			continue
+		}
+
+		rejectStatus, err := c.checkAddrAcceptsReceive(
+			addr, &lndclient.Transaction{Timestamp: time.Now()},
+			wire.OutPoint{},
+		)
+		if err != nil {
+			reportErr(err)
+			return
+		}
+		if rejectStatus != nil {
+			continue
+		}
+
+		c.watchAmountlessAddr(addr)
+	}
+
 	log.Infof("Starting main custodian event loop")
 	for {
 		var err error
@@ -251,6 +431,17 @@ func (c *Custodian) watchInboundAssets() {
 		case newProof := <-c.proofSubscription.NewItemCreated.ChanOut():
 			err = c.mapProofToEvent(newProof)
 
+		case failure := <-c.receiveFailures:
+			err = c.failReceive(failure)
+
+		// A failed rescan is reported to the caller that started it, so
+		// it doesn't abort the main event loop.
+		case req := <-c.rescanTxns:
+			req.errChan <- c.inspectWalletTx(req.tx)
+
+		case reorg := <-c.receiveReorgs:
+			reorg.errChan <- c.handleReceiveReorg(reorg)
+
 		case err = <-txErrChan:
 			break
 
@@ -268,6 +459,87 @@ func (c *Custodian) watchInboundAssets() {
 	}
 }
 
+// RescanAddrs replays all wallet transactions starting at the given block
+// height and inspects them for inbound asset transfers to our addresses. This
+// finds transfers that were missed before, for example because the addresses
+// they were sent to were only imported later on. Transfers that are already
+// known are left untouched, so a rescan can safely be repeated. The progress
+// callback is invoked periodically and once the rescan is complete.
+func (c *Custodian) RescanAddrs(ctx context.Context, startHeight uint32,
+	progress func(RescanProgress) error) error {
+
+	log.Infof("Rescanning wallet transactions for inbound assets "+
+		"starting at block height %d", startHeight)
+
+	walletTxns, err := c.cfg.WalletAnchor.ListTransactions(
+		ctx, int32(startHeight), -1, waddrmgr.ImportedAddrAccountName,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list wallet transactions: %w", err)
+	}
+
+	status := RescanProgress{
+		StartHeight: startHeight,
+		NumTxns:     uint32(len(walletTxns)),
+	}
+	for idx := range walletTxns {
+		walletTx := &walletTxns[idx]
+
+		// Only the main event loop is allowed to inspect transactions,
+		// as it owns the cache of ongoing events.
+		req := &rescanTx{
+			tx:      walletTx,
+			errChan: make(chan error, 1),
+		}
+		select {
+		case c.rescanTxns <- req:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.Quit:
+			return fmt.Errorf("custodian shutting down")
+		}
+
+		select {
+		case err := <-req.errChan:
+			if err != nil {
+				return fmt.Errorf("unable to inspect tx %v: %w",
+					walletTx.Tx.TxHash(), err)
+			}
+
+		case <-c.Quit:
+			return fmt.Errorf("custodian shutting down")
+		}
+
+		status.NumInspected++
+		if walletTx.BlockHeight > 0 {
+			status.Height = uint32(walletTx.BlockHeight)
+		}
+
+		if status.NumInspected%rescanProgressInterval != 0 &&
+			status.NumInspected != status.NumTxns {
+
+			continue
+		}
+
+		if err := progress(status); err != nil {
+			return err
+		}
+	}
+
+	// We always report the final state, even if there was nothing to
+	// inspect.
+	if status.NumTxns == 0 {
+		if err := progress(status); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Rescanned %d wallet transactions for inbound assets",
+		status.NumTxns)
+
+	return nil
+}
+
 // inspectWalletTx looks at the outputs of a transaction belonging to the wallet
 // and decides whether a new event should be created for it.
 func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
@@ -290,11 +562,13 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 		op := wire.OutPoint{Hash: txHash, Index: uint32(idx)}
 		event, ok := c.events[op]
 		if ok {
+			if walletTx.Confirmations == 0 {
+				continue
+			}
+
 			// Was this event previously unconfirmed, and we have
 			// received a conf now? Let's bump the state then.
-			if event.ConfirmationHeight == 0 &&
-				walletTx.Confirmations > 0 {
-
+			if event.ConfirmationHeight == 0 {
 				var err error
 				ctxt, cancel := c.CtxBlocking()
 				event, err = c.cfg.AddrBook.GetOrCreateEvent(
@@ -311,6 +585,16 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				c.events[op] = event
 			}
 
+			// We also get here for transfers that were already
+			// confirmed before our last restart, which need to be
+			// watched again. Watching a transaction again only
+			// refreshes its confirmation.
+			outputIdx := uint32(idx)
+			err := c.watchForReorg(walletTx, outputIdx, event.Addr)
+			if err != nil {
+				return err
+			}
+
 			continue
 		}
 
@@ -329,35 +613,84 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 		// goroutine to use the ProofCourier to import the proof into
 		// our local DB.
 		c.Wg.Add(1)
-		go func() {
-			defer c.Wg.Done()
-
-			ctx, cancel := c.WithCtxQuitNoTimeout()
-			defer cancel()
-
-			assetID := addr.ID()
-			proof, err := c.cfg.ProofCourier.ReceiveProof(
-				ctx, *addr, proof.Locator{
-					AssetID:   &assetID,
-					ScriptKey: addr.ScriptKey,
-				},
-			)
-			if err != nil {
-				log.Errorf("unable to recv proof: %v", err)
-				return
-			}
+		go c.receiveProof(addr, &op)
+	}
 
-			ctx, cancel = c.CtxBlocking()
-			defer cancel()
+	return nil
+}
 
-			err = c.cfg.ProofArchive.ImportProofs(ctx, proof)
-			if err != nil {
-				log.Errorf("unable to import proofs: %v", err)
-				return
-			}
+// receiveProof uses the ProofCourier to receive the proof for an inbound asset
+// transfer to the given address and imports it into our local DB. If the
+// outpoint of the transfer is known and the proof turns out to be invalid, the
+// transfer is marked as failed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Custodian) receiveProof(addr *address.Taro, op *wire.OutPoint) {
+	defer c.Wg.Done()
 
-			return
-		}()
+	if err := c.importCourierProof(addr, op); err != nil {
+		log.Errorf("Unable to receive proof: %v", err)
+	}
+}
+
+// receiveAmountlessProof uses the ProofCourier to receive the proof for the
+// inbound asset transfer to the given amount-less address, as we can't detect
+// the transfer on chain. Amount-less addresses only accept a single receive,
+// so we don't wait for any further proofs once the proof is imported.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *Custodian) receiveAmountlessProof(addr *address.AddrWithKeyInfo) {
+	defer c.Wg.Done()
+
+	if err := c.importCourierProof(addr.Taro, nil); err != nil {
+		log.Errorf("Unable to receive proof for amount-less address: "+
+			"%v", err)
+	}
+}
+
+// importCourierProof uses the ProofCourier to receive the proof for an inbound
+// asset transfer to the given address and imports it into our local DB. If the
+// outpoint of the transfer is known and the proof turns out to be invalid, the
+// transfer is marked as failed.
+func (c *Custodian) importCourierProof(addr *address.Taro,
+	op *wire.OutPoint) error {
+
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	assetID := addr.ID()
+	recvProof, err := c.cfg.ProofCourier.ReceiveProof(
+		ctx, *addr, proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: addr.ScriptKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to recv proof: %w", err)
+	}
+
+	ctx, cancel = c.CtxBlocking()
+	defer cancel()
+
+	err = c.cfg.ProofArchive.ImportProofs(ctx, recvProof)
+	switch {
+	case errors.Is(err, proof.ErrProofInvalid) && op != nil:
+		log.Errorf("Received invalid proof for inbound asset transfer "+
+			"in %v: %v", op, err)
+
+		select {
+		case c.receiveFailures <- &receiveFailure{
+			op:     *op,
+			status: address.StatusProofInvalid,
+			reason: err.Error(),
+		}:
+		case <-c.Quit:
+		}
+
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to import proofs: %w", err)
 	}
 
 	return nil
@@ -403,6 +736,18 @@ func (c *Custodian) mapToTaroAddr(walletTx *lndclient.Transaction,
 		status = address.StatusTransactionConfirmed
 	}
 
+	// If the address doesn't accept this transfer, we still record the
+	// event, but flag it accordingly.
+	rejectStatus, err := c.checkAddrAcceptsReceive(addr, walletTx, op)
+	if err != nil {
+		return nil, err
+	}
+	if rejectStatus != nil {
+		log.Warnf("Rejecting inbound asset transfer for Taro address "+
+			"%s in %s: %v", addrStr, op.String(), *rejectStatus)
+		status = *rejectStatus
+	}
+
 	// Block here, a shutdown can wait on this operation.
 	ctxt, cancel = c.CtxBlocking()
 	event, err := c.cfg.AddrBook.GetOrCreateEvent(
@@ -413,12 +758,91 @@ func (c *Custodian) mapToTaroAddr(walletTx *lndclient.Transaction,
 		return nil, fmt.Errorf("error creating event: %w", err)
 	}
 
+	// A confirmed transfer is only final once its transaction is buried
+	// deep enough, so we watch it for reorgs until then.
+	if walletTx.Confirmations > 0 && rejectStatus == nil &&
+		!event.Status.IsFailure() {
+
+		err := c.watchForReorg(walletTx, outputIdx, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A rejected, failed or completed transfer is final, so we neither
+	// track the event nor fetch the proof for it. We might see such a
+	// transfer again when rescanning.
+	if rejectStatus != nil || event.Status.IsFailure() ||
+		event.Status == address.StatusCompleted {
+
+		return nil, nil
+	}
+
 	// Let's update our cache of ongoing events.
 	c.events[op] = event
 
 	return addr.Taro, nil
 }
 
+// checkAddrAcceptsReceive checks whether the given address still accepts the
+// incoming asset transfer in the given outpoint. If it doesn't, the status the
+// event for the transfer should be flagged with is returned.
+func (c *Custodian) checkAddrAcceptsReceive(addr *address.AddrWithKeyInfo,
+	walletTx *lndclient.Transaction, op wire.OutPoint) (*address.Status,
+	error) {
+
+	// The wallet timestamp is the time the transaction was first seen,
+	// which is what matters for the expiry, even if we only inspect the
+	// transaction later on.
+	if addr.IsExpired(walletTx.Timestamp) {
+		status := address.StatusExpired
+		return &status, nil
+	}
+
+	// Amount-less addresses only accept a single receive, even if they
+	// were stored without a limit.
+	maxReceives := addr.MaxReceives
+	if addr.IsAmountless() {
+		maxReceives = 1
+	}
+	if maxReceives == 0 {
+		return nil, nil
+	}
+
+	// Count all the other transfers the address already accepted.
+	from := address.StatusTransactionDetected
+	to := address.StatusCompleted
+	ctxt, cancel := c.WithCtxQuit()
+	events, err := c.cfg.AddrBook.QueryEvents(
+		ctxt, address.EventQueryParams{
+			AddrTaprootOutputKey: schnorr.SerializePubKey(
+				&addr.TaprootOutputKey,
+			),
+			StatusFrom: &from,
+			StatusTo:   &to,
+		},
+	)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("error querying address events: %w",
+			err)
+	}
+
+	var numReceives uint32
+	for _, event := range events {
+		if event.Outpoint != op {
+			numReceives++
+		}
+	}
+
+	if numReceives >= maxReceives {
+		status := address.StatusMaxReceivesExceeded
+		return &status, nil
+	}
+
+	return nil, nil
+}
+
 // importAddrToWallet imports the given Taro address into the lnd-internal
 // btcwallet instance by tracking the on-chain Taproot output key the assets
 // must be sent to in order to be received.
@@ -432,8 +856,45 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 	ctxt, cancel := c.CtxBlocking()
 	defer cancel()
 
+	// The on-chain output of an amount-less address depends on the amount
+	// the sender chooses, so there's nothing we can watch yet. Instead, we
+	// wait for the proof of the transfer, which tells us the output.
+	if addr.IsAmountless() {
+		log.Infof("Waiting for proof of transfer to amount-less Taro "+
+			"address %v", addrStr)
+
+		c.watchAmountlessAddr(addr)
+
+		return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
+	}
+
+	if err := c.importTaprootOutput(ctxt, &addr.TaprootOutputKey); err != nil {
+		return err
+	}
+
+	log.Infof("Imported Taro address %v into wallet", addrStr)
+
+	return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
+}
+
+// watchAmountlessAddr starts waiting for the proof of the transfer to the
+// given amount-less address, if we have a proof courier to receive it from.
+func (c *Custodian) watchAmountlessAddr(addr *address.AddrWithKeyInfo) {
+	if c.cfg.ProofCourier == nil {
+		return
+	}
+
+	c.Wg.Add(1)
+	go c.receiveAmountlessProof(addr)
+}
+
+// importTaprootOutput imports the given Taproot output key into the
+// lnd-internal btcwallet instance so the output is watched on chain.
+func (c *Custodian) importTaprootOutput(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey) error {
+
 	p2trAddr, err := c.cfg.WalletAnchor.ImportTaprootOutput(
-		ctxt, &addr.TaprootOutputKey,
+		ctx, taprootOutputKey,
 	)
 	switch {
 	case err == nil:
@@ -451,10 +912,9 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 		return err
 	}
 
-	log.Infof("Imported Taro address %v into wallet, watching p2tr "+
-		"address %v on chain", addrStr, p2trAddr.String())
+	log.Infof("Watching p2tr address %v on chain", p2trAddr)
 
-	return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
+	return nil
 }
 
 // checkProofAvailable checks the proof storage if a proof for the given event
@@ -539,6 +999,186 @@ func (c *Custodian) mapProofToEvent(p proof.Blob) error {
 		}
 	}
 
+	// If the proof is anchored in the output of one of our in-flight
+	// events but doesn't match the address, the sender didn't send what
+	// the address asked for.
+	anchorPoint := wire.OutPoint{
+		Hash:  lastProof.AnchorTx.TxHash(),
+		Index: lastProof.InclusionProof.OutputIndex,
+	}
+	if event, ok := c.events[anchorPoint]; ok {
+		return c.failReceive(&receiveFailure{
+			op:     anchorPoint,
+			status: address.StatusAssetMismatch,
+			reason: fmt.Sprintf("received %d units of asset %v "+
+				"instead of %d units of asset %v",
+				lastProof.Asset.Amount, lastProof.Asset.ID(),
+				event.Addr.Amount, event.Addr.ID()),
+		})
+	}
+
+	// Transfers to amount-less addresses can't be detected on chain, so
+	// the proof might be the first thing we learn about them.
+	return c.mapProofToAmountlessAddr(lastProof)
+}
+
+// mapProofToAmountlessAddr attempts to match the given proof to one of our
+// amount-less addresses. If it matches, the on-chain output that received the
+// assets is imported into the wallet and a completed event is created for it.
+func (c *Custodian) mapProofToAmountlessAddr(p *proof.Proof) error {
+	// Only normal assets can be received with amount-less addresses.
+	if p.Asset.Type != asset.Normal || p.InclusionProof.InternalKey == nil {
+		return nil
+	}
+
+	// The address is identified by the Taproot output key it would have
+	// if it was for zero units, which we can re-create from the proof.
+	var famKey *btcec.PublicKey
+	if p.Asset.FamilyKey != nil {
+		famKey = &p.Asset.FamilyKey.FamKey
+	}
+	zeroAddr, err := address.New(
+		p.Asset.Genesis, famKey, *p.Asset.ScriptKey.PubKey,
+		*p.InclusionProof.InternalKey, 0, c.cfg.ChainParams,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create address: %w", err)
+	}
+	zeroAddrKey, err := zeroAddr.TaprootOutputKey(nil)
+	if err != nil {
+		return fmt.Errorf("unable to derive Taproot output key: %w",
+			err)
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	addr, err := c.cfg.AddrBook.AddrByTaprootOutput(ctxt, zeroAddrKey)
+	cancel()
+	switch {
+	case errors.Is(err, address.ErrNoAddr):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("error querying addresses by taro key: %w",
+			err)
+	}
+
+	if !addr.IsAmountless() || !AddrMatchesAsset(addr, &p.Asset) {
+		return nil
+	}
+
+	// Now that we know the amount that was sent, we can make sure the
+	// anchor output actually commits to the assets. We keep the Taproot
+	// output key of the stored address, as that's what identifies it.
+	receivedAddr := *addr
+	receivedAddr.Taro = addr.Copy()
+	receivedAddr.Amount = p.Asset.Amount
+
+	outputIdx := p.InclusionProof.OutputIndex
+	if int(outputIdx) >= len(p.AnchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d", outputIdx)
+	}
+	outputKey, err := receivedAddr.Taro.TaprootOutputKey(nil)
+	if err != nil {
+		return fmt.Errorf("unable to derive Taproot output key: %w",
+			err)
+	}
+	anchorKey, err := proof.ExtractTaprootKey(&p.AnchorTx, outputIdx)
+	if err != nil {
+		return fmt.Errorf("error extracting taproot key: %w", err)
+	}
+	if !bytes.Equal(
+		schnorr.SerializePubKey(outputKey),
+		schnorr.SerializePubKey(anchorKey),
+	) {
+
+		log.Warnf("Proof for amount-less Taro address doesn't match "+
+			"anchor output %v:%d", p.AnchorTx.TxHash(), outputIdx)
+		return nil
+	}
+
+	op := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: outputIdx,
+	}
+	log.Infof("Found inbound transfer of %d units for amount-less Taro "+
+		"address in %v", p.Asset.Amount, op)
+
+	// The anchor transaction was already stored together with its block
+	// information when the proof was imported, so we only need to tell
+	// the address book about the output itself.
+	outputDetails := make([]*lnrpc.OutputDetail, len(p.AnchorTx.TxOut))
+	for idx, txOut := range p.AnchorTx.TxOut {
+		outputDetails[idx] = &lnrpc.OutputDetail{
+			Amount: txOut.Value,
+		}
+	}
+	walletTx := &lndclient.Transaction{
+		Tx:            &p.AnchorTx,
+		Timestamp:     p.BlockHeader.Timestamp,
+		OutputDetails: outputDetails,
+	}
+
+	status := address.StatusCompleted
+	rejectStatus, err := c.checkAddrAcceptsReceive(addr, walletTx, op)
+	if err != nil {
+		return err
+	}
+	if rejectStatus != nil {
+		log.Warnf("Rejecting inbound asset transfer for amount-less "+
+			"Taro address in %v: %v", op, *rejectStatus)
+		status = *rejectStatus
+	}
+
+	ctxt, cancel = c.CtxBlocking()
+	defer cancel()
+	event, err := c.cfg.AddrBook.GetOrCreateEvent(
+		ctxt, status, &receivedAddr, walletTx, outputIdx, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating event: %w", err)
+	}
+
+	if rejectStatus != nil {
+		return nil
+	}
+
+	// We want the wallet to watch the output, so we can spend it later.
+	if err := c.importTaprootOutput(ctxt, outputKey); err != nil {
+		return err
+	}
+
+	// The proof was already imported, so the transfer is complete.
+	return c.cfg.AddrBook.CompleteEvent(
+		ctxt, event, address.StatusCompleted, op,
+	)
+}
+
+// failReceive updates the address event of the given failed inbound asset
+// transfer to the failure status and stops tracking it.
+func (c *Custodian) failReceive(failure *receiveFailure) error {
+	event, ok := c.events[failure.op]
+	if !ok {
+		log.Debugf("Ignoring failure of unknown inbound asset "+
+			"transfer in %v", failure.op)
+		return nil
+	}
+
+	log.Warnf("Inbound asset transfer in %v failed (%v): %v", failure.op,
+		failure.status, failure.reason)
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	defer cancel()
+
+	err := c.cfg.AddrBook.FailEvent(
+		ctxt, event, failure.status, failure.reason,
+	)
+	if err != nil {
+		return fmt.Errorf("error failing event: %w", err)
+	}
+
+	delete(c.events, failure.op)
+
 	return nil
 }
 
@@ -561,6 +1201,146 @@ func (c *Custodian) setReceiveCompleted(event *address.Event,
 	)
 }
 
+// watchForReorg hands the confirmed transaction of an inbound asset transfer
+// over to the reorg watcher. If the block that confirmed it is disconnected,
+// the address event goes back to the detected state until the transaction
+// confirms again.
+func (c *Custodian) watchForReorg(walletTx *lndclient.Transaction,
+	outputIdx uint32, addr *address.AddrWithKeyInfo) error {
+
+	blockHash, err := chainhash.NewHashFromStr(walletTx.BlockHash)
+	if err != nil {
+		return fmt.Errorf("error parsing block hash: %w", err)
+	}
+
+	// The callbacks are invoked by the reorg watcher, so we hand the
+	// update over to the main event loop which owns the event cache.
+	notifyMainLoop := func(conf *chainntnfs.TxConfirmation) error {
+		reorg := &receiveReorg{
+			walletTx:  walletTx,
+			outputIdx: outputIdx,
+			addr:      addr,
+			conf:      conf,
+			errChan:   make(chan error, 1),
+		}
+		if !chanutils.SendOrQuit(c.receiveReorgs, reorg, c.Quit) {
+			return fmt.Errorf("custodian shutting down")
+		}
+
+		select {
+		case err := <-reorg.errChan:
+			return err
+
+		case <-c.Quit:
+			return fmt.Errorf("custodian shutting down")
+		}
+	}
+
+	return c.cfg.ReorgWatcher.WatchTx(&WatchedTx{
+		Tx: walletTx.Tx,
+		Conf: &chainntnfs.TxConfirmation{
+			BlockHash:   blockHash,
+			BlockHeight: uint32(walletTx.BlockHeight),
+			Tx:          walletTx.Tx,
+		},
+		OnReorg: func(context.Context) error {
+			return notifyMainLoop(nil)
+		},
+		OnConf: func(_ context.Context,
+			conf *chainntnfs.TxConfirmation) error {
+
+			return notifyMainLoop(conf)
+		},
+	})
+}
+
+// handleReceiveReorg updates the address event of an inbound asset transfer
+// whose transaction was reorged out, or confirmed again after a reorg. If the
+// proof of a completed transfer references the disconnected block, it is
+// re-anchored in the block the transaction was confirmed in again.
+func (c *Custodian) handleReceiveReorg(reorg *receiveReorg) error {
+	walletTx := *reorg.walletTx
+	status := address.StatusTransactionDetected
+	if reorg.conf != nil {
+		walletTx.Confirmations = 1
+		walletTx.BlockHeight = int32(reorg.conf.BlockHeight)
+		walletTx.BlockHash = reorg.conf.BlockHash.String()
+		status = address.StatusTransactionConfirmed
+	} else {
+		walletTx.Confirmations = 0
+	}
+
+	op := wire.OutPoint{
+		Hash:  walletTx.Tx.TxHash(),
+		Index: reorg.outputIdx,
+	}
+	log.Infof("Inbound asset transfer in %v affected by reorg, new "+
+		"status %v", op, status)
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	defer cancel()
+
+	event, err := c.cfg.AddrBook.GetOrCreateEvent(
+		ctxt, status, reorg.addr, &walletTx, reorg.outputIdx, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating event: %w", err)
+	}
+
+	if _, ok := c.events[op]; ok {
+		c.events[op] = event
+	}
+
+	// Only a completed transfer has a proof that needs to be re-anchored.
+	// For all others, the sender delivers the re-anchored proof.
+	if reorg.conf == nil || event.Status != address.StatusCompleted {
+		return nil
+	}
+
+	assetID := reorg.addr.ID()
+	locator := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: reorg.addr.ScriptKey,
+	}
+	proofBlob, err := c.cfg.ProofArchive.FetchProof(ctxt, locator)
+	if err != nil {
+		return fmt.Errorf("error fetching proof: %w", err)
+	}
+
+	reanchoredBlob, _, err := proof.ReanchorLastProof(
+		proofBlob, &proof.BaseProofParams{
+			Block:   reorg.conf.Block,
+			Tx:      reorg.conf.Tx,
+			TxIndex: int(reorg.conf.TxIndex),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error re-anchoring proof: %w", err)
+	}
+
+	// The asset itself didn't change, so we only replace its proof and
+	// the block information of the anchor transaction. Importing the
+	// proof again would create a second asset.
+	err = c.cfg.ProofFiles.ImportProofs(ctxt, &proof.AnnotatedProof{
+		Locator: locator,
+		Blob:    reanchoredBlob,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating proof file: %w", err)
+	}
+
+	err = c.cfg.ReceiveLog.UpdateReceiveProof(
+		ctxt, op, &reorg.addr.ScriptKey, reorg.conf.BlockHash,
+		reorg.conf.BlockHeight, reorg.conf.TxIndex, reanchoredBlob,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating proof: %w", err)
+	}
+
+	return nil
+}
+
 // hasWalletTaprootOutput returns true if one of the outputs of the given
 // transaction is recognized by the wallet as belonging to us and is a Taproot
 // output.
@@ -586,7 +1366,8 @@ func isWalletTaprootOutput(out *lnrpc.OutputDetail) bool {
 }
 
 // AddrMatchesAsset returns true if the given asset state (ID, family key,
-// script key) matches the state represented in the address.
+// script key, amount) matches the state represented in the address. An
+// amount-less address matches any amount of the asset.
 func AddrMatchesAsset(addr *address.AddrWithKeyInfo, a *asset.Asset) bool {
 	famKeyBothNil := (addr.FamilyKey == nil) && (a.FamilyKey == nil)
 	famKeyNoneNil := (addr.FamilyKey != nil) && (a.FamilyKey != nil)
@@ -600,6 +1381,8 @@ func AddrMatchesAsset(addr *address.AddrWithKeyInfo, a *asset.Asset) bool {
 	famKeyEqual := famKeyBothNil ||
 		addr.FamilyKey.IsEqual(&a.FamilyKey.FamKey)
 
-	return addr.ID() == a.ID() && famKeyEqual &&
+	amountMatches := addr.IsAmountless() || addr.Amount == a.Amount
+
+	return addr.ID() == a.ID() && famKeyEqual && amountMatches &&
 		addr.ScriptKey.IsEqual(a.ScriptKey.PubKey)
 }
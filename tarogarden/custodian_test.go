@@ -1,19 +1,23 @@
 package tarogarden_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"io"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarodb"
@@ -55,9 +59,10 @@ func newAddrBook(t *testing.T, keyRing *tarogarden.MockKeyRing) (*address.Book,
 	return book, tarodbBook, db
 }
 
-// newProofArchive creates a new instance of the MultiArchiver.
-func newProofArchive(t *testing.T) (*proof.MultiArchiver, *tarodb.AssetStore) {
-	db := tarodb.NewTestSqliteDB(t)
+// newProofArchive creates a new instance of the MultiArchiver that stores the
+// proofs in the given database, which is shared with the address book.
+func newProofArchive(t *testing.T,
+	db *tarodb.SqliteStore) (*proof.MultiArchiver, *tarodb.AssetStore) {
 
 	txCreator := func(tx tarodb.Tx) tarodb.ActiveAssetsStore {
 		sqlTx, _ := tx.(*sql.Tx)
@@ -87,6 +92,7 @@ type custodianHarness struct {
 	addrBook     *address.Book
 	assetDB      *tarodb.AssetStore
 	proofArchive *proof.MultiArchiver
+	proofFiles   *proof.FileArchiver
 }
 
 // assertStartup makes sure the custodian was started correctly.
@@ -133,8 +139,10 @@ func newHarness(t *testing.T,
 	chainBridge := tarogarden.NewMockChainBridge()
 	walletAnchor := tarogarden.NewMockWalletAnchor()
 	keyRing := tarogarden.NewMockKeyRing()
-	addrBook, tarodbBook, _ := newAddrBook(t, keyRing)
-	proofArchive, assetDB := newProofArchive(t)
+	addrBook, tarodbBook, db := newAddrBook(t, keyRing)
+	proofArchive, assetDB := newProofArchive(t, db)
+	proofFiles, err := proof.NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
 
 	ctxb := context.Background()
 	for _, initialAddr := range initialAddrs {
@@ -142,13 +150,25 @@ func newHarness(t *testing.T,
 		require.NoError(t, err)
 	}
 
+	errChan := make(chan error, 1)
+	reorgWatcher := tarogarden.NewReorgWatcher(
+		&tarogarden.ReorgWatcherConfig{
+			ChainBridge: chainBridge,
+			SafeDepth:   6,
+			ErrChan:     errChan,
+		},
+	)
+
 	cfg := &tarogarden.CustodianConfig{
 		ChainParams:  chainParams,
 		ChainBridge:  chainBridge,
 		WalletAnchor: walletAnchor,
 		AddrBook:     addrBook,
 		ProofArchive: proofArchive,
-		ErrChan:      make(chan error, 1),
+		ProofFiles:   proofFiles,
+		ReceiveLog:   assetDB,
+		ReorgWatcher: reorgWatcher,
+		ErrChan:      errChan,
 	}
 	return &custodianHarness{
 		t:            t,
@@ -161,6 +181,7 @@ func newHarness(t *testing.T,
 		addrBook:     addrBook,
 		assetDB:      assetDB,
 		proofArchive: proofArchive,
+		proofFiles:   proofFiles,
 	}
 }
 
@@ -281,7 +302,8 @@ func TestCustodianNewAddr(t *testing.T) {
 	ctx := context.Background()
 	addr := randAddr(t)
 	dbAddr, err := h.addrBook.NewAddress(
-		ctx, addr.Genesis, addr.FamilyKey, addr.Amount,
+		ctx, addr.Genesis, addr.FamilyKey, addr.Amount, time.Time{}, 0,
+		"", nil,
 	)
 	require.NoError(t, err)
 
@@ -298,6 +320,74 @@ func TestCustodianNewAddr(t *testing.T) {
 	})
 }
 
+// TestNewAmountlessAddr makes sure that new amount-less addresses only accept
+// a single receive.
+func TestNewAmountlessAddr(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t, nil)
+	ctx := context.Background()
+	genesis := asset.RandGenesis(t, asset.Normal)
+
+	// An amount-less address that can be received to more than once is
+	// rejected before any keys are derived for it.
+	_, err := h.addrBook.NewAddress(
+		ctx, genesis, nil, 0, time.Time{}, 2, "", nil,
+	)
+	require.ErrorContains(t, err, "can only be received to once")
+
+	// Without an explicit limit, the address is limited to a single
+	// receive.
+	go func() {
+		<-h.keyRing.ReqKeys
+		<-h.keyRing.ReqKeys
+	}()
+	addr, err := h.addrBook.NewAddress(
+		ctx, genesis, nil, 0, time.Time{}, 0, "", nil,
+	)
+	require.NoError(t, err)
+	require.True(t, addr.IsAmountless())
+	require.EqualValues(t, 1, addr.MaxReceives)
+}
+
+// TestCustodianImportAddrs makes sure that addresses imported into the address
+// book, for example from an address export file, are imported into the wallet
+// and watched on-chain, while already known addresses are skipped.
+func TestCustodianImportAddrs(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t, nil)
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	ctx := context.Background()
+	addrs := []address.AddrWithKeyInfo{*randAddr(t), *randAddr(t)}
+	imported, err := h.addrBook.ImportAddrs(ctx, addrs)
+	require.NoError(t, err)
+	require.Len(t, imported, len(addrs))
+
+	h.assertAddrsRegistered(&addrs[0], &addrs[1])
+
+	h.eventually(func() bool {
+		dbAddrs, err := h.tarodbBook.QueryAddrs(
+			ctx, address.QueryParams{
+				UnmanagedOnly: true,
+			},
+		)
+		require.NoError(t, err)
+
+		return len(dbAddrs) == 0
+	})
+
+	// Importing the same addresses again should skip both of them.
+	imported, err = h.addrBook.ImportAddrs(ctx, addrs)
+	require.NoError(t, err)
+	require.Empty(t, imported)
+}
+
 func TestTransactionHandling(t *testing.T) {
 	h := newHarness(t, nil)
 
@@ -344,6 +434,719 @@ func TestTransactionHandling(t *testing.T) {
 	})
 }
 
+// TestTransactionHandlingAddrRestrictions makes sure that transfers to
+// expired addresses or to addresses that already received the maximum number
+// of transfers are flagged accordingly.
+func TestTransactionHandlingAddrRestrictions(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// We create one address that expired before it received anything, one
+	// single-use address that receives twice and one unrestricted address
+	// that also receives twice.
+	ctx := context.Background()
+	expiredAddr := randAddr(t)
+	expiredAddr.Expiry = time.Now().Add(-time.Hour)
+	singleUseAddr := randAddr(t)
+	singleUseAddr.MaxReceives = 1
+	unrestrictedAddr := randAddr(t)
+
+	addrs := []*address.AddrWithKeyInfo{
+		expiredAddr, singleUseAddr, unrestrictedAddr,
+	}
+	for _, addr := range addrs {
+		err := h.tarodbBook.InsertAddrs(ctx, *addr)
+		require.NoError(t, err)
+	}
+
+	for _, addr := range []*address.AddrWithKeyInfo{
+		expiredAddr, singleUseAddr, singleUseAddr, unrestrictedAddr,
+		unrestrictedAddr,
+	} {
+		_, tx := randWalletTx(addr)
+		h.walletAnchor.Transactions = append(
+			h.walletAnchor.Transactions, *tx,
+		)
+	}
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addrs...)
+
+	// queryStatuses returns the statuses of all events of an address.
+	queryStatuses := func(addr *address.AddrWithKeyInfo) []address.Status {
+		events, err := h.tarodbBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{
+				AddrTaprootOutputKey: schnorr.SerializePubKey(
+					&addr.TaprootOutputKey,
+				),
+			},
+		)
+		require.NoError(t, err)
+
+		statuses := make([]address.Status, len(events))
+		for idx, event := range events {
+			statuses[idx] = event.Status
+		}
+
+		return statuses
+	}
+
+	detected := address.StatusTransactionDetected
+	h.eventually(func() bool {
+		return len(queryStatuses(unrestrictedAddr)) == 2
+	})
+	require.Equal(
+		t, []address.Status{address.StatusExpired},
+		queryStatuses(expiredAddr),
+	)
+	require.ElementsMatch(
+		t, []address.Status{
+			detected, address.StatusMaxReceivesExceeded,
+		}, queryStatuses(singleUseAddr),
+	)
+	require.Equal(
+		t, []address.Status{detected, detected},
+		queryStatuses(unrestrictedAddr),
+	)
+
+	// The address that expired and the one that was used up are no longer
+	// active.
+	inactiveAddrs, err := h.tarodbBook.QueryAddrs(
+		ctx, address.QueryParams{
+			ExpiredOnly: true,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, inactiveAddrs, 2)
+
+	activeAddrs, err := h.tarodbBook.QueryAddrs(
+		ctx, address.QueryParams{
+			ActiveOnly: true,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, activeAddrs, 1)
+	require.Equal(
+		t, schnorr.SerializePubKey(&unrestrictedAddr.TaprootOutputKey),
+		schnorr.SerializePubKey(&activeAddrs[0].TaprootOutputKey),
+	)
+}
+
+// TestTransactionHandlingDoubleSpend makes sure that an unconfirmed inbound
+// asset transfer whose transaction is no longer known to the wallet is marked
+// as failed on startup.
+func TestTransactionHandlingDoubleSpend(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// We create an event for an unconfirmed transaction that the wallet
+	// forgot about, for example because it was double spent, and one for a
+	// transaction the wallet still knows.
+	ctx := context.Background()
+	replacedAddr := randAddr(t)
+	knownAddr := randAddr(t)
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *replacedAddr))
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *knownAddr))
+
+	outputIdx, replacedTx := randWalletTx(replacedAddr)
+	_, err := h.tarodbBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionDetected, replacedAddr,
+		replacedTx, uint32(outputIdx), nil,
+	)
+	require.NoError(t, err)
+
+	outputIdx, knownTx := randWalletTx(knownAddr)
+	_, err = h.tarodbBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionDetected, knownAddr, knownTx,
+		uint32(outputIdx), nil,
+	)
+	require.NoError(t, err)
+	h.walletAnchor.Transactions = append(
+		h.walletAnchor.Transactions, *knownTx,
+	)
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(replacedAddr, knownAddr)
+
+	failed := address.StatusTransactionFailed
+	h.eventually(func() bool {
+		events, err := h.tarodbBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{
+				StatusFrom: &failed,
+				StatusTo:   &failed,
+			},
+		)
+		require.NoError(t, err)
+
+		return len(events) == 1
+	})
+
+	// Only the event of the replaced transaction should have failed.
+	events, err := h.tarodbBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	for _, event := range events {
+		if event.Outpoint.Hash == replacedTx.Tx.TxHash() {
+			require.Equal(t, failed, event.Status)
+			require.NotEmpty(t, event.FailureReason)
+			continue
+		}
+
+		require.Equal(
+			t, address.StatusTransactionDetected, event.Status,
+		)
+	}
+}
+
+// TestCustodianRescanAddrs makes sure that a rescan finds inbound asset
+// transfers to addresses that weren't known when the transaction was first
+// inspected, and that repeating the rescan doesn't create duplicate events.
+func TestCustodianRescanAddrs(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// The wallet already knows a transaction to an address that isn't
+	// in our address book yet.
+	ctx := context.Background()
+	addr := randAddr(t)
+	outputIdx, tx := randWalletTx(addr)
+	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// We now add the address without notifying the custodian, so only a
+	// rescan can find the transfer.
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *addr))
+
+	rescan := func() []tarogarden.RescanProgress {
+		go func() {
+			_, err := chanutils.RecvOrTimeout(
+				h.walletAnchor.ListTxnsSignal, testTimeout,
+			)
+			require.NoError(t, err)
+		}()
+
+		var updates []tarogarden.RescanProgress
+		err := h.c.RescanAddrs(
+			ctx, 0, func(p tarogarden.RescanProgress) error {
+				updates = append(updates, p)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+
+		return updates
+	}
+
+	for i := 0; i < 2; i++ {
+		updates := rescan()
+		require.Equal(t, []tarogarden.RescanProgress{{
+			NumTxns:      1,
+			NumInspected: 1,
+		}}, updates)
+
+		events, err := h.tarodbBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{},
+		)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.EqualValues(t, outputIdx, events[0].Outpoint.Index)
+		require.Equal(
+			t, address.StatusTransactionDetected, events[0].Status,
+		)
+	}
+}
+
+// TestCustodianReceiveEvents makes sure that subscribers of the address book
+// are notified about the inbound asset transfers the custodian detects.
+func TestCustodianReceiveEvents(t *testing.T) {
+	h := newHarness(t, nil)
+
+	ctx := context.Background()
+	addr := randAddr(t)
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *addr))
+
+	outputIdx, tx := randWalletTx(addr)
+	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err := h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addr)
+
+	expectedOutpoint := wire.OutPoint{
+		Hash:  tx.Tx.TxHash(),
+		Index: uint32(outputIdx),
+	}
+	event, err := chanutils.RecvOrTimeout(
+		eventSub.NewItemCreated.ChanOut(), testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutpoint, (*event).Outpoint)
+	require.Equal(
+		t, address.StatusTransactionDetected, (*event).Status,
+	)
+
+	// A new subscriber that asks for existing events should get the event
+	// delivered right away.
+	existingSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = h.addrBook.RegisterEventSubscriber(
+		existingSub, true, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := h.addrBook.RemoveEventSubscriber(existingSub)
+		require.NoError(t, err)
+	})
+
+	event, err = chanutils.RecvOrTimeout(
+		existingSub.NewItemCreated.ChanOut(), testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutpoint, (*event).Outpoint)
+}
+
+// importReceivedAsset imports the asset received with the given address in
+// the given transaction output, anchored in the given block, as if the
+// inbound asset transfer was already completed.
+func importReceivedAsset(t *testing.T, h *custodianHarness,
+	addr *address.AddrWithKeyInfo, tx *wire.MsgTx, outputIdx int,
+	block *wire.MsgBlock, blockHeight uint32) {
+
+	receivedAsset, err := asset.New(
+		addr.Genesis, addr.Amount, 0, 0,
+		asset.NewScriptKey(&addr.ScriptKey), nil,
+	)
+	require.NoError(t, err)
+
+	assetCommitment, err := commitment.NewAssetCommitment(receivedAsset)
+	require.NoError(t, err)
+	taroCommitment, err := commitment.NewTaroCommitment(assetCommitment)
+	require.NoError(t, err)
+
+	merkleProof, err := proof.NewTxMerkleProof(block.Transactions, 0)
+	require.NoError(t, err)
+
+	receiveProof := proof.Proof{
+		PrevOut:       test.RandOp(t),
+		BlockHeader:   block.Header,
+		AnchorTx:      *tx,
+		TxMerkleProof: *merkleProof,
+		Asset:         *receivedAsset,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: uint32(outputIdx),
+			InternalKey: &addr.InternalKey,
+		},
+	}
+	proofFile, err := proof.NewFile(proof.V0, receiveProof)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&buf))
+
+	ctx := context.Background()
+	assetID := receivedAsset.ID()
+	err = h.assetDB.ImportProofs(ctx, &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: addr.ScriptKey,
+		},
+		Blob: buf.Bytes(),
+		AssetSnapshot: &proof.AssetSnapshot{
+			Asset: receivedAsset,
+			OutPoint: wire.OutPoint{
+				Hash:  tx.TxHash(),
+				Index: uint32(outputIdx),
+			},
+			AnchorBlockHash:   block.BlockHash(),
+			AnchorBlockHeight: blockHeight,
+			AnchorTx:          tx,
+			OutputIndex:       uint32(outputIdx),
+			InternalKey:       &addr.InternalKey,
+			ScriptRoot:        taroCommitment,
+		},
+	})
+	require.NoError(t, err)
+}
+
+// TestCustodianReceiveReorg makes sure that the proof of a completed inbound
+// asset transfer is re-anchored in the new block once its transaction is
+// confirmed again after a reorg, without importing the asset a second time.
+func TestCustodianReceiveReorg(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// The inbound asset transfer was already completed, so the received
+	// asset is in our database, anchored in the block at height 100.
+	const confHeight = 100
+	ctx := context.Background()
+	addr := randAddr(t)
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *addr))
+
+	outputIdx, tx := randWalletTx(addr)
+	oldBlock := &wire.MsgBlock{Transactions: []*wire.MsgTx{tx.Tx}}
+	tx.Confirmations = 1
+	tx.BlockHeight = confHeight
+	tx.BlockHash = oldBlock.BlockHash().String()
+	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
+
+	importReceivedAsset(t, h, addr, tx.Tx, outputIdx, oldBlock, confHeight)
+	_, err := h.tarodbBook.GetOrCreateEvent(
+		ctx, address.StatusCompleted, addr, tx, uint32(outputIdx), nil,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, h.cfg.ReorgWatcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.cfg.ReorgWatcher.Stop())
+	})
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addr)
+
+	// The confirmed transaction is watched for reorgs on startup.
+	h.eventually(func() bool {
+		return h.cfg.ReorgWatcher.NumWatchedTxs() == 1
+	})
+
+	// We now disconnect the block that confirmed the transaction, and
+	// confirm it again in a different block at a different index.
+	h.chainBridge.BlockEpochs <- confHeight
+	h.chainBridge.BlockEpochs <- confHeight + 1
+	h.chainBridge.BlockEpochs <- confHeight
+
+	reqNo, err := chanutils.RecvOrTimeout(
+		h.chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	newBlock := &wire.MsgBlock{
+		Header:       wire.BlockHeader{Nonce: 1},
+		Transactions: []*wire.MsgTx{otherTx, tx.Tx},
+	}
+	newBlockHash := newBlock.BlockHash()
+	h.chainBridge.SendConfNtfn(
+		*reqNo, &newBlockHash, confHeight+1, 1, newBlock, tx.Tx,
+	)
+
+	// The stored proof should be re-anchored in the new block.
+	assetID := addr.ID()
+	locator := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: addr.ScriptKey,
+	}
+	lastProofBlock := func(blob proof.Blob) chainhash.Hash {
+		f := proof.NewEmptyFile(proof.V0)
+		require.NoError(t, f.Decode(bytes.NewReader(blob)))
+
+		lastProof, err := f.LastProof()
+		require.NoError(t, err)
+
+		return lastProof.BlockHeader.BlockHash()
+	}
+	h.eventually(func() bool {
+		blob, err := h.assetDB.FetchProof(ctx, locator)
+		require.NoError(t, err)
+
+		return lastProofBlock(blob) == newBlockHash
+	})
+
+	fileBlob, err := h.proofFiles.FetchProof(ctx, locator)
+	require.NoError(t, err)
+	require.Equal(t, newBlockHash, lastProofBlock(fileBlob))
+
+	// The asset must not be imported a second time, only its anchor
+	// transaction is now confirmed in the new block.
+	assets, err := h.assetDB.FetchAllAssets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	require.Equal(t, newBlockHash, assets[0].AnchorBlockHash)
+	require.EqualValues(t, confHeight+1, assets[0].AnchorBlockHeight)
+}
+
+// mockProofCourier is a proof courier that signals each proof request and
+// hands out the proofs that are passed to it.
+type mockProofCourier struct {
+	// recvSignal is sent on each time a proof is requested.
+	recvSignal chan struct{}
+
+	// proofs is used to hand out a proof to a pending proof request.
+	proofs chan *proof.AnnotatedProof
+}
+
+// DeliverProof attempts to delivery a proof to the receiver, using the
+// information in the Addr type.
+func (m *mockProofCourier) DeliverProof(context.Context, address.Taro,
+	*proof.AnnotatedProof) error {
+
+	return nil
+}
+
+// ReceiveProof attempts to obtain a proof as identified by the passed locator
+// from the source encapsulated within the specified address.
+func (m *mockProofCourier) ReceiveProof(ctx context.Context, _ address.Taro,
+	_ proof.Locator) (*proof.AnnotatedProof, error) {
+
+	select {
+	case m.recvSignal <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case p := <-m.proofs:
+		return p, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// lastProofVerifier is a proof verifier that doesn't verify the proof file
+// but returns a snapshot of the last state of the file.
+type lastProofVerifier struct{}
+
+// Verify takes the passed serialized proof file, and returns an AssetSnapshot
+// of the final state transition of the file.
+func (lastProofVerifier) Verify(_ context.Context,
+	blobReader io.Reader) (*proof.AssetSnapshot, error) {
+
+	file := proof.NewEmptyFile(proof.V0)
+	if err := file.Decode(blobReader); err != nil {
+		return nil, err
+	}
+	lastProof, err := file.LastProof()
+	if err != nil {
+		return nil, err
+	}
+
+	assetCommitment, err := commitment.NewAssetCommitment(&lastProof.Asset)
+	if err != nil {
+		return nil, err
+	}
+	taroCommitment, err := commitment.NewTaroCommitment(assetCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proof.AssetSnapshot{
+		Asset: &lastProof.Asset,
+		OutPoint: wire.OutPoint{
+			Hash:  lastProof.AnchorTx.TxHash(),
+			Index: lastProof.InclusionProof.OutputIndex,
+		},
+		AnchorBlockHash: lastProof.BlockHeader.BlockHash(),
+		AnchorTx:        &lastProof.AnchorTx,
+		OutputIndex:     lastProof.InclusionProof.OutputIndex,
+		InternalKey:     lastProof.InclusionProof.InternalKey,
+		ScriptRoot:      taroCommitment,
+	}, nil
+}
+
+// amountlessTransferProof creates the proof of a transfer of the given amount
+// to the given amount-less address, anchored in a new transaction. The
+// Taproot output key of the anchor output is returned as well.
+func amountlessTransferProof(t *testing.T, addr *address.AddrWithKeyInfo,
+	amount uint64) (*proof.AnnotatedProof, *btcec.PublicKey) {
+
+	receivedAddr := addr.Copy()
+	receivedAddr.Amount = amount
+	outputKey, err := receivedAddr.TaprootOutputKey(nil)
+	require.NoError(t, err)
+	pkScript, err := taroscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	tx.AddTxOut(&wire.TxOut{PkScript: pkScript, Value: 1000})
+	block := &wire.MsgBlock{Transactions: []*wire.MsgTx{tx}}
+
+	receivedAsset, err := asset.New(
+		addr.Genesis, amount, 0, 0,
+		asset.NewScriptKey(&addr.ScriptKey), nil,
+	)
+	require.NoError(t, err)
+
+	merkleProof, err := proof.NewTxMerkleProof(block.Transactions, 0)
+	require.NoError(t, err)
+
+	proofFile, err := proof.NewFile(proof.V0, proof.Proof{
+		PrevOut:       test.RandOp(t),
+		BlockHeader:   block.Header,
+		AnchorTx:      *tx,
+		TxMerkleProof: *merkleProof,
+		Asset:         *receivedAsset,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: 0,
+			InternalKey: &addr.InternalKey,
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&buf))
+
+	assetID := receivedAsset.ID()
+	return &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: addr.ScriptKey,
+		},
+		Blob: buf.Bytes(),
+	}, outputKey
+}
+
+// TestCustodianAmountlessReceives makes sure that the custodian waits for the
+// proof of the transfer to an amount-less address that was imported before a
+// restart, and that any further transfer to the address is rejected, as all
+// transfers would share the same script key.
+func TestCustodianAmountlessReceives(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t, nil)
+	courier := &mockProofCourier{
+		recvSignal: make(chan struct{}),
+		proofs:     make(chan *proof.AnnotatedProof),
+	}
+	h.cfg.ProofCourier = courier
+
+	// The received assets need to be imported into our database, so we
+	// can't use the mock verifier that returns a random asset.
+	h.cfg.ProofArchive = proof.NewMultiArchiver(
+		lastProofVerifier{}, tarodb.DefaultStoreTimeout, h.assetDB,
+		h.proofFiles,
+	)
+	h.c = tarogarden.NewCustodian(h.cfg)
+
+	// We add an amount-less address that was already imported into the
+	// wallet before our restart. It was stored without a receive limit,
+	// but still only accepts a single receive.
+	ctx := context.Background()
+	genesis := asset.RandGenesis(t, asset.Normal)
+	scriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+	})
+	internalKey := test.RandPubKey(t)
+	taro, err := address.New(
+		genesis, nil, *scriptKey.PubKey, *internalKey, 0, chainParams,
+	)
+	require.NoError(t, err)
+	taprootOutputKey, err := taro.TaprootOutputKey(nil)
+	require.NoError(t, err)
+	addr := &address.AddrWithKeyInfo{
+		Taro:           taro,
+		ScriptKeyTweak: *scriptKey.TweakedScriptKey,
+		InternalKeyDesc: keychain.KeyDescriptor{
+			PubKey: internalKey,
+		},
+		TaprootOutputKey: *taprootOutputKey,
+		CreationTime:     time.Now(),
+	}
+	require.NoError(t, h.tarodbBook.InsertAddrs(ctx, *addr))
+	require.NoError(t, h.tarodbBook.SetAddrManaged(ctx, addr, time.Now()))
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// The custodian should ask the courier for the proof of the transfer
+	// to the address and import the output it was anchored in.
+	_, err = chanutils.RecvOrTimeout(courier.recvSignal, testTimeout)
+	require.NoError(t, err)
+
+	firstProof, firstKey := amountlessTransferProof(t, addr, 5)
+	select {
+	case courier.proofs <- firstProof:
+	case <-time.After(testTimeout):
+		t.Fatalf("proof not requested")
+	}
+
+	importedKey, err := chanutils.RecvOrTimeout(
+		h.walletAnchor.ImportPubKeySignal, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, schnorr.SerializePubKey(firstKey),
+		schnorr.SerializePubKey(*importedKey),
+	)
+
+	// queryEvents returns the events of the address by their status.
+	queryEvents := func() map[address.Status]*address.Event {
+		events, err := h.tarodbBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{
+				AddrTaprootOutputKey: schnorr.SerializePubKey(
+					&addr.TaprootOutputKey,
+				),
+			},
+		)
+		require.NoError(t, err)
+
+		eventsByStatus := make(map[address.Status]*address.Event)
+		for _, event := range events {
+			eventsByStatus[event.Status] = event
+		}
+
+		return eventsByStatus
+	}
+	h.eventually(func() bool {
+		return len(queryEvents()) == 1
+	})
+	completed := queryEvents()[address.StatusCompleted]
+	require.NotNil(t, completed)
+	require.EqualValues(t, 5, completed.AssetAmount)
+
+	// As the address doesn't accept any further transfers, we shouldn't
+	// wait for another proof.
+	select {
+	case <-courier.recvSignal:
+		t.Fatalf("unexpected proof request")
+	case <-time.After(testPollInterval * 5):
+	}
+
+	// A second transfer to the address, with its proof imported manually,
+	// must not be taken custody of.
+	secondProof, _ := amountlessTransferProof(t, addr, 7)
+	require.NoError(t, h.cfg.ProofArchive.ImportProofs(ctx, secondProof))
+
+	h.eventually(func() bool {
+		return len(queryEvents()) == 2
+	})
+	rejected := queryEvents()[address.StatusMaxReceivesExceeded]
+	require.NotNil(t, rejected)
+	require.EqualValues(t, 7, rejected.AssetAmount)
+}
+
 // TestAddrMatchesAsset tests that the AddrMatchesAsset function works
 // correctly.
 func TestAddrMatchesAsset(t *testing.T) {
@@ -471,6 +1274,39 @@ func TestAddrMatchesAsset(t *testing.T) {
 			},
 		},
 		result: true,
+	}, {
+		name: "amount mismatch",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:   randGen1,
+				ScriptKey: *randKey2,
+				Amount:    10,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  11,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: false,
+	}, {
+		name: "amount-less address matches any amount",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:   randGen1,
+				ScriptKey: *randKey2,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  11,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: true,
 	}}
 
 	for _, tc := range testCases {
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -28,15 +29,38 @@ type Planter interface {
 	// error is returned no issuance operation was possible.
 	QueueNewSeedling(req *Seedling) (SeedlingUpdates, error)
 
-	// TODO(roasbeef): list seeds, their pending state, etc, etc
+	// QueueNewSeedlings attempts to atomically queue a set of new
+	// seedlings into the pending batch. All seedlings are validated
+	// first, if any of them is invalid then none are queued and a
+	// SeedlingBatchError describing each invalid seedling is returned.
+	// Otherwise the key of the batch the seedlings were added to is
+	// returned.
+	QueueNewSeedlings(reqs []*Seedling) (*btcec.PublicKey, error)
+
+	// ListBatches lists the set of batches submitted to the planter, both
+	// non-final and finalized ones. If a batch key is passed, only the
+	// batch identified by that key is returned.
+	ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch, error)
 
 	// TODO(roasbeef): notification methods also?
 
 	// CancelSeedling attempts to cancel the creation of a new asset
-	// identified by its name. If the seedling has already progressed to a
-	// point where the genesis PSBT has been broadcasted, an error is
-	// returned.
-	CancelSeedling() error
+	// identified by its name. Only seedlings of the pending batch can be
+	// cancelled. If the seedling is the last one of the pending batch,
+	// the whole batch is cancelled.
+	CancelSeedling(assetName string) error
+
+	// CancelBatch attempts to cancel a batch identified by its batch key,
+	// or the pending batch if no key is passed. Any wallet inputs locked
+	// for the genesis transaction are released. If the genesis transaction
+	// of the batch has already been broadcast, an error is returned.
+	CancelBatch(batchKey *btcec.PublicKey) error
+
+	// FinalizeBatch freezes the pending batch immediately, without waiting
+	// for the next batch tick, and starts to mint its assets. If a
+	// non-zero fee rate is passed, the genesis transaction is funded with
+	// that fee rate instead of an estimated one.
+	FinalizeBatch(feeRate chainfee.SatPerKWeight) (*MintingBatch, error)
 
 	// Start signals that the asset minter should being operations.
 	Start() error
@@ -76,6 +100,11 @@ const (
 	// state the batch has been confirmed on chain, with all assets
 	// created.
 	BatchStateFinalized BatchState = 5
+
+	// BatchStateCancelled denotes that a batch was cancelled before its
+	// genesis transaction was broadcast. In this terminal state none of
+	// the assets of the batch will be created.
+	BatchStateCancelled BatchState = 6
 )
 
 // String returns a human readable string for the target batch state.
@@ -99,6 +128,9 @@ func (b BatchState) String() string {
 	case BatchStateFinalized:
 		return "BatchStateFinalized"
 
+	case BatchStateCancelled:
+		return "BatchStateCancelled"
+
 	default:
 		return fmt.Sprintf("UnknownState(%v)", int(b))
 	}
@@ -125,10 +157,40 @@ type MintingStore interface {
 	AddSeedlingsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
 		seedlings ...*Seedling) error
 
+	// RemoveSeedlingFromBatch removes the seedling with the given asset
+	// name from an existing batch that's still in the BatchStatePending
+	// state.
+	RemoveSeedlingFromBatch(ctx context.Context,
+		batchKey *btcec.PublicKey, assetName string) error
+
+	// UpdateBatchFeeRate updates the fee rate the genesis transaction of
+	// the batch identified by the batch key is funded with.
+	UpdateBatchFeeRate(ctx context.Context, batchKey *btcec.PublicKey,
+		feeRate chainfee.SatPerKWeight) error
+
 	// FetchNonFinalBatches fetches all non-finalized batches, meaning
 	// batches that haven't yet fully confirmed on chain.
 	FetchNonFinalBatches(ctx context.Context) ([]*MintingBatch, error)
 
+	// FetchFinalizedBatches fetches all finalized batches, meaning batches
+	// that have fully confirmed on chain. Only the seedlings and the
+	// genesis packet of these batches are populated.
+	FetchFinalizedBatches(ctx context.Context) ([]*MintingBatch, error)
+
+	// FetchConfirmedBatches fetches all finalized batches whose minting
+	// transaction was confirmed at or above the given block height, along
+	// with the location of the minting transaction in the chain. The
+	// sprouts and the genesis packet of these batches are populated.
+	FetchConfirmedBatches(ctx context.Context,
+		minHeight uint32) ([]*ConfirmedBatch, error)
+
+	// FetchAssetFamily fetches the information needed to issue a new
+	// asset into the existing asset family identified by the passed
+	// tweaked family key. If the family is unknown, then ErrNoAssetFamily
+	// is returned.
+	FetchAssetFamily(ctx context.Context,
+		famKey *btcec.PublicKey) (*asset.AssetFamily, error)
+
 	// AddSproutsToBatch adds a new set of sprouts to the batch, along with
 	// a GenesisPacket, that once signed and broadcast with create the
 	// set of assets on chain.
@@ -227,6 +289,19 @@ func SupportedMintingStores() []string {
 	return supportedStores
 }
 
+// ReceiveLog is used to keep the on-disk state of assets that were received
+// with a Taro address in sync with the chain.
+type ReceiveLog interface {
+	// UpdateReceiveProof replaces the proof of an asset that was received
+	// in the given anchor point, and updates the block information of the
+	// anchor transaction. This is used once the anchor transaction was
+	// confirmed in a different block after a reorg. No new asset is
+	// created.
+	UpdateReceiveProof(ctx context.Context, anchorPoint wire.OutPoint,
+		scriptKey *btcec.PublicKey, blockHash *chainhash.Hash,
+		blockHeight uint32, txIndex uint32, proofBlob proof.Blob) error
+}
+
 // ChainBridge is our bridge to the target chain. It's used to get confirmation
 // notifications, the current height, publish transactions, and also estimate
 // fees.
@@ -238,6 +313,13 @@ type ChainBridge interface {
 		includeBlock bool) (*chainntnfs.ConfirmationEvent, chan error,
 		error)
 
+	// RegisterBlockEpochNtfn registers an intent to be notified of the
+	// height of each new block that is connected to the main chain. If
+	// the chain is reorganized, the height delivered can be lower than or
+	// equal to the height delivered before.
+	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
+		error)
+
 	// CurrentHeight return the current height of the main chain.
 	CurrentHeight(context.Context) (uint32, error)
 
@@ -296,9 +378,9 @@ type WalletAnchor interface {
 	// P2TR output.
 	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address, error)
 
-	// UnlockInput unlocks the set of target inputs after a batch is
-	// abandoned.
-	UnlockInput(context.Context) error
+	// UnlockInput unlocks a wallet input that was locked when funding a
+	// PSBT, after the PSBT is abandoned.
+	UnlockInput(ctx context.Context, op wire.OutPoint) error
 
 	// ListUnspentImportScripts lists all UTXOs of the imported Taproot
 	// scripts.
@@ -31,8 +31,9 @@ type MockWalletAnchor struct {
 	SubscribeTx        chan lndclient.Transaction
 	ListTxnsSignal     chan struct{}
 
-	Transactions  []lndclient.Transaction
-	ImportedUtxos []*lnwallet.Utxo
+	Transactions   []lndclient.Transaction
+	ImportedUtxos  []*lnwallet.Utxo
+	UnlockedInputs []wire.OutPoint
 }
 
 func NewMockWalletAnchor() *MockWalletAnchor {
@@ -119,7 +120,11 @@ func (m *MockWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	)
 }
 
-func (m *MockWalletAnchor) UnlockInput(_ context.Context) error {
+func (m *MockWalletAnchor) UnlockInput(_ context.Context,
+	op wire.OutPoint) error {
+
+	m.UnlockedInputs = append(m.UnlockedInputs, op)
+
 	return nil
 }
 
@@ -192,6 +197,7 @@ type MockChainBridge struct {
 	FeeEstimateSignal chan struct{}
 	PublishReq        chan *wire.MsgTx
 	ConfReqSignal     chan int
+	BlockEpochs       chan int32
 
 	ReqCount int
 	ConfReqs map[int]*chainntnfs.ConfirmationEvent
@@ -203,6 +209,7 @@ func NewMockChainBridge() *MockChainBridge {
 		PublishReq:        make(chan *wire.MsgTx),
 		ConfReqs:          make(map[int]*chainntnfs.ConfirmationEvent),
 		ConfReqSignal:     make(chan int),
+		BlockEpochs:       make(chan int32),
 	}
 }
 
@@ -249,6 +256,12 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, errChan, nil
 }
 
+func (m *MockChainBridge) RegisterBlockEpochNtfn(
+	_ context.Context) (chan int32, chan error, error) {
+
+	return m.BlockEpochs, make(chan error), nil
+}
+
 func (m *MockChainBridge) CurrentHeight(_ context.Context) (uint32, error) {
 	return 0, nil
 }
@@ -360,11 +373,12 @@ func NewMockGenSigner(keyRing *MockKeyRing) *MockGenSigner {
 }
 
 func (m *MockGenSigner) SignGenesis(desc keychain.KeyDescriptor,
-	gen asset.Genesis) (*btcec.PublicKey, *schnorr.Signature, error) {
+	initialGen asset.Genesis, currentGen *asset.Genesis) (*btcec.PublicKey,
+	*schnorr.Signature, error) {
 
 	priv := m.KeyRing.Keys[desc.KeyLocator]
 	signer := asset.NewRawKeyGenesisSigner(priv)
-	return signer.SignGenesis(desc, gen)
+	return signer.SignGenesis(desc, initialGen, currentGen)
 }
 
 type MockProofArchive struct {
@@ -1,14 +1,20 @@
 package tarogarden
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/proof"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
@@ -38,6 +44,10 @@ type GardenKit struct {
 
 	// ProofFiles stores the set of flat proof files.
 	ProofFiles proof.Archiver
+
+	// ReorgWatcher is used to watch the confirmed minting transactions
+	// for chain reorganizations until they're buried deep enough.
+	ReorgWatcher *ReorgWatcher
 }
 
 // PlanterConfig is the main config for the ChainPlanter.
@@ -82,11 +92,22 @@ func (s *stateReq[T]) Type() reqType {
 	return s.reqType
 }
 
+// stateParamReq is a state request that carries a parameter for the gardener.
+type stateParamReq[T, S any] struct {
+	stateReq[T]
+
+	param S
+}
+
 type reqType uint8
 
 const (
 	reqTypePendingBatch = iota
 	reqTypeNumActiveBatches
+	reqTypeCancelSeedling
+	reqTypeCancelBatch
+	reqTypeFinalizeBatch
+	reqTypeQueueSeedlings
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -145,11 +166,18 @@ func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 // inserts it into the caretaker map.
 func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch) *BatchCaretaker {
 	batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
-	caretaker := NewBatchCaretaker(&BatchCaretakerConfig{
+
+	// The caretaker may be stopped by the gardener while it signals its
+	// completion, so we also need to give up if that happens.
+	var caretaker *BatchCaretaker
+	caretaker = NewBatchCaretaker(&BatchCaretakerConfig{
 		Batch:     batch,
 		GardenKit: c.cfg.GardenKit,
 		SignalCompletion: func() {
-			c.completionSignals <- batchKey
+			select {
+			case c.completionSignals <- batchKey:
+			case <-caretaker.Quit:
+			}
 		},
 		ErrChan: c.cfg.ErrChan,
 	})
@@ -200,6 +228,39 @@ func (c *ChainPlanter) Start() error {
 			}
 		}
 
+		// The reorg watcher only keeps the transactions it watches in
+		// memory, so we need to watch the minting transactions of the
+		// batches that were finalized before our last restart, but
+		// aren't buried deep enough yet, again.
+		unsafeHeight, err := c.cfg.ReorgWatcher.MinUnsafeHeight(ctx)
+		if err != nil {
+			startErr = err
+			return
+		}
+		confirmedBatches, err := c.cfg.Log.FetchConfirmedBatches(
+			ctx, unsafeHeight,
+		)
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		log.Infof("Watching %v recently confirmed batches for reorgs",
+			len(confirmedBatches))
+
+		for _, batch := range confirmedBatches {
+			caretaker := NewBatchCaretaker(&BatchCaretakerConfig{
+				Batch:     batch.MintingBatch,
+				GardenKit: c.cfg.GardenKit,
+				ErrChan:   c.cfg.ErrChan,
+			})
+			err := caretaker.resumeReorgWatch(batch)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		// With all the caretakers for each minting batch launched,
 		// we'll start up the main gardener goroutine so we can accept
 		// new minting requests.
@@ -303,35 +364,14 @@ func (c *ChainPlanter) gardener() {
 				continue
 			}
 
-			// Prep the new care taker that'll be launched assuming
-			// the call below to freeze the batch succeeds.
-			caretaker := c.newCaretakerForBatch(c.pendingBatch)
-
 			// At this point, we have a non-empty batch, so we'll
-			// first finalize it on disk. This means no further
-			// seedlings can be added to this batch.
-			ctx, cancel := c.WithCtxQuit()
-			err := freezeMintingBatch(ctx, c.cfg.Log, c.pendingBatch)
-			cancel()
-			if err != nil {
-				c.cfg.ErrChan <- fmt.Errorf("unable to freeze "+
-					"minting batch: %w", err)
+			// freeze it and launch a caretaker for it, using an
+			// estimated fee rate.
+			if _, err := c.finalizeBatch(0); err != nil {
+				c.cfg.ErrChan <- err
 				continue
 			}
 
-			// Now that the batch has been frozen, we'll launch a
-			// new caretaker state machine for the batch that'll
-			// drive all the seedlings do adulthood.
-			if err := caretaker.Start(); err != nil {
-				c.cfg.ErrChan <- fmt.Errorf("unable to start "+
-					"new caretaker: %w", err)
-				continue
-			}
-
-			// Now that we have a caretaker launched for this
-			// batch, we'll set the pending batch to nil
-			c.pendingBatch = nil
-
 		// A request for new asset issuance just arrived, add this to
 		// the pending batch and acknowledge the receipt back to the
 		// caller.
@@ -403,6 +443,65 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(c.pendingBatch)
 			case reqTypeNumActiveBatches:
 				req.Resolve(len(c.caretakers))
+
+			case reqTypeCancelSeedling:
+				cancelReq := req.(*stateParamReq[bool, string])
+				err := c.cancelSeedling(cancelReq.param)
+				if err != nil {
+					req.Error(err)
+					continue
+				}
+				req.Resolve(true)
+
+			case reqTypeCancelBatch:
+				cancelReq := req.(*stateParamReq[
+					bool, *btcec.PublicKey,
+				])
+				err := c.cancelBatch(cancelReq.param)
+				if err != nil {
+					req.Error(err)
+					continue
+				}
+				req.Resolve(true)
+
+			case reqTypeFinalizeBatch:
+				finalizeReq := req.(*stateParamReq[
+					*MintingBatch, chainfee.SatPerKWeight,
+				])
+				batch, err := c.finalizeBatch(finalizeReq.param)
+				if err != nil {
+					req.Error(err)
+					continue
+				}
+				req.Resolve(batch)
+
+			case reqTypeQueueSeedlings:
+				queueReq := req.(*stateParamReq[
+					*btcec.PublicKey, []*Seedling,
+				])
+
+				ctx, cancel := c.WithCtxQuit()
+				batchNow, err := c.prepTaroSeedlings(
+					ctx, queueReq.param,
+				)
+				cancel()
+				if err != nil {
+					req.Error(err)
+					continue
+				}
+
+				log.Infof("Queued %d new seedlings",
+					len(queueReq.param))
+
+				req.Resolve(c.pendingBatch.BatchKey.PubKey)
+
+				// Just like for a single seedling, we'll force
+				// a new batch if any of the seedlings asked
+				// for it.
+				if batchNow {
+					log.Infof("Forcing new batch")
+					batchTicker <- time.Time{}
+				}
 			}
 
 		case <-c.Quit:
@@ -443,14 +542,303 @@ func (c *ChainPlanter) NumActiveBatches() (int, error) {
 	return <-req.resp, nil
 }
 
+// sendParamReq sends a state request with the given parameter to the gardener
+// and waits for its response.
+func sendParamReq[T, S any](c *ChainPlanter, reqType reqType,
+	param S) (T, error) {
+
+	var zero T
+	req := &stateParamReq[T, S]{
+		stateReq: stateReq[T]{
+			resp:    make(chan T, 1),
+			err:     make(chan error, 1),
+			reqType: reqType,
+		},
+		param: param,
+	}
+
+	if !chanutils.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return zero, fmt.Errorf("chain planter shutting down")
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp, nil
+
+	case err := <-req.err:
+		return zero, err
+
+	case <-c.Quit:
+		return zero, fmt.Errorf("chain planter shutting down")
+	}
+}
+
+// finalizeBatch freezes the pending batch and launches a new caretaker for it,
+// which will drive all the seedlings to adulthood. If a non-zero fee rate is
+// passed, the genesis transaction of the batch is funded with that fee rate.
+// A snapshot of the frozen batch is returned.
+func (c *ChainPlanter) finalizeBatch(
+	feeRate chainfee.SatPerKWeight) (*MintingBatch, error) {
+
+	if c.pendingBatch == nil {
+		return nil, fmt.Errorf("no pending batch")
+	}
+
+	batch := c.pendingBatch
+	batchKey := batch.BatchKey.PubKey
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	// We commit the fee rate to disk first, so it's also used if the
+	// batch is resumed after a restart.
+	if feeRate != 0 {
+		err := c.cfg.Log.UpdateBatchFeeRate(ctx, batchKey, feeRate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update batch fee "+
+				"rate: %w", err)
+		}
+		batch.FeeRate = feeRate
+	}
+
+	// Next, we'll finalize the batch on disk. This means no further
+	// seedlings can be added to this batch.
+	if err := freezeMintingBatch(ctx, c.cfg.Log, batch); err != nil {
+		return nil, fmt.Errorf("unable to freeze minting batch: %w",
+			err)
+	}
+	batch.BatchState = BatchStateFrozen
+
+	// The caretaker modifies the batch as it progresses, so we take a
+	// snapshot before we hand it over.
+	batchSnapshot := *batch
+
+	// Now that the batch has been frozen, we'll launch a new caretaker
+	// state machine for the batch that'll drive all the seedlings to
+	// adulthood.
+	caretaker := c.newCaretakerForBatch(batch)
+	if err := caretaker.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start new caretaker: %w",
+			err)
+	}
+
+	// Now that we have a caretaker launched for this batch, we'll set the
+	// pending batch to nil.
+	c.pendingBatch = nil
+
+	return &batchSnapshot, nil
+}
+
+// cancelPendingBatch marks the pending batch as cancelled, so none of its
+// seedlings will be minted.
+func (c *ChainPlanter) cancelPendingBatch() error {
+	batchKey := c.pendingBatch.BatchKey.PubKey
+
+	log.Infof("Cancelling pending MintingBatch(%x)",
+		batchKey.SerializeCompressed())
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+	err := c.cfg.Log.UpdateBatchState(ctx, batchKey, BatchStateCancelled)
+	if err != nil {
+		return fmt.Errorf("unable to cancel batch: %w", err)
+	}
+
+	c.pendingBatch = nil
+
+	return nil
+}
+
+// cancelSeedling removes the seedling with the given name from the pending
+// batch. If it's the last seedling of the batch, the whole batch is cancelled.
+func (c *ChainPlanter) cancelSeedling(assetName string) error {
+	if c.pendingBatch == nil {
+		return fmt.Errorf("no pending batch")
+	}
+	if _, ok := c.pendingBatch.Seedlings[assetName]; !ok {
+		return fmt.Errorf("seedling %v not found in pending batch",
+			assetName)
+	}
+
+	// An empty batch can't be minted, so we cancel it entirely instead.
+	if len(c.pendingBatch.Seedlings) == 1 {
+		return c.cancelPendingBatch()
+	}
+
+	log.Infof("Cancelling seedling %v of pending MintingBatch(%x)",
+		assetName, c.pendingBatch.BatchKey.PubKey.SerializeCompressed())
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+	err := c.cfg.Log.RemoveSeedlingFromBatch(
+		ctx, c.pendingBatch.BatchKey.PubKey, assetName,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to remove seedling: %w", err)
+	}
+
+	delete(c.pendingBatch.Seedlings, assetName)
+
+	return nil
+}
+
+// cancelBatch cancels the batch with the given key, or the pending batch if
+// no key is passed. If the batch already has a caretaker, the caretaker is
+// stopped and the wallet inputs locked for the genesis transaction are
+// released. Batches with a genesis transaction that may have been broadcast
+// already can't be cancelled.
+func (c *ChainPlanter) cancelBatch(batchKey *btcec.PublicKey) error {
+	if c.pendingBatch != nil && (batchKey == nil ||
+		batchKey.IsEqual(c.pendingBatch.BatchKey.PubKey)) {
+
+		return c.cancelPendingBatch()
+	}
+
+	if batchKey == nil {
+		return fmt.Errorf("no pending batch")
+	}
+
+	serializedKey := asset.ToSerialized(batchKey)
+	caretaker, ok := c.caretakers[serializedKey]
+	if !ok {
+		return fmt.Errorf("no active batch with key %x",
+			batchKey.SerializeCompressed())
+	}
+
+	// We stop the caretaker first, so the batch can't progress any
+	// further while we inspect it.
+	if err := caretaker.Stop(); err != nil {
+		return fmt.Errorf("unable to stop caretaker: %w", err)
+	}
+	delete(c.caretakers, serializedKey)
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+	nonFinalBatches, err := c.cfg.Log.FetchNonFinalBatches(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch batches: %w", err)
+	}
+
+	var batch *MintingBatch
+	for _, nonFinalBatch := range nonFinalBatches {
+		if batchKey.IsEqual(nonFinalBatch.BatchKey.PubKey) {
+			batch = nonFinalBatch
+			break
+		}
+	}
+	if batch == nil {
+		return fmt.Errorf("batch %x already finalized",
+			batchKey.SerializeCompressed())
+	}
+
+	// Once the signed genesis transaction is committed to disk, it may
+	// have been broadcast already. In that case we resume the batch.
+	if batch.BatchState >= BatchStateBroadcast {
+		caretaker := c.newCaretakerForBatch(batch)
+		if err := caretaker.Start(); err != nil {
+			return fmt.Errorf("unable to restart caretaker: %w",
+				err)
+		}
+
+		return fmt.Errorf("genesis transaction of batch %x already "+
+			"broadcast", batchKey.SerializeCompressed())
+	}
+
+	log.Infof("Cancelling MintingBatch(%x) in state %v",
+		batchKey.SerializeCompressed(), batch.BatchState)
+
+	err = c.cfg.Log.UpdateBatchState(ctx, batchKey, BatchStateCancelled)
+	if err != nil {
+		return fmt.Errorf("unable to cancel batch: %w", err)
+	}
+
+	// If the batch was already funded, we release the inputs of the
+	// genesis transaction. A frozen batch may have been funded without
+	// the packet being committed to disk yet, in which case the leases
+	// will expire on their own.
+	if batch.GenesisPacket == nil {
+		return nil
+	}
+	for _, txIn := range batch.GenesisPacket.Pkt.UnsignedTx.TxIn {
+		err := c.cfg.Wallet.UnlockInput(ctx, txIn.PreviousOutPoint)
+		if err != nil {
+			log.Warnf("Unable to unlock input %v of cancelled "+
+				"batch: %v", txIn.PreviousOutPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSeedling performs some basic validation for the seedling. If the
+// seedling is to be issued into an existing asset family, then the family is
+// looked up and attached to the seedling.
+func (c *ChainPlanter) validateSeedling(ctx context.Context,
+	req *Seedling) error {
+
+	// First, we'll perform some basic validation for the seedling.
+	if err := req.validateFields(); err != nil {
+		return err
+	}
+
+	// If the seedling is to be issued into an existing asset family, then
+	// we'll need to look up the family's raw key, so the caretaker is able
+	// to sign the new genesis with it.
+	if req.FamilyKey != nil {
+		famInfo, err := c.cfg.Log.FetchAssetFamily(ctx, req.FamilyKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch asset family %x: %w",
+				req.FamilyKey.SerializeCompressed(), err)
+		}
+
+		if err := validateAssetFamily(req, famInfo); err != nil {
+			return err
+		}
+
+		req.FamilyInfo = famInfo
+	}
+
+	return nil
+}
+
+// newPendingBatch creates a new pending batch that contains the passed
+// seedlings and commits it to disk.
+func (c *ChainPlanter) newPendingBatch(ctx context.Context,
+	seedlings map[string]*Seedling) error {
+
+	// To create a new batch we'll first need to grab a new internal key,
+	// which'll be used in the output we create, and also will serve as the
+	// primary identifier for a batch.
+	newInternalKey, err := c.cfg.KeyRing.DeriveNextKey(ctx, TaroKeyFamily)
+	if err != nil {
+		return err
+	}
+
+	// Create a new batch and commit it to disk so we can pick up where we
+	// left off upon restart.
+	newBatch := &MintingBatch{
+		CreationTime: time.Now(),
+		BatchState:   BatchStatePending,
+		BatchKey:     newInternalKey,
+		Seedlings:    seedlings,
+	}
+	err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
+	if err != nil {
+		return err
+	}
+
+	c.pendingBatch = newBatch
+
+	return nil
+}
+
 // prepTaroSeedling performs some basic validation for the TaroSeedling, then
 // either adds it to an existing pending batch or creates a new batch for it. A
 // bool indicating if a new batch should immediately be created is returned.
 func (c *ChainPlanter) prepTaroSeedling(ctx context.Context,
 	req *Seedling) (bool, error) {
 
-	// First, we'll perform some basic validation for the seedling.
-	if err := req.validateFields(); err != nil {
+	if err := c.validateSeedling(ctx, req); err != nil {
 		return false, err
 	}
 
@@ -462,35 +850,15 @@ func (c *ChainPlanter) prepTaroSeedling(ctx context.Context,
 	case c.pendingBatch == nil:
 		log.Infof("Creating new MintingBatch w/ %v", req)
 
-		// To create a new batch we'll first need to grab a new
-		// internal key, which'll be used in the output we create, and
-		// also will serve as the primary identifier for a batch.
-		newInternalKey, err := c.cfg.KeyRing.DeriveNextKey(
-			ctx, TaroKeyFamily,
-		)
-		if err != nil {
-			return false, err
-		}
-
-		// Create a new batch and commit it to disk so we can pick up
-		// where we left off upon restart.
-		newBatch := &MintingBatch{
-			CreationTime: time.Now(),
-			BatchState:   BatchStatePending,
-			BatchKey:     newInternalKey,
-			Seedlings: map[string]*Seedling{
-				req.AssetName: req,
-			},
-		}
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
-		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
+		err := c.newPendingBatch(ctx, map[string]*Seedling{
+			req.AssetName: req,
+		})
 		if err != nil {
 			return false, err
 		}
 
-		c.pendingBatch = newBatch
-
 	// A batch already exists, so we'll add this seedling to the batch,
 	// committing it to disk fully before we move on.
 	case c.pendingBatch != nil:
@@ -523,6 +891,130 @@ func (c *ChainPlanter) prepTaroSeedling(ctx context.Context,
 	return req.NoBatch, nil
 }
 
+// prepTaroSeedlings validates the full set of seedlings first, then atomically
+// adds all of them to the pending batch, or creates a new batch for them. If
+// any of the seedlings is invalid, then a SeedlingBatchError is returned and
+// none of them are added. A bool indicating if a new batch should immediately
+// be created is returned.
+func (c *ChainPlanter) prepTaroSeedlings(ctx context.Context,
+	reqs []*Seedling) (bool, error) {
+
+	if len(reqs) == 0 {
+		return false, fmt.Errorf("no seedlings specified")
+	}
+
+	// We validate all seedlings before touching the pending batch, so we
+	// can report every invalid seedling to the caller at once.
+	var (
+		batchErr  SeedlingBatchError
+		seedlings = make(map[string]*Seedling, len(reqs))
+		batchNow  bool
+	)
+	for i, req := range reqs {
+		err := c.validateSeedling(ctx, req)
+
+		// The asset names need to be unique within the pending batch,
+		// including the seedlings we're about to add.
+		switch {
+		case err != nil:
+
+		case seedlings[req.AssetName] != nil:
+			err = fmt.Errorf("duplicate asset name %v",
+				req.AssetName)
+
+		case c.pendingBatch != nil &&
+			c.pendingBatch.Seedlings[req.AssetName] != nil:
+
+			err = fmt.Errorf("asset with name %v already in batch",
+				req.AssetName)
+		}
+		if err != nil {
+			seedlingErr := &SeedlingError{
+				Index:     i,
+				AssetName: req.AssetName,
+				Err:       err,
+			}
+			batchErr.Errors = append(batchErr.Errors, seedlingErr)
+			continue
+		}
+
+		seedlings[req.AssetName] = req
+		batchNow = batchNow || req.NoBatch
+	}
+	if len(batchErr.Errors) != 0 {
+		return false, &batchErr
+	}
+
+	// No batch, so we'll create a new one with all the seedlings.
+	if c.pendingBatch == nil {
+		log.Infof("Creating new MintingBatch w/ %d seedlings",
+			len(seedlings))
+
+		if err := c.newPendingBatch(ctx, seedlings); err != nil {
+			return false, err
+		}
+
+		return batchNow, nil
+	}
+
+	// Otherwise, we'll write all seedlings to disk within a single
+	// transaction, and only add them to the pending batch in memory once
+	// that succeeded.
+	log.Infof("Adding %d seedlings to existing MintingBatch",
+		len(seedlings))
+
+	err := c.cfg.Log.AddSeedlingsToBatch(
+		ctx, c.pendingBatch.BatchKey.PubKey, reqs...,
+	)
+	if err != nil {
+		return false, err
+	}
+	for _, req := range reqs {
+		c.pendingBatch.Seedlings[req.AssetName] = req
+	}
+
+	return batchNow, nil
+}
+
+// validateAssetFamily makes sure that the passed seedling can be issued into
+// the given asset family, and that we're in control of the family's raw key.
+func validateAssetFamily(req *Seedling, famInfo *asset.AssetFamily) error {
+	// All assets of a family need to be of the same type, otherwise they
+	// wouldn't be fungible with each other.
+	if req.AssetType != famInfo.Genesis.Type {
+		return fmt.Errorf("%w: family_type=%v, asset_type=%v",
+			ErrFamilyTypeMismatch, famInfo.Genesis.Type,
+			req.AssetType)
+	}
+
+	// The amounts of all assets in a family are summed up, so they also
+	// need to be displayed with the same number of decimal places.
+	famDecimalDisplay := famInfo.Genesis.Meta().DecimalDisplay
+	reqDecimalDisplay := asset.DecodeMeta(req.Metadata).DecimalDisplay
+	if reqDecimalDisplay != famDecimalDisplay {
+		return fmt.Errorf("%w: family_decimal_display=%v, "+
+			"asset_decimal_display=%v", ErrFamilyDecimalMismatch,
+			famDecimalDisplay, reqDecimalDisplay)
+	}
+
+	// If we only learned about the family through a proof, then we don't
+	// know its raw key and therefore can't issue any new assets into it.
+	// We detect this by re-deriving the tweaked key from the raw key.
+	tweakedKey := txscript.ComputeTaprootOutputKey(
+		famInfo.RawKey.PubKey, famInfo.Genesis.FamilyKeyTweak(),
+	)
+	if !bytes.Equal(
+		schnorr.SerializePubKey(tweakedKey),
+		schnorr.SerializePubKey(famInfo.FamKey),
+	) {
+
+		return fmt.Errorf("raw key of asset family %x unknown",
+			famInfo.FamKey.SerializeCompressed())
+	}
+
+	return nil
+}
+
 // QueueNewSeedling attempts to queue a new seedling request (the intent for
 // New asset creation or on going issuance) to the ChainPlanter. A channel is
 // returned where future updates will be sent over. If an error is returned no
@@ -541,14 +1033,91 @@ func (c *ChainPlanter) QueueNewSeedling(req *Seedling) (SeedlingUpdates, error)
 	return req.updates, nil
 }
 
+// QueueNewSeedlings attempts to atomically queue a set of new seedlings into
+// the pending batch. All seedlings are validated first, if any of them is
+// invalid then none are queued and a SeedlingBatchError describing each
+// invalid seedling is returned. Otherwise the key of the batch the seedlings
+// were added to is returned.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) QueueNewSeedlings(
+	reqs []*Seedling) (*btcec.PublicKey, error) {
+
+	return sendParamReq[*btcec.PublicKey](c, reqTypeQueueSeedlings, reqs)
+}
+
+// ListBatches lists the set of batches submitted to the planter, both non-final
+// and finalized ones. If a batch key is passed, only the batch identified by
+// that key is returned.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch,
+	error) {
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	nonFinalBatches, err := c.cfg.Log.FetchNonFinalBatches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch non-final batches: %w",
+			err)
+	}
+	finalBatches, err := c.cfg.Log.FetchFinalizedBatches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch finalized batches: %w",
+			err)
+	}
+
+	allBatches := append(nonFinalBatches, finalBatches...)
+	batches := make([]*MintingBatch, 0, len(allBatches))
+	for _, batch := range allBatches {
+		if batchKey != nil && !batchKey.IsEqual(batch.BatchKey.PubKey) {
+			continue
+		}
+
+		batches = append(batches, batch)
+	}
+
+	// We return the batches in the order they were created, so the
+	// pending batch (if any) is always the last one.
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].CreationTime.Before(batches[j].CreationTime)
+	})
+
+	return batches, nil
+}
+
 // CancelSeedling attempts to cancel the creation of a new asset identified by
-// its name. If the seedling has already progressed to a point where the
-// genesis PSBT has been broadcasted, an error is returned.
+// its name. Only seedlings of the pending batch can be cancelled. If the
+// seedling is the last one of the pending batch, the whole batch is cancelled.
 //
 // NOTE: This is part of the Planter interface.
-func (c *ChainPlanter) CancelSeedling() error {
-	// TODO(roasbeef): actually needed?
-	return nil
+func (c *ChainPlanter) CancelSeedling(assetName string) error {
+	_, err := sendParamReq[bool](c, reqTypeCancelSeedling, assetName)
+	return err
+}
+
+// CancelBatch attempts to cancel a batch identified by its batch key, or the
+// pending batch if no key is passed. Any wallet inputs locked for the genesis
+// transaction are released. If the genesis transaction of the batch has
+// already been broadcast, an error is returned.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) CancelBatch(batchKey *btcec.PublicKey) error {
+	_, err := sendParamReq[bool](c, reqTypeCancelBatch, batchKey)
+	return err
+}
+
+// FinalizeBatch freezes the pending batch immediately, without waiting for the
+// next batch tick, and starts to mint its assets. If a non-zero fee rate is
+// passed, the genesis transaction is funded with that fee rate instead of an
+// estimated one.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) FinalizeBatch(
+	feeRate chainfee.SatPerKWeight) (*MintingBatch, error) {
+
+	return sendParamReq[*MintingBatch](c, reqTypeFinalizeBatch, feeRate)
 }
 
 // A compile-time assertion to make sure that ChainPlanter implements the
@@ -11,7 +11,9 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -23,6 +25,7 @@ import (
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +54,8 @@ type mintingTestHarness struct {
 
 	proofFiles *tarogarden.MockProofArchive
 
+	reorgWatcher *tarogarden.ReorgWatcher
+
 	*testing.T
 
 	errChan chan error
@@ -61,18 +66,33 @@ type mintingTestHarness struct {
 func newMintingTestHarness(t *testing.T, store tarogarden.MintingStore) *mintingTestHarness {
 	keyRing := tarogarden.NewMockKeyRing()
 	genSigner := tarogarden.NewMockGenSigner(keyRing)
+	chain := tarogarden.NewMockChainBridge()
+	errChan := make(chan error, 10)
+
+	reorgWatcher := tarogarden.NewReorgWatcher(
+		&tarogarden.ReorgWatcherConfig{
+			ChainBridge: chain,
+			SafeDepth:   6,
+			ErrChan:     errChan,
+		},
+	)
+	require.NoError(t, reorgWatcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, reorgWatcher.Stop())
+	})
 
 	return &mintingTestHarness{
 		T:     t,
 		store: store,
 		// Use a larger internal so it'll never actually tick and only
 		// rely on our manual ticks.
-		ticker:    ticker.NewForce(time.Hour * 24),
-		wallet:    tarogarden.NewMockWalletAnchor(),
-		chain:     tarogarden.NewMockChainBridge(),
-		keyRing:   keyRing,
-		genSigner: genSigner,
-		errChan:   make(chan error, 10),
+		ticker:       ticker.NewForce(time.Hour * 24),
+		wallet:       tarogarden.NewMockWalletAnchor(),
+		chain:        chain,
+		keyRing:      keyRing,
+		genSigner:    genSigner,
+		reorgWatcher: reorgWatcher,
+		errChan:      errChan,
 	}
 }
 
@@ -86,12 +106,13 @@ func (t *mintingTestHarness) refreshChainPlanter() {
 
 	t.planter = tarogarden.NewChainPlanter(tarogarden.PlanterConfig{
 		GardenKit: tarogarden.GardenKit{
-			Wallet:      t.wallet,
-			ChainBridge: t.chain,
-			Log:         t.store,
-			KeyRing:     t.keyRing,
-			GenSigner:   t.genSigner,
-			ProofFiles:  t.proofFiles,
+			Wallet:       t.wallet,
+			ChainBridge:  t.chain,
+			Log:          t.store,
+			KeyRing:      t.keyRing,
+			GenSigner:    t.genSigner,
+			ProofFiles:   t.proofFiles,
+			ReorgWatcher: t.reorgWatcher,
 		},
 		BatchTicker: t.ticker,
 		ErrChan:     t.errChan,
@@ -239,6 +260,29 @@ func (t *mintingTestHarness) assertGenesisTxFunded() *tarogarden.FundedPsbt {
 	return *pkt
 }
 
+// assertListedBatch asserts that the planter lists exactly one batch, which
+// is in the given state and has the given number of seedlings.
+func (t *mintingTestHarness) assertListedBatch(state tarogarden.BatchState,
+	numSeedlings int) *tarogarden.MintingBatch {
+
+	t.Helper()
+
+	batches, err := t.planter.ListBatches(nil)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, state, batches[0].BatchState)
+	require.Len(t, batches[0].Seedlings, numSeedlings)
+
+	// Filtering by the key of the batch should return the same batch.
+	batchKey := batches[0].BatchKey.PubKey
+	filtered, err := t.planter.ListBatches(batchKey)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.True(t, batchKey.IsEqual(filtered[0].BatchKey.PubKey))
+
+	return batches[0]
+}
+
 // assertSeedlingsExist asserts that all the seedlings are present in the batch.
 func (t *mintingTestHarness) assertSeedlingsExist(
 	seedlings []*tarogarden.Seedling) {
@@ -413,6 +457,7 @@ func testBasicAssetCreation(t *mintingTestHarness) {
 	// seedlings. The batch stored in the log should also match up exactly.
 	t.assertPendingBatchExists(numSeedlings)
 	t.assertSeedlingsExist(seedlings)
+	t.assertListedBatch(tarogarden.BatchStatePending, numSeedlings)
 
 	// Now we'll now we'll force a batch tick which should kick off a new
 	// caretaker that starts to progress the batch all the way to
@@ -510,6 +555,295 @@ func testBasicAssetCreation(t *mintingTestHarness) {
 
 	// At this point there should be no active caretakers.
 	t.assertNumCaretakersActive(0)
+
+	// The finalized batch should still be listed, along with the
+	// transaction that minted its assets.
+	batch := t.assertListedBatch(
+		tarogarden.BatchStateFinalized, numSeedlings,
+	)
+	require.Equal(
+		t, tx.TxHash(), batch.GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+}
+
+// testMintingReorg tests that a finalized batch is rolled back if its minting
+// transaction is reorged out, and finalized again once the transaction is
+// re-confirmed in a different block.
+func testMintingReorg(t *mintingTestHarness) {
+	t.Helper()
+
+	// We start by minting a batch, with the minting transaction confirmed
+	// at height 1.
+	testBasicAssetCreation(t)
+	require.Equal(t, 1, t.reorgWatcher.NumWatchedTxs())
+
+	sendBlock := func(height int32) {
+		select {
+		case t.chain.BlockEpochs <- height:
+		case <-time.After(defaultTimeout):
+			t.Fatalf("block %d not consumed", height)
+		}
+	}
+
+	// A second block at height 1 disconnects the block the minting
+	// transaction was confirmed in, so the batch should go back to the
+	// broadcast state.
+	sendBlock(1)
+	sendBlock(1)
+
+	var batch *tarogarden.MintingBatch
+	err := wait.Predicate(func() bool {
+		batches, err := t.store.FetchNonFinalBatches(
+			context.Background(),
+		)
+		require.NoError(t, err)
+		if len(batches) != 1 {
+			return false
+		}
+
+		batch = batches[0]
+		return batch.BatchState == tarogarden.BatchStateBroadcast
+	}, defaultTimeout)
+	require.NoError(t, err)
+
+	// The minting transaction is now re-confirmed in a different block,
+	// which should finalize the batch again.
+	tx, err := psbt.Extract(batch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{})
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(otherTx), btcutil.NewTx(tx)}, false,
+	)
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash,
+		merkleTree[len(merkleTree)-1], 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{otherTx, tx},
+	}
+
+	reqNo, err := chanutils.RecvOrTimeout(
+		t.chain.ConfReqSignal, defaultTimeout,
+	)
+	require.NoError(t, err)
+	blockHash := block.BlockHash()
+	t.chain.SendConfNtfn(*reqNo, &blockHash, 1, 1, block, tx)
+
+	err = wait.Predicate(func() bool {
+		batches, err := t.store.FetchNonFinalBatches(
+			context.Background(),
+		)
+		require.NoError(t, err)
+		return len(batches) == 0
+	}, defaultTimeout)
+	require.NoError(t, err)
+
+	t.assertNoError()
+}
+
+// testCancelSeedlingsAndBatches tests that seedlings can be removed from the
+// pending batch, and that batches can be cancelled until their genesis
+// transaction is signed.
+func testCancelSeedlingsAndBatches(t *mintingTestHarness) {
+	t.Helper()
+
+	t.refreshChainPlanter()
+
+	// Without a pending batch, there's nothing to cancel.
+	require.Error(t, t.planter.CancelSeedling("unknown"))
+	require.Error(t, t.planter.CancelBatch(nil))
+
+	// We'll queue up a few seedlings, then cancel one of them, which
+	// should only remove that seedling from the pending batch.
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+
+	require.NoError(t, t.planter.CancelSeedling(seedlings[0].AssetName))
+	require.Error(t, t.planter.CancelSeedling(seedlings[0].AssetName))
+	t.assertPendingBatchExists(numSeedlings - 1)
+	t.assertListedBatch(tarogarden.BatchStatePending, numSeedlings-1)
+
+	// Cancelling the pending batch should remove it entirely.
+	require.NoError(t, t.planter.CancelBatch(nil))
+	t.assertNoPendingBatch()
+
+	ctx := context.Background()
+	batches, err := t.store.FetchNonFinalBatches(ctx)
+	require.NoError(t, err)
+	require.Empty(t, batches)
+
+	// Next, we'll queue a new batch and let the caretaker commit to a
+	// funded genesis transaction.
+	seedlings = t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.tickMintingBatch()
+
+	genesisPkt := t.assertGenesisTxFunded()
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	err = wait.Predicate(func() bool {
+		batches, err := t.store.FetchNonFinalBatches(ctx)
+		require.NoError(t, err)
+		return len(batches) == 1 &&
+			batches[0].BatchState == tarogarden.BatchStateCommitted
+	}, defaultTimeout)
+	require.NoError(t, err)
+
+	// Cancelling the committed batch should stop its caretaker and
+	// release the inputs of the genesis transaction.
+	batchKey := t.batchKey.PubKey
+	require.NoError(t, t.planter.CancelBatch(batchKey))
+	t.assertNumCaretakersActive(0)
+
+	batches, err = t.store.FetchNonFinalBatches(ctx)
+	require.NoError(t, err)
+	require.Empty(t, batches)
+
+	var genesisInputs []wire.OutPoint
+	for _, txIn := range genesisPkt.Pkt.UnsignedTx.TxIn {
+		genesisInputs = append(genesisInputs, txIn.PreviousOutPoint)
+	}
+	require.Equal(t, genesisInputs, t.wallet.UnlockedInputs)
+
+	// The batch is gone, so it can't be cancelled again.
+	require.Error(t, t.planter.CancelBatch(batchKey))
+
+	t.assertNoError()
+}
+
+// testFinalizeBatch tests that the pending batch can be finalized manually
+// with a custom fee rate.
+func testFinalizeBatch(t *mintingTestHarness) {
+	t.Helper()
+
+	t.refreshChainPlanter()
+
+	// Without a pending batch, there's nothing to finalize.
+	_, err := t.planter.FinalizeBatch(0)
+	require.Error(t, err)
+
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+
+	// Finalizing the batch should freeze it right away, without waiting
+	// for a batch tick.
+	const feeRate = chainfee.SatPerKWeight(1000)
+	batch, err := t.planter.FinalizeBatch(feeRate)
+	require.NoError(t, err)
+	require.Equal(t, tarogarden.BatchStateFrozen, batch.BatchState)
+	require.Equal(t, feeRate, batch.FeeRate)
+	require.Len(t, batch.Seedlings, numSeedlings)
+	t.assertNoPendingBatch()
+
+	// As we passed a fee rate, the caretaker should fund the genesis
+	// transaction without estimating the fee first.
+	_, err = chanutils.RecvOrTimeout(
+		t.wallet.FundPsbtSignal, defaultTimeout,
+	)
+	require.NoError(t, err)
+	require.Empty(t, t.chain.FeeEstimateSignal)
+
+	// The fee rate should also be committed to disk, so it's used if the
+	// batch is resumed after a restart.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, feeRate, batches[0].FeeRate)
+
+	t.assertNumCaretakersActive(1)
+	t.assertNoError()
+}
+
+// testQueueSeedlingBatch tests that a set of seedlings is queued atomically,
+// and that all invalid seedlings of the set are reported back.
+func testQueueSeedlingBatch(t *mintingTestHarness) {
+	t.Helper()
+
+	t.refreshChainPlanter()
+
+	// An empty set of seedlings can't be queued.
+	_, err := t.planter.QueueNewSeedlings(nil)
+	require.Error(t, err)
+
+	// We'll create a set of seedlings, where some of them are invalid.
+	// None of the seedlings should be queued, and each invalid one should
+	// be reported.
+	const numSeedlings = 5
+	seedlings := t.newRandSeedlings(numSeedlings)
+	seedlings[1].Amount = 0
+	seedlings[3].AssetName = seedlings[2].AssetName
+
+	_, err = t.planter.QueueNewSeedlings(seedlings)
+	var batchErr *tarogarden.SeedlingBatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Errors, 2)
+	require.Equal(t, 1, batchErr.Errors[0].Index)
+	require.ErrorIs(t, batchErr.Errors[0], tarogarden.ErrInvalidAssetAmt)
+	require.Equal(t, 3, batchErr.Errors[1].Index)
+	t.assertNoPendingBatch()
+
+	// queueSeedlings queues the given seedlings in a goroutine, as the
+	// planter will block on deriving a new batch key if needed.
+	queueSeedlings := func(
+		seedlings []*tarogarden.Seedling) chan *btcec.PublicKey {
+
+		batchKeys := make(chan *btcec.PublicKey, 1)
+		go func() {
+			batchKey, err := t.planter.QueueNewSeedlings(seedlings)
+			require.NoError(t, err)
+			batchKeys <- batchKey
+		}()
+
+		return batchKeys
+	}
+
+	// With a valid set of seedlings, a new batch should be created that
+	// holds all of them.
+	seedlings = t.newRandSeedlings(numSeedlings)
+	batchKeys := queueSeedlings(seedlings)
+	t.batchKey = t.assertKeyDerived()
+
+	batchKey, err := chanutils.RecvOrTimeout(batchKeys, defaultTimeout)
+	require.NoError(t, err)
+	require.True(t, t.batchKey.PubKey.IsEqual(*batchKey))
+	t.assertPendingBatchExists(numSeedlings)
+
+	// A second set of seedlings is added to the existing batch. The names
+	// need to be unique within the batch though.
+	moreSeedlings := t.newRandSeedlings(numSeedlings)
+	moreSeedlings[0].AssetName = seedlings[0].AssetName
+	_, err = t.planter.QueueNewSeedlings(moreSeedlings)
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Errors, 1)
+	require.Equal(t, 0, batchErr.Errors[0].Index)
+	t.assertPendingBatchExists(numSeedlings)
+
+	moreSeedlings = t.newRandSeedlings(numSeedlings)
+	batchKey, err = chanutils.RecvOrTimeout(
+		queueSeedlings(moreSeedlings), defaultTimeout,
+	)
+	require.NoError(t, err)
+	require.True(t, t.batchKey.PubKey.IsEqual(*batchKey))
+	t.assertPendingBatchExists(numSeedlings * 2)
+
+	// The seedlings should also be committed to disk.
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Seedlings, numSeedlings*2)
+
+	t.assertNoError()
 }
 
 // mintingStoreCreator is a function closure that is capable of creating a new
@@ -529,6 +863,22 @@ var testCases = []mintingStoreTestCase{
 		name:     "basic_asset_creation",
 		testFunc: testBasicAssetCreation,
 	},
+	{
+		name:     "minting_reorg",
+		testFunc: testMintingReorg,
+	},
+	{
+		name:     "cancel_seedlings_and_batches",
+		testFunc: testCancelSeedlingsAndBatches,
+	},
+	{
+		name:     "finalize_batch",
+		testFunc: testFinalizeBatch,
+	},
+	{
+		name:     "queue_seedling_batch",
+		testFunc: testQueueSeedlingBatch,
+	},
 }
 
 // testBatchedAssetIssuance takes an active testing instance along with a
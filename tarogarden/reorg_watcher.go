@@ -0,0 +1,401 @@
+package tarogarden
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// ReorgWatcherConfig houses all the items that the ReorgWatcher needs to carry
+// out its duties.
+type ReorgWatcherConfig struct {
+	// ChainBridge is used to get notified about new blocks and to
+	// re-register for the confirmation of transactions that were reorged
+	// out of the main chain.
+	ChainBridge ChainBridge
+
+	// SafeDepth is the number of confirmations after which a transaction
+	// is considered safe from chain reorganizations and is no longer
+	// watched. A safe depth of 1 disables the watcher.
+	SafeDepth uint32
+
+	// ErrChan is the main error channel the watcher will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// WatchedTx is a confirmed transaction that is watched for chain
+// reorganizations until it is buried deep enough in the main chain.
+type WatchedTx struct {
+	// Tx is the confirmed transaction.
+	Tx *wire.MsgTx
+
+	// Conf is the confirmation of the transaction the caller already
+	// processed.
+	Conf *chainntnfs.TxConfirmation
+
+	// OnReorg is called once the block that confirmed the transaction is
+	// disconnected from the main chain. Any state that depends on the
+	// block the transaction was confirmed in should be rolled back.
+	//
+	// NOTE: A transaction that is reorged out goes back to the mempool
+	// and is expected to confirm again, so the asset state it created
+	// stays in place until then. We never roll back assets or balances,
+	// as that would need to be re-applied once the transaction confirms
+	// again anyway. Transactions that are double spent during a reorg
+	// aren't handled.
+	OnReorg func(ctx context.Context) error
+
+	// OnConf is called once the transaction is confirmed again after a
+	// reorg. The confirmation includes the new block, so any proofs that
+	// reference the old block can be re-created.
+	OnConf func(ctx context.Context, conf *chainntnfs.TxConfirmation) error
+}
+
+// watchedTxState is the internal state the ReorgWatcher keeps for each
+// watched transaction.
+type watchedTxState struct {
+	*WatchedTx
+
+	// confHeight is the height of the block the transaction is currently
+	// confirmed in. This is zero while we wait for the transaction to be
+	// confirmed again after a reorg.
+	confHeight uint32
+}
+
+// txReConf is a confirmation of a watched transaction that was received after
+// the transaction was reorged out.
+type txReConf struct {
+	txid chainhash.Hash
+	conf *chainntnfs.TxConfirmation
+}
+
+// ReorgWatcher watches the chain for reorganizations that disconnect the
+// blocks our minting and transfer transactions were confirmed in. Once a block
+// is disconnected, each affected transaction is rolled back and we wait for
+// it to confirm again, so its proofs can be re-created with the new block.
+type ReorgWatcher struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ReorgWatcherConfig
+
+	// txs is the set of transactions that are currently watched.
+	//
+	// NOTE: The callbacks of a transaction are never invoked while the
+	// mutex is held, so they can safely add new transactions to watch.
+	txs    map[chainhash.Hash]*watchedTxState
+	txsMtx sync.Mutex
+
+	// reConfs is used to deliver the confirmation of a transaction that
+	// was reorged out to the main goroutine.
+	reConfs chan *txReConf
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*chanutils.ContextGuard
+}
+
+// NewReorgWatcher creates a new reorg watcher based on the passed config.
+func NewReorgWatcher(cfg *ReorgWatcherConfig) *ReorgWatcher {
+	return &ReorgWatcher{
+		cfg:     cfg,
+		txs:     make(map[chainhash.Hash]*watchedTxState),
+		reConfs: make(chan *txReConf),
+		ContextGuard: &chanutils.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start attempts to start the reorg watcher.
+func (w *ReorgWatcher) Start() error {
+	var startErr error
+	w.startOnce.Do(func() {
+		log.Infof("Starting ReorgWatcher with safe depth %d",
+			w.cfg.SafeDepth)
+
+		ctx, cancel := w.WithCtxQuit()
+		defer cancel()
+		bestHeight, err := w.cfg.ChainBridge.CurrentHeight(ctx)
+		if err != nil {
+			startErr = fmt.Errorf("unable to get current height: "+
+				"%w", err)
+			return
+		}
+
+		epochCtx, epochCancel := w.WithCtxQuitNoTimeout()
+		chainBridge := w.cfg.ChainBridge
+		blockChan, errChan, err := chainBridge.RegisterBlockEpochNtfn(
+			epochCtx,
+		)
+		if err != nil {
+			epochCancel()
+			startErr = fmt.Errorf("unable to register for block "+
+				"epochs: %w", err)
+			return
+		}
+
+		w.Wg.Add(1)
+		go w.watchChain(bestHeight, blockChan, errChan, epochCancel)
+	})
+
+	return startErr
+}
+
+// Stop signals the reorg watcher to gracefully exit.
+func (w *ReorgWatcher) Stop() error {
+	var stopErr error
+	w.stopOnce.Do(func() {
+		log.Info("Stopping ReorgWatcher")
+
+		close(w.Quit)
+		w.Wg.Wait()
+	})
+
+	return stopErr
+}
+
+// WatchTx starts watching the given confirmed transaction for chain
+// reorganizations until it reaches the safe depth. The callbacks of the
+// watched transaction are invoked from the main goroutine of the watcher.
+//
+// NOTE: This method never blocks on the callbacks of other transactions, so
+// it's safe to call it from within such a callback.
+func (w *ReorgWatcher) WatchTx(tx *WatchedTx) error {
+	txHash := tx.Tx.TxHash()
+	if tx.Conf == nil {
+		return fmt.Errorf("transaction %v is not confirmed", txHash)
+	}
+
+	// With a safe depth of one, a single confirmation is final.
+	if w.cfg.SafeDepth <= 1 {
+		return nil
+	}
+
+	log.Debugf("Watching tx %v confirmed at height %d for reorgs", txHash,
+		tx.Conf.BlockHeight)
+
+	w.txsMtx.Lock()
+	defer w.txsMtx.Unlock()
+
+	w.txs[txHash] = &watchedTxState{
+		WatchedTx:  tx,
+		confHeight: tx.Conf.BlockHeight,
+	}
+
+	return nil
+}
+
+// MinUnsafeHeight returns the lowest block height transactions can be
+// confirmed at without being buried deep enough to be safe from reorgs yet.
+// The set of watched transactions is only kept in memory, so it's used on
+// startup to find the confirmed transactions that need to be watched again.
+func (w *ReorgWatcher) MinUnsafeHeight(ctx context.Context) (uint32, error) {
+	bestHeight, err := w.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get current height: %w", err)
+	}
+
+	// A transaction confirmed at height h has bestHeight-h+1
+	// confirmations, so it's safe once h <= bestHeight+1-SafeDepth.
+	if bestHeight+1 < w.cfg.SafeDepth {
+		return 0, nil
+	}
+
+	return bestHeight + 2 - w.cfg.SafeDepth, nil
+}
+
+// NumWatchedTxs returns the number of transactions that are currently watched
+// for chain reorganizations.
+func (w *ReorgWatcher) NumWatchedTxs() int {
+	w.txsMtx.Lock()
+	defer w.txsMtx.Unlock()
+
+	return len(w.txs)
+}
+
+// watchChain is the main goroutine of the ReorgWatcher. It tracks the best
+// height of the main chain, and rolls back and re-confirms all watched
+// transactions that were confirmed in a block that's disconnected.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *ReorgWatcher) watchChain(bestHeight uint32, blockChan chan int32,
+	errChan chan error, epochCancel func()) {
+
+	defer w.Wg.Done()
+	defer epochCancel()
+
+	reportErr := func(err error) {
+		log.Errorf("Aborting ReorgWatcher: %v", err)
+
+		select {
+		case w.cfg.ErrChan <- err:
+		case <-w.Quit:
+		}
+	}
+
+	for {
+		select {
+		case height := <-blockChan:
+			newHeight := uint32(height)
+
+			// A block at a height we've already seen means that
+			// all blocks from that height on were disconnected.
+			if newHeight <= bestHeight {
+				log.Infof("Detected chain reorg, new block at "+
+					"height %d replaces best height %d",
+					newHeight, bestHeight)
+
+				if err := w.handleReorg(newHeight); err != nil {
+					reportErr(err)
+					return
+				}
+			}
+			bestHeight = newHeight
+
+			w.pruneSafeTxs(bestHeight)
+
+		case reConf := <-w.reConfs:
+			w.txsMtx.Lock()
+			tx, ok := w.txs[reConf.txid]
+			if ok {
+				tx.confHeight = reConf.conf.BlockHeight
+			}
+			w.txsMtx.Unlock()
+
+			if !ok {
+				continue
+			}
+
+			log.Infof("Reorged tx %v confirmed again at height %d",
+				reConf.txid, reConf.conf.BlockHeight)
+
+			ctx, cancel := w.CtxBlocking()
+			err := tx.OnConf(ctx, reConf.conf)
+			cancel()
+			if err != nil {
+				reportErr(fmt.Errorf("unable to re-confirm tx "+
+					"%v: %w", reConf.txid, err))
+				return
+			}
+
+		case err := <-errChan:
+			reportErr(fmt.Errorf("error receiving block epoch: %w",
+				err))
+			return
+
+		case <-w.Quit:
+			return
+		}
+	}
+}
+
+// pruneSafeTxs stops watching all transactions that are buried deep enough
+// below the given best height.
+func (w *ReorgWatcher) pruneSafeTxs(bestHeight uint32) {
+	w.txsMtx.Lock()
+	defer w.txsMtx.Unlock()
+
+	for txHash, tx := range w.txs {
+		if tx.confHeight == 0 ||
+			bestHeight+1 < tx.confHeight+w.cfg.SafeDepth {
+
+			continue
+		}
+
+		log.Debugf("Tx %v reached safe depth, no longer watching for "+
+			"reorgs", txHash)
+
+		delete(w.txs, txHash)
+	}
+}
+
+// handleReorg rolls back all watched transactions that were confirmed at or
+// above the given height, and registers for their confirmation again.
+func (w *ReorgWatcher) handleReorg(height uint32) error {
+	// Transactions that still wait for their confirmation, or that were
+	// confirmed below the disconnected blocks, aren't affected.
+	var reorgedTxs []*watchedTxState
+	w.txsMtx.Lock()
+	for _, tx := range w.txs {
+		if tx.confHeight == 0 || tx.confHeight < height {
+			continue
+		}
+
+		log.Infof("Tx %v confirmed at height %d was reorged out",
+			tx.Tx.TxHash(), tx.confHeight)
+
+		tx.confHeight = 0
+		reorgedTxs = append(reorgedTxs, tx)
+	}
+	w.txsMtx.Unlock()
+
+	for _, tx := range reorgedTxs {
+		ctx, cancel := w.CtxBlocking()
+		err := tx.OnReorg(ctx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to roll back tx %v: %w",
+				tx.Tx.TxHash(), err)
+		}
+
+		if err := w.registerReConf(tx.Tx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerReConf registers for the confirmation of a transaction that was
+// reorged out, and launches a goroutine that delivers it to the main
+// goroutine.
+func (w *ReorgWatcher) registerReConf(tx *wire.MsgTx, heightHint uint32) error {
+	txHash := tx.TxHash()
+	confCtx, confCancel := w.WithCtxQuitNoTimeout()
+	confNtfn, errChan, err := w.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, tx.TxOut[0].PkScript, 1, heightHint, true,
+	)
+	if err != nil {
+		confCancel()
+		return fmt.Errorf("unable to register for conf of reorged "+
+			"tx %v: %w", txHash, err)
+	}
+
+	w.Wg.Add(1)
+	go func() {
+		defer w.Wg.Done()
+		defer confCancel()
+
+		var confEvent *chainntnfs.TxConfirmation
+		select {
+		case confEvent = <-confNtfn.Confirmed:
+
+		case err := <-errChan:
+			select {
+			case w.cfg.ErrChan <- fmt.Errorf("error getting "+
+				"confirmation of reorged tx %v: %w", txHash,
+				err):
+			case <-w.Quit:
+			}
+			return
+
+		case <-confCtx.Done():
+			return
+		}
+
+		select {
+		case w.reConfs <- &txReConf{txid: txHash, conf: confEvent}:
+		case <-w.Quit:
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package tarogarden_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// reorgTestTx is a transaction watched by the reorg watcher that records the
+// callbacks invoked for it.
+type reorgTestTx struct {
+	tx      *wire.MsgTx
+	reorgs  chan struct{}
+	reConfs chan *chainntnfs.TxConfirmation
+}
+
+// watchTestTx creates a new random transaction confirmed at the given height
+// and hands it over to the reorg watcher.
+func watchTestTx(t *testing.T, w *tarogarden.ReorgWatcher,
+	height uint32) *reorgTestTx {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	tx.AddTxOut(&wire.TxOut{PkScript: test.RandBytes(34), Value: 1000})
+
+	testTx := &reorgTestTx{
+		tx:      tx,
+		reorgs:  make(chan struct{}, 1),
+		reConfs: make(chan *chainntnfs.TxConfirmation, 1),
+	}
+	err := w.WatchTx(&tarogarden.WatchedTx{
+		Tx: tx,
+		Conf: &chainntnfs.TxConfirmation{
+			BlockHash:   &chainhash.Hash{},
+			BlockHeight: height,
+			Tx:          tx,
+		},
+		OnReorg: func(context.Context) error {
+			testTx.reorgs <- struct{}{}
+			return nil
+		},
+		OnConf: func(_ context.Context,
+			conf *chainntnfs.TxConfirmation) error {
+
+			testTx.reConfs <- conf
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	return testTx
+}
+
+// TestReorgWatcher tests that the reorg watcher rolls back and re-confirms
+// the transactions that are affected by a chain reorganization, and that it
+// stops watching transactions once they reached the safe depth.
+func TestReorgWatcher(t *testing.T) {
+	const safeDepth = 3
+
+	chainBridge := tarogarden.NewMockChainBridge()
+	errChan := make(chan error, 1)
+	w := tarogarden.NewReorgWatcher(&tarogarden.ReorgWatcherConfig{
+		ChainBridge: chainBridge,
+		SafeDepth:   safeDepth,
+		ErrChan:     errChan,
+	})
+	require.NoError(t, w.Start())
+	t.Cleanup(func() {
+		require.NoError(t, w.Stop())
+	})
+
+	sendBlock := func(height int32) {
+		select {
+		case chainBridge.BlockEpochs <- height:
+		case <-time.After(testTimeout):
+			t.Fatalf("block %d not consumed", height)
+		}
+	}
+
+	// We watch two transactions, one confirmed in block 1 and one in
+	// block 2.
+	sendBlock(1)
+	sendBlock(2)
+	tx1 := watchTestTx(t, w, 1)
+	tx2 := watchTestTx(t, w, 2)
+	require.Equal(t, 2, w.NumWatchedTxs())
+
+	// A new block at height 2 replaces the block the second transaction
+	// was confirmed in, so only that one should be rolled back.
+	sendBlock(2)
+	_, err := chanutils.RecvOrTimeout(tx2.reorgs, testTimeout)
+	require.NoError(t, err)
+	require.Empty(t, tx1.reorgs)
+
+	// We now expect a confirmation request for the reorged transaction.
+	// Once it confirms again in block 3, the new confirmation should be
+	// delivered.
+	reqNo, err := chanutils.RecvOrTimeout(
+		chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	newBlockHash := chainhash.Hash{3}
+	newBlock := &wire.MsgBlock{Transactions: []*wire.MsgTx{tx2.tx}}
+	sendBlock(3)
+	chainBridge.SendConfNtfn(*reqNo, &newBlockHash, 3, 0, newBlock, tx2.tx)
+
+	reConf, err := chanutils.RecvOrTimeout(tx2.reConfs, testTimeout)
+	require.NoError(t, err)
+	require.Equal(t, newBlockHash, *(*reConf).BlockHash)
+	require.Equal(t, uint32(3), (*reConf).BlockHeight)
+
+	// With block 3, the first transaction reached the safe depth. The
+	// second one needs another two blocks.
+	require.Eventually(t, func() bool {
+		return w.NumWatchedTxs() == 1
+	}, testTimeout, testPollInterval)
+
+	sendBlock(4)
+	sendBlock(5)
+	require.Eventually(t, func() bool {
+		return w.NumWatchedTxs() == 0
+	}, testTimeout, testPollInterval)
+
+	// A reorg of transactions that are no longer watched has no effect.
+	sendBlock(3)
+	require.Empty(t, tx1.reorgs)
+	require.Empty(t, tx2.reorgs)
+	require.Empty(t, errChan)
+}
@@ -2,6 +2,7 @@ package tarogarden
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taro/asset"
@@ -21,8 +22,80 @@ var (
 	// ErrInvalidAssetAmt is returned in an asset request has an invalid
 	// amount.
 	ErrInvalidAssetAmt = fmt.Errorf("asset amt cannot be zero")
+
+	// ErrConflictingFamily is returned if an asset request both asks for
+	// a new asset family and specifies an existing family to issue into.
+	ErrConflictingFamily = fmt.Errorf("emission cannot be enabled for " +
+		"an asset issued into an existing family")
+
+	// ErrNoAssetFamily is returned if the family key of an asset request
+	// doesn't reference a known asset family.
+	ErrNoAssetFamily = fmt.Errorf("unknown asset family")
+
+	// ErrFamilyTypeMismatch is returned if the type of an asset request
+	// doesn't match the type of the existing family it's issued into.
+	ErrFamilyTypeMismatch = fmt.Errorf("asset type doesn't match type " +
+		"of asset family")
+
+	// ErrInvalidAssetMeta is returned if the metadata of an asset request
+	// doesn't match its declared metadata type.
+	ErrInvalidAssetMeta = fmt.Errorf("invalid asset metadata")
+
+	// ErrFamilyDecimalMismatch is returned if the decimal display of an
+	// asset request doesn't match the decimal display of the existing
+	// family it's issued into.
+	ErrFamilyDecimalMismatch = fmt.Errorf("asset decimal display doesn't " +
+		"match decimal display of asset family")
+
+	// ErrCollectibleDecimals is returned if a collectible asset request
+	// declares a decimal display, as collectibles can't be divided.
+	ErrCollectibleDecimals = fmt.Errorf("collectible assets cannot have " +
+		"a decimal display")
 )
 
+// SeedlingError describes why a single seedling of a batch of seedlings was
+// rejected.
+type SeedlingError struct {
+	// Index is the position of the seedling within the batch of seedlings.
+	Index int
+
+	// AssetName is the name of the rejected seedling.
+	AssetName string
+
+	// Err is the reason the seedling was rejected.
+	Err error
+}
+
+// Error returns a human readable representation of the SeedlingError.
+func (e *SeedlingError) Error() string {
+	return fmt.Sprintf("seedling %d (%v): %v", e.Index, e.AssetName,
+		e.Err)
+}
+
+// Unwrap returns the reason the seedling was rejected.
+func (e *SeedlingError) Unwrap() error {
+	return e.Err
+}
+
+// SeedlingBatchError is returned if one or more seedlings of a batch of
+// seedlings were rejected. In that case none of the seedlings are queued.
+type SeedlingBatchError struct {
+	// Errors is the set of rejected seedlings, in the order they appear
+	// in the batch of seedlings.
+	Errors []*SeedlingError
+}
+
+// Error returns a human readable representation of the SeedlingBatchError.
+func (e *SeedlingBatchError) Error() string {
+	errStrs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		errStrs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d invalid seedling(s): %v", len(e.Errors),
+		strings.Join(errStrs, ", "))
+}
+
 // MintingState is an enum that tracks an asset through the various minting
 // stages.
 type MintingState uint8
@@ -76,9 +149,8 @@ type Seedling struct {
 	// AssetName is the name of the asset.
 	AssetName string
 
-	// Metadata is the set of metadata associated with the asset.
-	//
-	// TODO(roasbeef): redundant w/ the above?
+	// Metadata is the set of metadata associated with the asset. This is
+	// either an opaque blob or an encoded asset.Meta envelope.
 	Metadata []byte
 
 	// Amount is the total amount of the asset.
@@ -88,6 +160,16 @@ type Seedling struct {
 	// for this asset meaning future assets linked to it can be created.
 	EnableEmission bool
 
+	// FamilyKey, if set, is the tweaked family key of an existing asset
+	// family the asset will be issued into. This allows the on going
+	// emission of an asset that was created with EnableEmission set.
+	FamilyKey *btcec.PublicKey
+
+	// FamilyInfo holds the information of the asset family referenced by
+	// FamilyKey that's needed to sign the genesis of the new asset. This
+	// is looked up by the planter when the seedling is added to a batch.
+	FamilyInfo *asset.AssetFamily
+
 	// NoBatch if true, then this asset will be bundled immediately in a
 	// batch without waiting for the normal ticker.
 	NoBatch bool
@@ -119,6 +201,24 @@ func (c Seedling) validateFields() error {
 	// Creating an asset with zero available supply is not allowed.
 	case c.Amount == 0:
 		return ErrInvalidAssetAmt
+
+	// An asset either creates a new family or is issued into an existing
+	// one, but not both.
+	case c.EnableEmission && c.FamilyKey != nil:
+		return ErrConflictingFamily
+	}
+
+	// Typed metadata must match its declared type, as it can't be changed
+	// once the asset is minted.
+	meta := asset.DecodeMeta(c.Metadata)
+	if err := meta.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAssetMeta, err)
+	}
+
+	// A collectible always has an amount of exactly one unit, so it can't
+	// be displayed with decimal places.
+	if c.AssetType == asset.Collectible && meta.DecimalDisplay != 0 {
+		return ErrCollectibleDecimals
 	}
 
 	return nil
@@ -74,6 +74,251 @@ func (AssetType) EnumDescriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{0}
 }
 
+type AssetMetaType int32
+
+const (
+	//
+	//Metadata without any known structure. This is also the type of all
+	//metadata that wasn't created with an explicit type.
+	AssetMetaType_META_TYPE_OPAQUE AssetMetaType = 0
+	// Metadata that is a valid JSON document.
+	AssetMetaType_META_TYPE_JSON AssetMetaType = 1
+	// Metadata that is a media file of a given MIME type, for example an image.
+	AssetMetaType_META_TYPE_MEDIA AssetMetaType = 2
+)
+
+// Enum value maps for AssetMetaType.
+var (
+	AssetMetaType_name = map[int32]string{
+		0: "META_TYPE_OPAQUE",
+		1: "META_TYPE_JSON",
+		2: "META_TYPE_MEDIA",
+	}
+	AssetMetaType_value = map[string]int32{
+		"META_TYPE_OPAQUE": 0,
+		"META_TYPE_JSON":   1,
+		"META_TYPE_MEDIA":  2,
+	}
+)
+
+func (x AssetMetaType) Enum() *AssetMetaType {
+	p := new(AssetMetaType)
+	*p = x
+	return p
+}
+
+func (x AssetMetaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetMetaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[1].Descriptor()
+}
+
+func (AssetMetaType) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[1]
+}
+
+func (x AssetMetaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetMetaType.Descriptor instead.
+func (AssetMetaType) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{1}
+}
+
+type BatchState int32
+
+const (
+	BatchState_BATCH_STATE_UNKNOWN   BatchState = 0
+	BatchState_BATCH_STATE_PENDING   BatchState = 1
+	BatchState_BATCH_STATE_FROZEN    BatchState = 2
+	BatchState_BATCH_STATE_COMMITTED BatchState = 3
+	BatchState_BATCH_STATE_BROADCAST BatchState = 4
+	BatchState_BATCH_STATE_CONFIRMED BatchState = 5
+	BatchState_BATCH_STATE_FINALIZED BatchState = 6
+	BatchState_BATCH_STATE_CANCELLED BatchState = 7
+)
+
+// Enum value maps for BatchState.
+var (
+	BatchState_name = map[int32]string{
+		0: "BATCH_STATE_UNKNOWN",
+		1: "BATCH_STATE_PENDING",
+		2: "BATCH_STATE_FROZEN",
+		3: "BATCH_STATE_COMMITTED",
+		4: "BATCH_STATE_BROADCAST",
+		5: "BATCH_STATE_CONFIRMED",
+		6: "BATCH_STATE_FINALIZED",
+		7: "BATCH_STATE_CANCELLED",
+	}
+	BatchState_value = map[string]int32{
+		"BATCH_STATE_UNKNOWN":   0,
+		"BATCH_STATE_PENDING":   1,
+		"BATCH_STATE_FROZEN":    2,
+		"BATCH_STATE_COMMITTED": 3,
+		"BATCH_STATE_BROADCAST": 4,
+		"BATCH_STATE_CONFIRMED": 5,
+		"BATCH_STATE_FINALIZED": 6,
+		"BATCH_STATE_CANCELLED": 7,
+	}
+)
+
+func (x BatchState) Enum() *BatchState {
+	p := new(BatchState)
+	*p = x
+	return p
+}
+
+func (x BatchState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchState) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[2].Descriptor()
+}
+
+func (BatchState) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[2]
+}
+
+func (x BatchState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchState.Descriptor instead.
+func (BatchState) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{2}
+}
+
+type AssetHistoryEventType int32
+
+const (
+	// The asset was minted by the daemon.
+	AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_MINT AssetHistoryEventType = 0
+	// The asset was received through a Taro address.
+	AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_RECEIVE AssetHistoryEventType = 1
+	// The asset was sent by an outbound transfer.
+	AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_SEND AssetHistoryEventType = 2
+	// The asset was burned by an outbound transfer.
+	AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_BURN AssetHistoryEventType = 3
+)
+
+// Enum value maps for AssetHistoryEventType.
+var (
+	AssetHistoryEventType_name = map[int32]string{
+		0: "ASSET_HISTORY_EVENT_TYPE_MINT",
+		1: "ASSET_HISTORY_EVENT_TYPE_RECEIVE",
+		2: "ASSET_HISTORY_EVENT_TYPE_SEND",
+		3: "ASSET_HISTORY_EVENT_TYPE_BURN",
+	}
+	AssetHistoryEventType_value = map[string]int32{
+		"ASSET_HISTORY_EVENT_TYPE_MINT":    0,
+		"ASSET_HISTORY_EVENT_TYPE_RECEIVE": 1,
+		"ASSET_HISTORY_EVENT_TYPE_SEND":    2,
+		"ASSET_HISTORY_EVENT_TYPE_BURN":    3,
+	}
+)
+
+func (x AssetHistoryEventType) Enum() *AssetHistoryEventType {
+	p := new(AssetHistoryEventType)
+	*p = x
+	return p
+}
+
+func (x AssetHistoryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetHistoryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[3].Descriptor()
+}
+
+func (AssetHistoryEventType) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[3]
+}
+
+func (x AssetHistoryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetHistoryEventType.Descriptor instead.
+func (AssetHistoryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{3}
+}
+
+type VMStepType int32
+
+const (
+	VMStepType_VM_STEP_TYPE_UNKNOWN VMStepType = 0
+	// Validation of a genesis asset, which must not have any inputs.
+	VMStepType_VM_STEP_TYPE_GENESIS_VALIDATION VMStepType = 1
+	// Validation of an asset split against its split commitment root.
+	VMStepType_VM_STEP_TYPE_SPLIT_VALIDATION VMStepType = 2
+	// Ensures the sum of the inputs matches the sum of the outputs.
+	VMStepType_VM_STEP_TYPE_INFLATION_CHECK VMStepType = 3
+	// Ensures the new asset holds the same genesis as the input it spends.
+	VMStepType_VM_STEP_TYPE_GENESIS_MATCH VMStepType = 4
+	// Static checks of an input witness, such as its sighash flags.
+	VMStepType_VM_STEP_TYPE_WITNESS_CHECK VMStepType = 5
+	// The execution of a single opcode of an input witness script.
+	VMStepType_VM_STEP_TYPE_SCRIPT_OPCODE VMStepType = 6
+	// The final check of the stack after an input witness was executed.
+	VMStepType_VM_STEP_TYPE_SCRIPT_RESULT VMStepType = 7
+)
+
+// Enum value maps for VMStepType.
+var (
+	VMStepType_name = map[int32]string{
+		0: "VM_STEP_TYPE_UNKNOWN",
+		1: "VM_STEP_TYPE_GENESIS_VALIDATION",
+		2: "VM_STEP_TYPE_SPLIT_VALIDATION",
+		3: "VM_STEP_TYPE_INFLATION_CHECK",
+		4: "VM_STEP_TYPE_GENESIS_MATCH",
+		5: "VM_STEP_TYPE_WITNESS_CHECK",
+		6: "VM_STEP_TYPE_SCRIPT_OPCODE",
+		7: "VM_STEP_TYPE_SCRIPT_RESULT",
+	}
+	VMStepType_value = map[string]int32{
+		"VM_STEP_TYPE_UNKNOWN":            0,
+		"VM_STEP_TYPE_GENESIS_VALIDATION": 1,
+		"VM_STEP_TYPE_SPLIT_VALIDATION":   2,
+		"VM_STEP_TYPE_INFLATION_CHECK":    3,
+		"VM_STEP_TYPE_GENESIS_MATCH":      4,
+		"VM_STEP_TYPE_WITNESS_CHECK":      5,
+		"VM_STEP_TYPE_SCRIPT_OPCODE":      6,
+		"VM_STEP_TYPE_SCRIPT_RESULT":      7,
+	}
+)
+
+func (x VMStepType) Enum() *VMStepType {
+	p := new(VMStepType)
+	*p = x
+	return p
+}
+
+func (x VMStepType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VMStepType) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[4].Descriptor()
+}
+
+func (VMStepType) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[4]
+}
+
+func (x VMStepType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VMStepType.Descriptor instead.
+func (VMStepType) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{4}
+}
+
 type AddrEventStatus int32
 
 const (
@@ -82,6 +327,11 @@ const (
 	AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED AddrEventStatus = 2
 	AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED        AddrEventStatus = 3
 	AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED             AddrEventStatus = 4
+	AddrEventStatus_ADDR_EVENT_STATUS_EXPIRED               AddrEventStatus = 5
+	AddrEventStatus_ADDR_EVENT_STATUS_MAX_RECEIVES_EXCEEDED AddrEventStatus = 6
+	AddrEventStatus_ADDR_EVENT_STATUS_PROOF_INVALID         AddrEventStatus = 7
+	AddrEventStatus_ADDR_EVENT_STATUS_ASSET_MISMATCH        AddrEventStatus = 8
+	AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FAILED    AddrEventStatus = 9
 )
 
 // Enum value maps for AddrEventStatus.
@@ -92,6 +342,11 @@ var (
 		2: "ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED",
 		3: "ADDR_EVENT_STATUS_PROOF_RECEIVED",
 		4: "ADDR_EVENT_STATUS_COMPLETED",
+		5: "ADDR_EVENT_STATUS_EXPIRED",
+		6: "ADDR_EVENT_STATUS_MAX_RECEIVES_EXCEEDED",
+		7: "ADDR_EVENT_STATUS_PROOF_INVALID",
+		8: "ADDR_EVENT_STATUS_ASSET_MISMATCH",
+		9: "ADDR_EVENT_STATUS_TRANSACTION_FAILED",
 	}
 	AddrEventStatus_value = map[string]int32{
 		"ADDR_EVENT_STATUS_UNKNOWN":               0,
@@ -99,6 +354,11 @@ var (
 		"ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED": 2,
 		"ADDR_EVENT_STATUS_PROOF_RECEIVED":        3,
 		"ADDR_EVENT_STATUS_COMPLETED":             4,
+		"ADDR_EVENT_STATUS_EXPIRED":               5,
+		"ADDR_EVENT_STATUS_MAX_RECEIVES_EXCEEDED": 6,
+		"ADDR_EVENT_STATUS_PROOF_INVALID":         7,
+		"ADDR_EVENT_STATUS_ASSET_MISMATCH":        8,
+		"ADDR_EVENT_STATUS_TRANSACTION_FAILED":    9,
 	}
 )
 
@@ -113,11 +373,11 @@ func (x AddrEventStatus) String() string {
 }
 
 func (AddrEventStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_taro_proto_enumTypes[1].Descriptor()
+	return file_taro_proto_enumTypes[5].Descriptor()
 }
 
 func (AddrEventStatus) Type() protoreflect.EnumType {
-	return &file_taro_proto_enumTypes[1]
+	return &file_taro_proto_enumTypes[5]
 }
 
 func (x AddrEventStatus) Number() protoreflect.EnumNumber {
@@ -126,7 +386,77 @@ func (x AddrEventStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddrEventStatus.Descriptor instead.
 func (AddrEventStatus) EnumDescriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{1}
+	return file_taro_proto_rawDescGZIP(), []int{5}
+}
+
+type CoinSelectStrategy int32
+
+const (
+	// Select the first asset commitment that satisfies the send.
+	CoinSelectStrategy_COIN_SELECT_DEFAULT CoinSelectStrategy = 0
+	//
+	//Select the asset commitment with the smallest amount that is still enough
+	//to satisfy the send.
+	CoinSelectStrategy_COIN_SELECT_SMALLEST_SUFFICIENT CoinSelectStrategy = 1
+	// Select the asset commitment with the largest amount.
+	CoinSelectStrategy_COIN_SELECT_LARGEST_FIRST CoinSelectStrategy = 2
+	// Select the asset commitment that was confirmed the earliest.
+	CoinSelectStrategy_COIN_SELECT_OLDEST_FIRST CoinSelectStrategy = 3
+	//
+	//Select the asset commitment with the fewest unrelated assets co-located in
+	//the same anchor output.
+	CoinSelectStrategy_COIN_SELECT_PRIVACY CoinSelectStrategy = 4
+	//
+	//Select an asset commitment with the exact amount of the send if there is
+	//one, so no split is needed.
+	CoinSelectStrategy_COIN_SELECT_MINIMIZE_SPLIT CoinSelectStrategy = 5
+)
+
+// Enum value maps for CoinSelectStrategy.
+var (
+	CoinSelectStrategy_name = map[int32]string{
+		0: "COIN_SELECT_DEFAULT",
+		1: "COIN_SELECT_SMALLEST_SUFFICIENT",
+		2: "COIN_SELECT_LARGEST_FIRST",
+		3: "COIN_SELECT_OLDEST_FIRST",
+		4: "COIN_SELECT_PRIVACY",
+		5: "COIN_SELECT_MINIMIZE_SPLIT",
+	}
+	CoinSelectStrategy_value = map[string]int32{
+		"COIN_SELECT_DEFAULT":             0,
+		"COIN_SELECT_SMALLEST_SUFFICIENT": 1,
+		"COIN_SELECT_LARGEST_FIRST":       2,
+		"COIN_SELECT_OLDEST_FIRST":        3,
+		"COIN_SELECT_PRIVACY":             4,
+		"COIN_SELECT_MINIMIZE_SPLIT":      5,
+	}
+)
+
+func (x CoinSelectStrategy) Enum() *CoinSelectStrategy {
+	p := new(CoinSelectStrategy)
+	*p = x
+	return p
+}
+
+func (x CoinSelectStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoinSelectStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[6].Descriptor()
+}
+
+func (CoinSelectStrategy) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[6]
+}
+
+func (x CoinSelectStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoinSelectStrategy.Descriptor instead.
+func (CoinSelectStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{6}
 }
 
 type MintAssetRequest struct {
@@ -154,6 +484,24 @@ type MintAssetRequest struct {
 	//If true, then a batch will be created immediately. Otherwise the asset
 	//creation transaction may be batched with other pending minting requests.
 	SkipBatch bool `protobuf:"varint,6,opt,name=skip_batch,json=skipBatch,proto3" json:"skip_batch,omitempty"`
+	//
+	//The tweaked family key of an existing asset family the new asset should be
+	//issued into. The family must have been created by this node with
+	//enable_emission set. This is used to issue more units of an existing asset
+	//and cannot be combined with enable_emission.
+	FamilyKey []byte `protobuf:"bytes,7,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	//
+	//The type of the meta_data. Typed metadata is validated before the asset is
+	//minted and is committed to in a typed envelope.
+	MetaType AssetMetaType `protobuf:"varint,8,opt,name=meta_type,json=metaType,proto3,enum=tarorpc.AssetMetaType" json:"meta_type,omitempty"`
+	// The MIME type of the meta_data, required if meta_type is media.
+	MetaMimeType string `protobuf:"bytes,9,opt,name=meta_mime_type,json=metaMimeType,proto3" json:"meta_mime_type,omitempty"`
+	//
+	//The number of decimal places the amounts of the asset should be displayed
+	//with. This is committed to in the metadata of the asset and therefore can't
+	//be changed after the asset is minted. An asset with a decimal display of 2
+	//and an amount of 1234 units is displayed as 12.34.
+	DecimalDisplay uint32 `protobuf:"varint,10,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
@@ -230,6 +578,34 @@ func (x *MintAssetRequest) GetSkipBatch() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *MintAssetRequest) GetMetaType() AssetMetaType {
+	if x != nil {
+		return x.MetaType
+	}
+	return AssetMetaType_META_TYPE_OPAQUE
+}
+
+func (x *MintAssetRequest) GetMetaMimeType() string {
+	if x != nil {
+		return x.MetaMimeType
+	}
+	return ""
+}
+
+func (x *MintAssetRequest) GetDecimalDisplay() uint32 {
+	if x != nil {
+		return x.DecimalDisplay
+	}
+	return 0
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -281,14 +657,20 @@ func (x *MintAssetResponse) GetBatchKey() []byte {
 	return nil
 }
 
-type ListAssetRequest struct {
+type MintAssetBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The set of assets to mint. The assets are added to the pending batch in
+	//the given order. If any of the assets has skip_batch set, then the batch
+	//is created immediately after all assets were added.
+	Assets []*MintAssetRequest `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
 }
 
-func (x *ListAssetRequest) Reset() {
-	*x = ListAssetRequest{}
+func (x *MintAssetBatchRequest) Reset() {
+	*x = MintAssetBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,13 +678,13 @@ func (x *ListAssetRequest) Reset() {
 	}
 }
 
-func (x *ListAssetRequest) String() string {
+func (x *MintAssetBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssetRequest) ProtoMessage() {}
+func (*MintAssetBatchRequest) ProtoMessage() {}
 
-func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
+func (x *MintAssetBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,31 +696,33 @@ func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssetRequest.ProtoReflect.Descriptor instead.
-func (*ListAssetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MintAssetBatchRequest.ProtoReflect.Descriptor instead.
+func (*MintAssetBatchRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{2}
 }
 
-type AnchorInfo struct {
+func (x *MintAssetBatchRequest) GetAssets() []*MintAssetRequest {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type MintAssetBatchError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction that anchors the Taro commitment where the asset resides.
-	AnchorTx []byte `protobuf:"bytes,1,opt,name=anchor_tx,json=anchorTx,proto3" json:"anchor_tx,omitempty"`
-	// The txid of the above transaction.
-	AnchorTxid []byte `protobuf:"bytes,2,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
-	// The block hash the contains the anchor transaction above.
-	AnchorBlockHash []byte `protobuf:"bytes,3,opt,name=anchor_block_hash,json=anchorBlockHash,proto3" json:"anchor_block_hash,omitempty"`
-	// The outpoint (txid:vout) that stores the Taro commitment.
-	AnchorOutpoint string `protobuf:"bytes,4,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
-	//
-	//The raw internal key that was used to create the anchor Taproot output key.
-	InternalKey []byte `protobuf:"bytes,5,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The position of the invalid asset within the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The name of the invalid asset.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason the asset was rejected.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *AnchorInfo) Reset() {
-	*x = AnchorInfo{}
+func (x *MintAssetBatchError) Reset() {
+	*x = MintAssetBatchError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,13 +730,13 @@ func (x *AnchorInfo) Reset() {
 	}
 }
 
-func (x *AnchorInfo) String() string {
+func (x *MintAssetBatchError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnchorInfo) ProtoMessage() {}
+func (*MintAssetBatchError) ProtoMessage() {}
 
-func (x *AnchorInfo) ProtoReflect() protoreflect.Message {
+func (x *MintAssetBatchError) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -364,73 +748,50 @@ func (x *AnchorInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnchorInfo.ProtoReflect.Descriptor instead.
-func (*AnchorInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use MintAssetBatchError.ProtoReflect.Descriptor instead.
+func (*MintAssetBatchError) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AnchorInfo) GetAnchorTx() []byte {
-	if x != nil {
-		return x.AnchorTx
-	}
-	return nil
-}
-
-func (x *AnchorInfo) GetAnchorTxid() []byte {
-	if x != nil {
-		return x.AnchorTxid
-	}
-	return nil
-}
-
-func (x *AnchorInfo) GetAnchorBlockHash() []byte {
+func (x *MintAssetBatchError) GetIndex() uint32 {
 	if x != nil {
-		return x.AnchorBlockHash
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *AnchorInfo) GetAnchorOutpoint() string {
+func (x *MintAssetBatchError) GetName() string {
 	if x != nil {
-		return x.AnchorOutpoint
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AnchorInfo) GetInternalKey() []byte {
+func (x *MintAssetBatchError) GetError() string {
 	if x != nil {
-		return x.InternalKey
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type GenesisInfo struct {
+type MintAssetBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The first outpoint of the transaction that created the asset (txid:vout).
-	GenesisPoint string `protobuf:"bytes,1,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
-	// The name of the asset.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The opaque meta data of the asset.
-	Meta []byte `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
-	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 	//
-	//The index of the output that carries the unique Taro commitment in the
-	//genesis transaction.
-	OutputIndex uint32 `protobuf:"varint,5,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//A public key serialized in compressed format that identifies the pending
+	//minting batch the assets were added to. Empty if any of the assets was
+	//invalid.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 	//
-	//The full genesis information encoded in a portable manner so it can be
-	//easily copy/pasted for address creation.
-	GenesisBootstrapInfo []byte `protobuf:"bytes,6,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
-	// The version of the Taro commitment that created this asset.
-	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	//The set of invalid assets. If this isn't empty, then none of the assets
+	//were queued.
+	Errors []*MintAssetBatchError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *GenesisInfo) Reset() {
-	*x = GenesisInfo{}
+func (x *MintAssetBatchResponse) Reset() {
+	*x = MintAssetBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,13 +799,13 @@ func (x *GenesisInfo) Reset() {
 	}
 }
 
-func (x *GenesisInfo) String() string {
+func (x *MintAssetBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisInfo) ProtoMessage() {}
+func (*MintAssetBatchResponse) ProtoMessage() {}
 
-func (x *GenesisInfo) ProtoReflect() protoreflect.Message {
+func (x *MintAssetBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,77 +817,36 @@ func (x *GenesisInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenesisInfo.ProtoReflect.Descriptor instead.
-func (*GenesisInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use MintAssetBatchResponse.ProtoReflect.Descriptor instead.
+func (*MintAssetBatchResponse) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GenesisInfo) GetGenesisPoint() string {
+func (x *MintAssetBatchResponse) GetBatchKey() []byte {
 	if x != nil {
-		return x.GenesisPoint
+		return x.BatchKey
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisInfo) GetName() string {
+func (x *MintAssetBatchResponse) GetErrors() []*MintAssetBatchError {
 	if x != nil {
-		return x.Name
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisInfo) GetMeta() []byte {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *GenesisInfo) GetAssetId() []byte {
-	if x != nil {
-		return x.AssetId
-	}
-	return nil
-}
-
-func (x *GenesisInfo) GetOutputIndex() uint32 {
-	if x != nil {
-		return x.OutputIndex
-	}
-	return 0
-}
-
-func (x *GenesisInfo) GetGenesisBootstrapInfo() []byte {
-	if x != nil {
-		return x.GenesisBootstrapInfo
-	}
-	return nil
-}
-
-func (x *GenesisInfo) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-type AssetFamily struct {
+type ListBatchesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw family key which is a normal public key.
-	RawFamilyKey []byte `protobuf:"bytes,1,opt,name=raw_family_key,json=rawFamilyKey,proto3" json:"raw_family_key,omitempty"`
-	//
-	//The tweaked family key, which is derived based on the genesis point and also
-	//asset type.
-	TweakedFamilyKey []byte `protobuf:"bytes,2,opt,name=tweaked_family_key,json=tweakedFamilyKey,proto3" json:"tweaked_family_key,omitempty"`
-	// A signature over the genesis point using the above key.
-	AssetIdSig []byte `protobuf:"bytes,3,opt,name=asset_id_sig,json=assetIdSig,proto3" json:"asset_id_sig,omitempty"`
+	// If set, only the batch identified by this batch key is returned.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
-func (x *AssetFamily) Reset() {
-	*x = AssetFamily{}
+func (x *ListBatchesRequest) Reset() {
+	*x = ListBatchesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,13 +854,13 @@ func (x *AssetFamily) Reset() {
 	}
 }
 
-func (x *AssetFamily) String() string {
+func (x *ListBatchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetFamily) ProtoMessage() {}
+func (*ListBatchesRequest) ProtoMessage() {}
 
-func (x *AssetFamily) ProtoReflect() protoreflect.Message {
+func (x *ListBatchesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,61 +872,55 @@ func (x *AssetFamily) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetFamily.ProtoReflect.Descriptor instead.
-func (*AssetFamily) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchesRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AssetFamily) GetRawFamilyKey() []byte {
-	if x != nil {
-		return x.RawFamilyKey
-	}
-	return nil
-}
-
-func (x *AssetFamily) GetTweakedFamilyKey() []byte {
-	if x != nil {
-		return x.TweakedFamilyKey
-	}
-	return nil
-}
-
-func (x *AssetFamily) GetAssetIdSig() []byte {
+func (x *ListBatchesRequest) GetBatchKey() []byte {
 	if x != nil {
-		return x.AssetIdSig
+		return x.BatchKey
 	}
 	return nil
 }
 
-type Asset struct {
+type BatchAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The version of the Taro asset.
-	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// The base genesis information of an asset. This information never changes.
-	AssetGenesis *GenesisInfo `protobuf:"bytes,2,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
 	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
-	// The total amount of the asset stored in this Taro UTXO.
-	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// An optional locktime, as with Bitcoin transactions.
-	LockTime int32 `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	// An optional relative lock time, same as Bitcoin transactions.
-	RelativeLockTime int32 `protobuf:"varint,6,opt,name=relative_lock_time,json=relativeLockTime,proto3" json:"relative_lock_time,omitempty"`
-	// The version of the script, only version 0 is defined at present.
-	ScriptVersion int32 `protobuf:"varint,7,opt,name=script_version,json=scriptVersion,proto3" json:"script_version,omitempty"`
-	// The script key of the asset, which can be spent under Taproot semantics.
-	ScriptKey []byte `protobuf:"bytes,9,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The information related to the key family of an asset (if it exists).
-	AssetFamily *AssetFamily `protobuf:"bytes,10,opt,name=asset_family,json=assetFamily,proto3" json:"asset_family,omitempty"`
-	// Describes where in the chain the asset is currently anchored.
-	ChainAnchor *AnchorInfo `protobuf:"bytes,11,opt,name=chain_anchor,json=chainAnchor,proto3" json:"chain_anchor,omitempty"`
+	AssetType AssetType `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The name, or "tag" of the asset.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The raw metadata of the asset, without the typed metadata envelope.
+	MetaData []byte `protobuf:"bytes,3,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// The type of the meta_data.
+	MetaType AssetMetaType `protobuf:"varint,4,opt,name=meta_type,json=metaType,proto3,enum=tarorpc.AssetMetaType" json:"meta_type,omitempty"`
+	// The MIME type of the meta_data, only set for media metadata.
+	MetaMimeType string `protobuf:"bytes,5,opt,name=meta_mime_type,json=metaMimeType,proto3" json:"meta_mime_type,omitempty"`
+	//
+	//The number of decimal places the amounts of the asset should be displayed
+	//with.
+	DecimalDisplay uint32 `protobuf:"varint,6,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
+	// The total amount of units of the asset that are created.
+	Amount int64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	//
+	//Whether the asset is created with a key family, which allows for future
+	//asset issuance.
+	EnableEmission bool `protobuf:"varint,8,opt,name=enable_emission,json=enableEmission,proto3" json:"enable_emission,omitempty"`
+	//
+	//The tweaked family key of the asset family the asset is issued into, if
+	//any. For new families this is only set once the batch is committed.
+	FamilyKey []byte `protobuf:"bytes,9,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	//
+	//The asset ID that uniquely identifies the asset. This is only set once the
+	//batch is committed, as the ID depends on the genesis transaction.
+	AssetId []byte `protobuf:"bytes,10,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 }
 
-func (x *Asset) Reset() {
-	*x = Asset{}
+func (x *BatchAsset) Reset() {
+	*x = BatchAsset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,13 +928,13 @@ func (x *Asset) Reset() {
 	}
 }
 
-func (x *Asset) String() string {
+func (x *BatchAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Asset) ProtoMessage() {}
+func (*BatchAsset) ProtoMessage() {}
 
-func (x *Asset) ProtoReflect() protoreflect.Message {
+func (x *BatchAsset) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,91 +946,110 @@ func (x *Asset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
-func (*Asset) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchAsset.ProtoReflect.Descriptor instead.
+func (*BatchAsset) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Asset) GetVersion() int32 {
+func (x *BatchAsset) GetAssetType() AssetType {
 	if x != nil {
-		return x.Version
+		return x.AssetType
 	}
-	return 0
+	return AssetType_NORMAL
 }
 
-func (x *Asset) GetAssetGenesis() *GenesisInfo {
+func (x *BatchAsset) GetName() string {
 	if x != nil {
-		return x.AssetGenesis
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Asset) GetAssetType() AssetType {
+func (x *BatchAsset) GetMetaData() []byte {
 	if x != nil {
-		return x.AssetType
+		return x.MetaData
 	}
-	return AssetType_NORMAL
+	return nil
 }
 
-func (x *Asset) GetAmount() int64 {
+func (x *BatchAsset) GetMetaType() AssetMetaType {
 	if x != nil {
-		return x.Amount
+		return x.MetaType
 	}
-	return 0
+	return AssetMetaType_META_TYPE_OPAQUE
 }
 
-func (x *Asset) GetLockTime() int32 {
+func (x *BatchAsset) GetMetaMimeType() string {
 	if x != nil {
-		return x.LockTime
+		return x.MetaMimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *Asset) GetRelativeLockTime() int32 {
+func (x *BatchAsset) GetDecimalDisplay() uint32 {
 	if x != nil {
-		return x.RelativeLockTime
+		return x.DecimalDisplay
 	}
 	return 0
 }
 
-func (x *Asset) GetScriptVersion() int32 {
+func (x *BatchAsset) GetAmount() int64 {
 	if x != nil {
-		return x.ScriptVersion
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Asset) GetScriptKey() []byte {
+func (x *BatchAsset) GetEnableEmission() bool {
 	if x != nil {
-		return x.ScriptKey
+		return x.EnableEmission
 	}
-	return nil
+	return false
 }
 
-func (x *Asset) GetAssetFamily() *AssetFamily {
+func (x *BatchAsset) GetFamilyKey() []byte {
 	if x != nil {
-		return x.AssetFamily
+		return x.FamilyKey
 	}
 	return nil
 }
 
-func (x *Asset) GetChainAnchor() *AnchorInfo {
+func (x *BatchAsset) GetAssetId() []byte {
 	if x != nil {
-		return x.ChainAnchor
+		return x.AssetId
 	}
 	return nil
 }
 
-type ListAssetResponse struct {
+type MintingBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assets []*Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	//
+	//The public key serialized in compressed format that uniquely identifies
+	//the batch. This is also used as the internal key of the minting output.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The current state of the batch.
+	State BatchState `protobuf:"varint,2,opt,name=state,proto3,enum=tarorpc.BatchState" json:"state,omitempty"`
+	// The time the batch was created, as a unix timestamp.
+	CreationTimeUnix int64 `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+	// The assets that are created by the batch.
+	Assets []*BatchAsset `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets,omitempty"`
+	//
+	//The genesis transaction of the batch as a PSBT. This is only set once the
+	//batch is committed, and is fully signed once the batch is broadcast.
+	GenesisPsbt []byte `protobuf:"bytes,5,opt,name=genesis_psbt,json=genesisPsbt,proto3" json:"genesis_psbt,omitempty"`
+	// The txid of the genesis transaction, if the batch is committed.
+	GenesisTxid string `protobuf:"bytes,6,opt,name=genesis_txid,json=genesisTxid,proto3" json:"genesis_txid,omitempty"`
+	//
+	//The chain fees in satoshis paid by the genesis transaction, if the batch is
+	//committed.
+	ChainFeesSats int64 `protobuf:"varint,7,opt,name=chain_fees_sats,json=chainFeesSats,proto3" json:"chain_fees_sats,omitempty"`
 }
 
-func (x *ListAssetResponse) Reset() {
-	*x = ListAssetResponse{}
+func (x *MintingBatch) Reset() {
+	*x = MintingBatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,13 +1057,13 @@ func (x *ListAssetResponse) Reset() {
 	}
 }
 
-func (x *ListAssetResponse) String() string {
+func (x *MintingBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssetResponse) ProtoMessage() {}
+func (*MintingBatch) ProtoMessage() {}
 
-func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
+func (x *MintingBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -742,38 +1075,71 @@ func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssetResponse.ProtoReflect.Descriptor instead.
-func (*ListAssetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MintingBatch.ProtoReflect.Descriptor instead.
+func (*MintingBatch) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListAssetResponse) GetAssets() []*Asset {
+func (x *MintingBatch) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *MintingBatch) GetState() BatchState {
+	if x != nil {
+		return x.State
+	}
+	return BatchState_BATCH_STATE_UNKNOWN
+}
+
+func (x *MintingBatch) GetCreationTimeUnix() int64 {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return 0
+}
+
+func (x *MintingBatch) GetAssets() []*BatchAsset {
 	if x != nil {
 		return x.Assets
 	}
 	return nil
 }
 
-type ListBalancesRequest struct {
+func (x *MintingBatch) GetGenesisPsbt() []byte {
+	if x != nil {
+		return x.GenesisPsbt
+	}
+	return nil
+}
+
+func (x *MintingBatch) GetGenesisTxid() string {
+	if x != nil {
+		return x.GenesisTxid
+	}
+	return ""
+}
+
+func (x *MintingBatch) GetChainFeesSats() int64 {
+	if x != nil {
+		return x.ChainFeesSats
+	}
+	return 0
+}
+
+type ListBatchesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to GroupBy:
-	//	*ListBalancesRequest_AssetId
-	//	*ListBalancesRequest_FamKey
-	GroupBy isListBalancesRequest_GroupBy `protobuf_oneof:"group_by"`
-	// If the query results should grouped by asset ids, then an optional asset
-	// filter may be provided to query balance of a specific asset.
-	AssetFilter []byte `protobuf:"bytes,3,opt,name=asset_filter,json=assetFilter,proto3" json:"asset_filter,omitempty"`
-	// If the query results should be grouped by family keys, then an optional
-	// family key filter may be provided to query the balance of a specific
-	// asset family.
-	FamilyKeyFilter []byte `protobuf:"bytes,4,opt,name=family_key_filter,json=familyKeyFilter,proto3" json:"family_key_filter,omitempty"`
+	// The minting batches, in the order they were created.
+	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
 }
 
-func (x *ListBalancesRequest) Reset() {
-	*x = ListBalancesRequest{}
+func (x *ListBatchesResponse) Reset() {
+	*x = ListBatchesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -781,13 +1147,13 @@ func (x *ListBalancesRequest) Reset() {
 	}
 }
 
-func (x *ListBalancesRequest) String() string {
+func (x *ListBatchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBalancesRequest) ProtoMessage() {}
+func (*ListBatchesResponse) ProtoMessage() {}
 
-func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBatchesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,94 +1165,86 @@ func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
-func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchesResponse) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *ListBalancesRequest) GetGroupBy() isListBalancesRequest_GroupBy {
-	if m != nil {
-		return m.GroupBy
+func (x *ListBatchesResponse) GetBatches() []*MintingBatch {
+	if x != nil {
+		return x.Batches
 	}
 	return nil
 }
 
-func (x *ListBalancesRequest) GetAssetId() bool {
-	if x, ok := x.GetGroupBy().(*ListBalancesRequest_AssetId); ok {
-		return x.AssetId
-	}
-	return false
+type ListSeedlingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListBalancesRequest) GetFamKey() bool {
-	if x, ok := x.GetGroupBy().(*ListBalancesRequest_FamKey); ok {
-		return x.FamKey
+func (x *ListSeedlingsRequest) Reset() {
+	*x = ListSeedlingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ListBalancesRequest) GetAssetFilter() []byte {
-	if x != nil {
-		return x.AssetFilter
-	}
-	return nil
+func (x *ListSeedlingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListBalancesRequest) GetFamilyKeyFilter() []byte {
-	if x != nil {
-		return x.FamilyKeyFilter
+func (*ListSeedlingsRequest) ProtoMessage() {}
+
+func (x *ListSeedlingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isListBalancesRequest_GroupBy interface {
-	isListBalancesRequest_GroupBy()
+// Deprecated: Use ListSeedlingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeedlingsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{9}
 }
 
-type ListBalancesRequest_AssetId struct {
-	// Group results by asset IDs.
-	AssetId bool `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3,oneof"`
-}
+type PendingSeedling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type ListBalancesRequest_FamKey struct {
-	// Group results by family keys.
-	FamKey bool `protobuf:"varint,2,opt,name=fam_key,json=famKey,proto3,oneof"`
+	// The key of the batch the seedling is queued in.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The current state of the batch the seedling is queued in.
+	BatchState BatchState `protobuf:"varint,2,opt,name=batch_state,json=batchState,proto3,enum=tarorpc.BatchState" json:"batch_state,omitempty"`
+	// The asset the seedling will create.
+	Asset *BatchAsset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
 }
 
-func (*ListBalancesRequest_AssetId) isListBalancesRequest_GroupBy() {}
-
-func (*ListBalancesRequest_FamKey) isListBalancesRequest_GroupBy() {}
-
-type AssetBalance struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The base genesis information of an asset. This information never changes.
-	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
-	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
-	// The balance of the asset owned by the target daemon.
-	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
-}
-
-func (x *AssetBalance) Reset() {
-	*x = AssetBalance{}
+func (x *PendingSeedling) Reset() {
+	*x = PendingSeedling{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[9]
+		mi := &file_taro_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetBalance) String() string {
+func (x *PendingSeedling) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetBalance) ProtoMessage() {}
+func (*PendingSeedling) ProtoMessage() {}
 
-func (x *AssetBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[9]
+func (x *PendingSeedling) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,60 +1255,57 @@ func (x *AssetBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetBalance.ProtoReflect.Descriptor instead.
-func (*AssetBalance) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PendingSeedling.ProtoReflect.Descriptor instead.
+func (*PendingSeedling) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AssetBalance) GetAssetGenesis() *GenesisInfo {
+func (x *PendingSeedling) GetBatchKey() []byte {
 	if x != nil {
-		return x.AssetGenesis
+		return x.BatchKey
 	}
 	return nil
 }
 
-func (x *AssetBalance) GetAssetType() AssetType {
+func (x *PendingSeedling) GetBatchState() BatchState {
 	if x != nil {
-		return x.AssetType
+		return x.BatchState
 	}
-	return AssetType_NORMAL
+	return BatchState_BATCH_STATE_UNKNOWN
 }
 
-func (x *AssetBalance) GetBalance() int64 {
+func (x *PendingSeedling) GetAsset() *BatchAsset {
 	if x != nil {
-		return x.Balance
+		return x.Asset
 	}
-	return 0
+	return nil
 }
 
-type AssetFamilyBalance struct {
+type ListSeedlingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The family key or nil aggregating assets that don't have a family.
-	FamilyKey []byte `protobuf:"bytes,1,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
-	// The total balance of the assets in the family.
-	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Seedlings []*PendingSeedling `protobuf:"bytes,1,rep,name=seedlings,proto3" json:"seedlings,omitempty"`
 }
 
-func (x *AssetFamilyBalance) Reset() {
-	*x = AssetFamilyBalance{}
+func (x *ListSeedlingsResponse) Reset() {
+	*x = ListSeedlingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[10]
+		mi := &file_taro_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetFamilyBalance) String() string {
+func (x *ListSeedlingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetFamilyBalance) ProtoMessage() {}
+func (*ListSeedlingsResponse) ProtoMessage() {}
 
-func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[10]
+func (x *ListSeedlingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,51 +1316,44 @@ func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetFamilyBalance.ProtoReflect.Descriptor instead.
-func (*AssetFamilyBalance) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListSeedlingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeedlingsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AssetFamilyBalance) GetFamilyKey() []byte {
+func (x *ListSeedlingsResponse) GetSeedlings() []*PendingSeedling {
 	if x != nil {
-		return x.FamilyKey
+		return x.Seedlings
 	}
 	return nil
 }
 
-func (x *AssetFamilyBalance) GetBalance() int64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
-}
-
-type ListBalancesResponse struct {
+type CancelSeedlingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetBalances       map[string]*AssetBalance       `protobuf:"bytes,1,rep,name=asset_balances,json=assetBalances,proto3" json:"asset_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	AssetFamilyBalances map[string]*AssetFamilyBalance `protobuf:"bytes,2,rep,name=asset_family_balances,json=assetFamilyBalances,proto3" json:"asset_family_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The name of the seedling to remove from the pending batch.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ListBalancesResponse) Reset() {
-	*x = ListBalancesResponse{}
+func (x *CancelSeedlingRequest) Reset() {
+	*x = CancelSeedlingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[11]
+		mi := &file_taro_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBalancesResponse) String() string {
+func (x *CancelSeedlingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBalancesResponse) ProtoMessage() {}
+func (*CancelSeedlingRequest) ProtoMessage() {}
 
-func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[11]
+func (x *CancelSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,48 +1364,41 @@ func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
-func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ListBalancesResponse) GetAssetBalances() map[string]*AssetBalance {
-	if x != nil {
-		return x.AssetBalances
-	}
-	return nil
+// Deprecated: Use CancelSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*CancelSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListBalancesResponse) GetAssetFamilyBalances() map[string]*AssetFamilyBalance {
+func (x *CancelSeedlingRequest) GetName() string {
 	if x != nil {
-		return x.AssetFamilyBalances
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ListTransfersRequest struct {
+type CancelSeedlingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListTransfersRequest) Reset() {
-	*x = ListTransfersRequest{}
+func (x *CancelSeedlingResponse) Reset() {
+	*x = CancelSeedlingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[12]
+		mi := &file_taro_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListTransfersRequest) String() string {
+func (x *CancelSeedlingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersRequest) ProtoMessage() {}
+func (*CancelSeedlingResponse) ProtoMessage() {}
 
-func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[12]
+func (x *CancelSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,37 +1409,39 @@ func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
-func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CancelSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*CancelSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{13}
 }
 
-type ListTransfersResponse struct {
+type CancelBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unordered list of outgoing asset transfers.
-	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	//
+	//The key of the batch to cancel. If this isn't set, the pending batch is
+	//cancelled.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
-func (x *ListTransfersResponse) Reset() {
-	*x = ListTransfersResponse{}
+func (x *CancelBatchRequest) Reset() {
+	*x = CancelBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[13]
+		mi := &file_taro_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListTransfersResponse) String() string {
+func (x *CancelBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersResponse) ProtoMessage() {}
+func (*CancelBatchRequest) ProtoMessage() {}
 
-func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[13]
+func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,58 +1452,41 @@ func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
-func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
+func (x *CancelBatchRequest) GetBatchKey() []byte {
 	if x != nil {
-		return x.Transfers
+		return x.BatchKey
 	}
 	return nil
 }
 
-type AssetTransfer struct {
+type CancelBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
-	// The old/current location of the Taro commitment that was spent as an
-	// input.
-	OldAnchorPoint string `protobuf:"bytes,2,opt,name=old_anchor_point,json=oldAnchorPoint,proto3" json:"old_anchor_point,omitempty"`
-	// The new location of the Taro commitment referenced by the old anchor
-	// point.
-	NewAnchorPoint string `protobuf:"bytes,3,opt,name=new_anchor_point,json=newAnchorPoint,proto3" json:"new_anchor_point,omitempty"`
-	// The new Taro root that commits to the set of modified and unmodified
-	// assets.
-	TaroRoot []byte `protobuf:"bytes,4,opt,name=taro_root,json=taroRoot,proto3" json:"taro_root,omitempty"`
-	// The new transaction that commits to the set of Taro assets found at the
-	// above new anchor point.
-	AnchorTxHash []byte `protobuf:"bytes,5,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
-	// Describes the set of mutated assets that now live at the new anchor tx
-	// point.
-	AssetSpendDeltas []*AssetSpendDelta `protobuf:"bytes,6,rep,name=asset_spend_deltas,json=assetSpendDeltas,proto3" json:"asset_spend_deltas,omitempty"`
 }
 
-func (x *AssetTransfer) Reset() {
-	*x = AssetTransfer{}
+func (x *CancelBatchResponse) Reset() {
+	*x = CancelBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[14]
+		mi := &file_taro_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetTransfer) String() string {
+func (x *CancelBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetTransfer) ProtoMessage() {}
+func (*CancelBatchResponse) ProtoMessage() {}
 
-func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[14]
+func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,86 +1497,87 @@ func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
-func (*AssetTransfer) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AssetTransfer) GetTransferTimestamp() int64 {
-	if x != nil {
-		return x.TransferTimestamp
-	}
-	return 0
+type FinalizeBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The fee rate in sat/kw the genesis transaction should be funded with. If
+	//this isn't set, the fee rate is estimated.
+	FeeRate uint32 `protobuf:"varint,1,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (x *AssetTransfer) GetOldAnchorPoint() string {
-	if x != nil {
-		return x.OldAnchorPoint
+func (x *FinalizeBatchRequest) Reset() {
+	*x = FinalizeBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AssetTransfer) GetNewAnchorPoint() string {
-	if x != nil {
-		return x.NewAnchorPoint
-	}
-	return ""
+func (x *FinalizeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AssetTransfer) GetTaroRoot() []byte {
-	if x != nil {
-		return x.TaroRoot
+func (*FinalizeBatchRequest) ProtoMessage() {}
+
+func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AssetTransfer) GetAnchorTxHash() []byte {
-	if x != nil {
-		return x.AnchorTxHash
-	}
-	return nil
+// Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AssetTransfer) GetAssetSpendDeltas() []*AssetSpendDelta {
+func (x *FinalizeBatchRequest) GetFeeRate() uint32 {
 	if x != nil {
-		return x.AssetSpendDeltas
+		return x.FeeRate
 	}
-	return nil
+	return 0
 }
 
-type AssetSpendDelta struct {
+type FinalizeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The old script key that uniquely identified the spent asset on disk.
-	OldScriptKey []byte `protobuf:"bytes,2,opt,name=old_script_key,json=oldScriptKey,proto3" json:"old_script_key,omitempty"`
-	// The new script key. We assume BIP 86 usage when updating the script keys
-	// on disk.
-	NewScriptKey []byte `protobuf:"bytes,3,opt,name=new_script_key,json=newScriptKey,proto3" json:"new_script_key,omitempty"`
-	// The new amount for the asset.
-	NewAmt int64 `protobuf:"varint,4,opt,name=new_amt,json=newAmt,proto3" json:"new_amt,omitempty"`
+	// The batch that was frozen and is now being minted.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *AssetSpendDelta) Reset() {
-	*x = AssetSpendDelta{}
+func (x *FinalizeBatchResponse) Reset() {
+	*x = FinalizeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[15]
+		mi := &file_taro_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetSpendDelta) String() string {
+func (x *FinalizeBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetSpendDelta) ProtoMessage() {}
+func (*FinalizeBatchResponse) ProtoMessage() {}
 
-func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[15]
+func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,62 +1588,72 @@ func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetSpendDelta.ProtoReflect.Descriptor instead.
-func (*AssetSpendDelta) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *AssetSpendDelta) GetAssetId() []byte {
-	if x != nil {
-		return x.AssetId
-	}
-	return nil
-}
-
-func (x *AssetSpendDelta) GetOldScriptKey() []byte {
-	if x != nil {
-		return x.OldScriptKey
-	}
-	return nil
+// Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AssetSpendDelta) GetNewScriptKey() []byte {
+func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
 	if x != nil {
-		return x.NewScriptKey
+		return x.Batch
 	}
 	return nil
 }
 
-func (x *AssetSpendDelta) GetNewAmt() int64 {
-	if x != nil {
-		return x.NewAmt
-	}
-	return 0
-}
-
-type StopRequest struct {
+type ListAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
+	// If set, only assets with this asset ID are returned.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// If set, only assets of the asset family with this family key are returned.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// Types that are assignable to TypeFilter:
+	//	*ListAssetRequest_AssetType
+	TypeFilter isListAssetRequest_TypeFilter `protobuf_oneof:"type_filter"`
+	//
+	//If set, only assets anchored at this outpoint (txid:vout) are returned. For
+	//spent assets, this is the outpoint the asset was anchored at before it was
+	//spent.
+	AnchorOutpoint string `protobuf:"bytes,4,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// If true, only assets with a confirmed anchor transaction are returned.
+	ConfirmedOnly bool `protobuf:"varint,5,opt,name=confirmed_only,json=confirmedOnly,proto3" json:"confirmed_only,omitempty"`
+	// If true, only assets with an unconfirmed anchor transaction are returned.
+	UnconfirmedOnly bool `protobuf:"varint,6,opt,name=unconfirmed_only,json=unconfirmedOnly,proto3" json:"unconfirmed_only,omitempty"`
+	// If set, only assets with at least this amount are returned.
+	MinAmount int64 `protobuf:"varint,7,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// The number of matching assets that should be skipped.
+	Offset int32 `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	//
+	//The max number of assets that should be returned. If not set, all matching
+	//assets are returned.
+	Limit int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	//
+	//If true, then the prior states of assets that were spent by outbound
+	//transfers of this daemon are returned as well. The filters are applied to
+	//the spent assets as well, while the pagination is applied to the combined
+	//list of the unspent assets followed by the spent assets.
+	IncludeSpent bool `protobuf:"varint,10,opt,name=include_spent,json=includeSpent,proto3" json:"include_spent,omitempty"`
+}
+
+func (x *ListAssetRequest) Reset() {
+	*x = ListAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[16]
+		mi := &file_taro_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopRequest) String() string {
+func (x *ListAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*ListAssetRequest) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[16]
+func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,115 +1664,119 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListAssetRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{18}
 }
 
-type StopResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListAssetRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
 }
 
-func (x *StopResponse) Reset() {
-	*x = StopResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListAssetRequest) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
 	}
+	return nil
 }
 
-func (x *StopResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *ListAssetRequest) GetTypeFilter() isListAssetRequest_TypeFilter {
+	if m != nil {
+		return m.TypeFilter
+	}
+	return nil
 }
 
-func (*StopResponse) ProtoMessage() {}
-
-func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListAssetRequest) GetAssetType() AssetType {
+	if x, ok := x.GetTypeFilter().(*ListAssetRequest_AssetType); ok {
+		return x.AssetType
 	}
-	return mi.MessageOf(x)
+	return AssetType_NORMAL
 }
 
-// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{17}
+func (x *ListAssetRequest) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
 }
 
-type DebugLevelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// If true, all the valid debug sub-systems will be returned.
-	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+func (x *ListAssetRequest) GetConfirmedOnly() bool {
+	if x != nil {
+		return x.ConfirmedOnly
+	}
+	return false
 }
 
-func (x *DebugLevelRequest) Reset() {
-	*x = DebugLevelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListAssetRequest) GetUnconfirmedOnly() bool {
+	if x != nil {
+		return x.UnconfirmedOnly
 	}
+	return false
 }
 
-func (x *DebugLevelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListAssetRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
 }
 
-func (*DebugLevelRequest) ProtoMessage() {}
-
-func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListAssetRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{18}
+func (x *ListAssetRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *DebugLevelRequest) GetShow() bool {
+func (x *ListAssetRequest) GetIncludeSpent() bool {
 	if x != nil {
-		return x.Show
+		return x.IncludeSpent
 	}
 	return false
 }
 
-func (x *DebugLevelRequest) GetLevelSpec() string {
-	if x != nil {
-		return x.LevelSpec
-	}
-	return ""
+type isListAssetRequest_TypeFilter interface {
+	isListAssetRequest_TypeFilter()
 }
 
-type DebugLevelResponse struct {
+type ListAssetRequest_AssetType struct {
+	// If set, only assets of this type are returned.
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType,oneof"`
+}
+
+func (*ListAssetRequest_AssetType) isListAssetRequest_TypeFilter() {}
+
+type AnchorInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+	// The transaction that anchors the Taro commitment where the asset resides.
+	AnchorTx []byte `protobuf:"bytes,1,opt,name=anchor_tx,json=anchorTx,proto3" json:"anchor_tx,omitempty"`
+	// The txid of the above transaction.
+	AnchorTxid []byte `protobuf:"bytes,2,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The block hash the contains the anchor transaction above.
+	AnchorBlockHash []byte `protobuf:"bytes,3,opt,name=anchor_block_hash,json=anchorBlockHash,proto3" json:"anchor_block_hash,omitempty"`
+	// The outpoint (txid:vout) that stores the Taro commitment.
+	AnchorOutpoint string `protobuf:"bytes,4,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	//
+	//The raw internal key that was used to create the anchor Taproot output key.
+	InternalKey []byte `protobuf:"bytes,5,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
 }
 
-func (x *DebugLevelResponse) Reset() {
-	*x = DebugLevelResponse{}
+func (x *AnchorInfo) Reset() {
+	*x = AnchorInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1443,13 +1784,13 @@ func (x *DebugLevelResponse) Reset() {
 	}
 }
 
-func (x *DebugLevelResponse) String() string {
+func (x *AnchorInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugLevelResponse) ProtoMessage() {}
+func (*AnchorInfo) ProtoMessage() {}
 
-func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+func (x *AnchorInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1461,48 +1802,83 @@ func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnchorInfo.ProtoReflect.Descriptor instead.
+func (*AnchorInfo) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DebugLevelResponse) GetSubSystems() string {
+func (x *AnchorInfo) GetAnchorTx() []byte {
 	if x != nil {
-		return x.SubSystems
+		return x.AnchorTx
+	}
+	return nil
+}
+
+func (x *AnchorInfo) GetAnchorTxid() []byte {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return nil
+}
+
+func (x *AnchorInfo) GetAnchorBlockHash() []byte {
+	if x != nil {
+		return x.AnchorBlockHash
+	}
+	return nil
+}
+
+func (x *AnchorInfo) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
 	}
 	return ""
 }
 
-type Addr struct {
+func (x *AnchorInfo) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+type GenesisInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The bech32 encoded Taro address.
-	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	// The first outpoint of the transaction that created the asset (txid:vout).
+	GenesisPoint string `protobuf:"bytes,1,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// The name of the asset.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The opaque meta data of the asset.
+	Meta []byte `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
-	// The total amount of the asset stored in this Taro UTXO.
-	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The family key of the asset (if it exists)
-	FamilyKey []byte `protobuf:"bytes,5,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	AssetId []byte `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 	//
-	//The specific script key the asset must commit to in order to transfer
-	//ownership to the creator of the address.
-	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The internal key used for the on-chain output.
-	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	//The index of the output that carries the unique Taro commitment in the
+	//genesis transaction.
+	OutputIndex uint32 `protobuf:"varint,5,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 	//
-	//The tweaked internal key that commits to the asset and represents the
-	//on-chain output key the Bitcoin transaction must send to in order to
-	//transfer assets described in this address.
-	TaprootOutputKey []byte `protobuf:"bytes,8,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
+	//The full genesis information encoded in a portable manner so it can be
+	//easily copy/pasted for address creation.
+	GenesisBootstrapInfo []byte `protobuf:"bytes,6,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
+	// The version of the Taro commitment that created this asset.
+	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// The type of the meta data of the asset.
+	MetaType AssetMetaType `protobuf:"varint,8,opt,name=meta_type,json=metaType,proto3,enum=tarorpc.AssetMetaType" json:"meta_type,omitempty"`
+	// The MIME type of the meta data, only set for media metadata.
+	MetaMimeType string `protobuf:"bytes,9,opt,name=meta_mime_type,json=metaMimeType,proto3" json:"meta_mime_type,omitempty"`
+	// The meta data of the asset as a JSON string, only set for JSON metadata.
+	MetaJson string `protobuf:"bytes,10,opt,name=meta_json,json=metaJson,proto3" json:"meta_json,omitempty"`
+	//
+	//The number of decimal places the amounts of the asset should be displayed
+	//with, as committed to in the meta data of the asset.
+	DecimalDisplay uint32 `protobuf:"varint,11,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
 }
 
-func (x *Addr) Reset() {
-	*x = Addr{}
+func (x *GenesisInfo) Reset() {
+	*x = GenesisInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,13 +1886,13 @@ func (x *Addr) Reset() {
 	}
 }
 
-func (x *Addr) String() string {
+func (x *GenesisInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Addr) ProtoMessage() {}
+func (*GenesisInfo) ProtoMessage() {}
 
-func (x *Addr) ProtoReflect() protoreflect.Message {
+func (x *GenesisInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1528,88 +1904,105 @@ func (x *Addr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
-func (*Addr) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenesisInfo.ProtoReflect.Descriptor instead.
+func (*GenesisInfo) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Addr) GetEncoded() string {
+func (x *GenesisInfo) GetGenesisPoint() string {
 	if x != nil {
-		return x.Encoded
+		return x.GenesisPoint
 	}
 	return ""
 }
 
-func (x *Addr) GetAssetId() []byte {
+func (x *GenesisInfo) GetName() string {
 	if x != nil {
-		return x.AssetId
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Addr) GetAssetType() AssetType {
+func (x *GenesisInfo) GetMeta() []byte {
 	if x != nil {
-		return x.AssetType
+		return x.Meta
 	}
-	return AssetType_NORMAL
+	return nil
 }
 
-func (x *Addr) GetAmount() int64 {
+func (x *GenesisInfo) GetAssetId() []byte {
 	if x != nil {
-		return x.Amount
+		return x.AssetId
 	}
-	return 0
+	return nil
 }
 
-func (x *Addr) GetFamilyKey() []byte {
+func (x *GenesisInfo) GetOutputIndex() uint32 {
 	if x != nil {
-		return x.FamilyKey
+		return x.OutputIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *Addr) GetScriptKey() []byte {
+func (x *GenesisInfo) GetGenesisBootstrapInfo() []byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.GenesisBootstrapInfo
 	}
 	return nil
 }
 
-func (x *Addr) GetInternalKey() []byte {
+func (x *GenesisInfo) GetVersion() int32 {
 	if x != nil {
-		return x.InternalKey
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *Addr) GetTaprootOutputKey() []byte {
+func (x *GenesisInfo) GetMetaType() AssetMetaType {
 	if x != nil {
-		return x.TaprootOutputKey
+		return x.MetaType
 	}
-	return nil
+	return AssetMetaType_META_TYPE_OPAQUE
 }
 
-type QueryAddrRequest struct {
+func (x *GenesisInfo) GetMetaMimeType() string {
+	if x != nil {
+		return x.MetaMimeType
+	}
+	return ""
+}
+
+func (x *GenesisInfo) GetMetaJson() string {
+	if x != nil {
+		return x.MetaJson
+	}
+	return ""
+}
+
+func (x *GenesisInfo) GetDecimalDisplay() uint32 {
+	if x != nil {
+		return x.DecimalDisplay
+	}
+	return 0
+}
+
+type AssetFamily struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The raw family key which is a normal public key.
+	RawFamilyKey []byte `protobuf:"bytes,1,opt,name=raw_family_key,json=rawFamilyKey,proto3" json:"raw_family_key,omitempty"`
 	//
-	//If set, then only addresses created after this Unix timestamp will be
-	//returned.
-	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
-	//
-	//If set, then only addresses created before this Unix timestamp will be
-	//returned.
-	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
-	// The max number of addresses that should be returned.
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// The offset from the addresses that should be returned.
-	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	//The tweaked family key, which is derived based on the genesis point and also
+	//asset type.
+	TweakedFamilyKey []byte `protobuf:"bytes,2,opt,name=tweaked_family_key,json=tweakedFamilyKey,proto3" json:"tweaked_family_key,omitempty"`
+	// A signature over the genesis point using the above key.
+	AssetIdSig []byte `protobuf:"bytes,3,opt,name=asset_id_sig,json=assetIdSig,proto3" json:"asset_id_sig,omitempty"`
 }
 
-func (x *QueryAddrRequest) Reset() {
-	*x = QueryAddrRequest{}
+func (x *AssetFamily) Reset() {
+	*x = AssetFamily{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1617,13 +2010,13 @@ func (x *QueryAddrRequest) Reset() {
 	}
 }
 
-func (x *QueryAddrRequest) String() string {
+func (x *AssetFamily) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrRequest) ProtoMessage() {}
+func (*AssetFamily) ProtoMessage() {}
 
-func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
+func (x *AssetFamily) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1635,49 +2028,63 @@ func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
-func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetFamily.ProtoReflect.Descriptor instead.
+func (*AssetFamily) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryAddrRequest) GetCreatedAfter() int64 {
-	if x != nil {
-		return x.CreatedAfter
-	}
-	return 0
-}
-
-func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+func (x *AssetFamily) GetRawFamilyKey() []byte {
 	if x != nil {
-		return x.CreatedBefore
+		return x.RawFamilyKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryAddrRequest) GetLimit() int32 {
+func (x *AssetFamily) GetTweakedFamilyKey() []byte {
 	if x != nil {
-		return x.Limit
+		return x.TweakedFamilyKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryAddrRequest) GetOffset() int32 {
+func (x *AssetFamily) GetAssetIdSig() []byte {
 	if x != nil {
-		return x.Offset
+		return x.AssetIdSig
 	}
-	return 0
+	return nil
 }
 
-type QueryAddrResponse struct {
+type Asset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// The version of the Taro asset.
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The base genesis information of an asset. This information never changes.
+	AssetGenesis *GenesisInfo `protobuf:"bytes,2,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The total amount of the asset stored in this Taro UTXO.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// An optional locktime, as with Bitcoin transactions.
+	LockTime int32 `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	// An optional relative lock time, same as Bitcoin transactions.
+	RelativeLockTime int32 `protobuf:"varint,6,opt,name=relative_lock_time,json=relativeLockTime,proto3" json:"relative_lock_time,omitempty"`
+	// The version of the script, only version 0 is defined at present.
+	ScriptVersion int32 `protobuf:"varint,7,opt,name=script_version,json=scriptVersion,proto3" json:"script_version,omitempty"`
+	// The script key of the asset, which can be spent under Taproot semantics.
+	ScriptKey []byte `protobuf:"bytes,9,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The information related to the key family of an asset (if it exists).
+	AssetFamily *AssetFamily `protobuf:"bytes,10,opt,name=asset_family,json=assetFamily,proto3" json:"asset_family,omitempty"`
+	// Describes where in the chain the asset is currently anchored.
+	ChainAnchor *AnchorInfo `protobuf:"bytes,11,opt,name=chain_anchor,json=chainAnchor,proto3" json:"chain_anchor,omitempty"`
+	// The amount formatted with the decimal display of the asset.
+	DisplayAmount string `protobuf:"bytes,12,opt,name=display_amount,json=displayAmount,proto3" json:"display_amount,omitempty"`
 }
 
-func (x *QueryAddrResponse) Reset() {
-	*x = QueryAddrResponse{}
+func (x *Asset) Reset() {
+	*x = Asset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1685,13 +2092,13 @@ func (x *QueryAddrResponse) Reset() {
 	}
 }
 
-func (x *QueryAddrResponse) String() string {
+func (x *Asset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrResponse) ProtoMessage() {}
+func (*Asset) ProtoMessage() {}
 
-func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
+func (x *Asset) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1703,30 +2110,100 @@ func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
-func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAddrResponse) GetAddrs() []*Addr {
+func (x *Asset) GetVersion() int32 {
 	if x != nil {
-		return x.Addrs
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Asset) GetAssetGenesis() *GenesisInfo {
+	if x != nil {
+		return x.AssetGenesis
 	}
 	return nil
 }
 
-type NewAddrRequest struct {
+func (x *Asset) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *Asset) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Asset) GetLockTime() int32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *Asset) GetRelativeLockTime() int32 {
+	if x != nil {
+		return x.RelativeLockTime
+	}
+	return 0
+}
+
+func (x *Asset) GetScriptVersion() int32 {
+	if x != nil {
+		return x.ScriptVersion
+	}
+	return 0
+}
+
+func (x *Asset) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *Asset) GetAssetFamily() *AssetFamily {
+	if x != nil {
+		return x.AssetFamily
+	}
+	return nil
+}
+
+func (x *Asset) GetChainAnchor() *AnchorInfo {
+	if x != nil {
+		return x.ChainAnchor
+	}
+	return nil
+}
+
+func (x *Asset) GetDisplayAmount() string {
+	if x != nil {
+		return x.DisplayAmount
+	}
+	return ""
+}
+
+type ListAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GenesisBootstrapInfo []byte `protobuf:"bytes,1,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
-	FamKey               []byte `protobuf:"bytes,2,opt,name=fam_key,json=famKey,proto3" json:"fam_key,omitempty"`
-	Amt                  int64  `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	Assets []*Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The spent assets, only set if include_spent was set in the request.
+	SpentAssets []*SpentAsset `protobuf:"bytes,2,rep,name=spent_assets,json=spentAssets,proto3" json:"spent_assets,omitempty"`
 }
 
-func (x *NewAddrRequest) Reset() {
-	*x = NewAddrRequest{}
+func (x *ListAssetResponse) Reset() {
+	*x = ListAssetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,13 +2211,13 @@ func (x *NewAddrRequest) Reset() {
 	}
 }
 
-func (x *NewAddrRequest) String() string {
+func (x *ListAssetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewAddrRequest) ProtoMessage() {}
+func (*ListAssetResponse) ProtoMessage() {}
 
-func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,42 +2229,59 @@ func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
-func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAssetResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetResponse) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *NewAddrRequest) GetGenesisBootstrapInfo() []byte {
+func (x *ListAssetResponse) GetAssets() []*Asset {
 	if x != nil {
-		return x.GenesisBootstrapInfo
+		return x.Assets
 	}
 	return nil
 }
 
-func (x *NewAddrRequest) GetFamKey() []byte {
+func (x *ListAssetResponse) GetSpentAssets() []*SpentAsset {
 	if x != nil {
-		return x.FamKey
+		return x.SpentAssets
 	}
 	return nil
 }
 
-func (x *NewAddrRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
-}
-
-type DecodeAddrRequest struct {
+type SpentAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-}
-
-func (x *DecodeAddrRequest) Reset() {
-	*x = DecodeAddrRequest{}
+	//
+	//The base genesis information of the spent asset. This is only unset for
+	//transfers that were made before the asset history was recorded, if the
+	//spent asset couldn't be recovered.
+	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
+	// The type of the asset, only set if asset_genesis is set.
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The family key of the asset, if it has one.
+	FamilyKey []byte `protobuf:"bytes,3,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The script key the asset was locked to before it was spent.
+	ScriptKey []byte `protobuf:"bytes,4,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	//
+	//The amount of the asset before it was spent. This is zero for transfers
+	//that were made before the amount was recorded.
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The amount of the asset that was kept as change by the transfer.
+	ChangeAmount int64 `protobuf:"varint,6,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	// The outpoint the asset was anchored at before it was spent.
+	AnchorOutpoint string `protobuf:"bytes,7,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The txid of the transaction that spent the asset.
+	SpendTxid []byte `protobuf:"bytes,8,opt,name=spend_txid,json=spendTxid,proto3" json:"spend_txid,omitempty"`
+	// Whether the transaction that spent the asset has been confirmed.
+	Confirmed bool `protobuf:"varint,9,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The time the transfer that spent the asset was initiated.
+	SpendTimeUnix int64 `protobuf:"varint,10,opt,name=spend_time_unix,json=spendTimeUnix,proto3" json:"spend_time_unix,omitempty"`
+}
+
+func (x *SpentAsset) Reset() {
+	*x = SpentAsset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1795,13 +2289,13 @@ func (x *DecodeAddrRequest) Reset() {
 	}
 }
 
-func (x *DecodeAddrRequest) String() string {
+func (x *SpentAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeAddrRequest) ProtoMessage() {}
+func (*SpentAsset) ProtoMessage() {}
 
-func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
+func (x *SpentAsset) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,98 +2307,104 @@ func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
-func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpentAsset.ProtoReflect.Descriptor instead.
+func (*SpentAsset) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DecodeAddrRequest) GetAddr() string {
+func (x *SpentAsset) GetAssetGenesis() *GenesisInfo {
 	if x != nil {
-		return x.Addr
+		return x.AssetGenesis
 	}
-	return ""
+	return nil
 }
 
-type ProofFile struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
-	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+func (x *SpentAsset) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
 }
 
-func (x *ProofFile) Reset() {
-	*x = ProofFile{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SpentAsset) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
 	}
+	return nil
 }
 
-func (x *ProofFile) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SpentAsset) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
 }
 
-func (*ProofFile) ProtoMessage() {}
+func (x *SpentAsset) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
 
-func (x *ProofFile) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SpentAsset) GetChangeAmount() int64 {
+	if x != nil {
+		return x.ChangeAmount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
-func (*ProofFile) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{25}
+func (x *SpentAsset) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
 }
 
-func (x *ProofFile) GetRawProof() []byte {
+func (x *SpentAsset) GetSpendTxid() []byte {
 	if x != nil {
-		return x.RawProof
+		return x.SpendTxid
 	}
 	return nil
 }
 
-func (x *ProofFile) GetGenesisPoint() string {
+func (x *SpentAsset) GetConfirmed() bool {
 	if x != nil {
-		return x.GenesisPoint
+		return x.Confirmed
 	}
-	return ""
+	return false
 }
 
-type ProofVerifyResponse struct {
+func (x *SpentAsset) GetSpendTimeUnix() int64 {
+	if x != nil {
+		return x.SpendTimeUnix
+	}
+	return 0
+}
+
+type ListUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (x *ProofVerifyResponse) Reset() {
-	*x = ProofVerifyResponse{}
+func (x *ListUtxosRequest) Reset() {
+	*x = ListUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[26]
+		mi := &file_taro_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofVerifyResponse) String() string {
+func (x *ListUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofVerifyResponse) ProtoMessage() {}
+func (*ListUtxosRequest) ProtoMessage() {}
 
-func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[26]
+func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,44 +2415,47 @@ func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProofVerifyResponse.ProtoReflect.Descriptor instead.
-func (*ProofVerifyResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *ProofVerifyResponse) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
+// Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{25}
 }
 
-type ExportProofRequest struct {
+type ManagedUtxo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The outpoint of the UTXO.
+	OutPoint string `protobuf:"bytes,1,opt,name=out_point,json=outPoint,proto3" json:"out_point,omitempty"`
+	// The UTXO amount in satoshis.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// The internal key used for the on-chain output.
+	InternalKey []byte `protobuf:"bytes,3,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The Taro root commitment hash.
+	TaroRoot []byte `protobuf:"bytes,4,opt,name=taro_root,json=taroRoot,proto3" json:"taro_root,omitempty"`
+	// The tapscript sibling of the Taro commitment, if any.
+	TapscriptSibling []byte `protobuf:"bytes,5,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	// The assets held at this UTXO.
+	Assets []*Asset `protobuf:"bytes,6,rep,name=assets,proto3" json:"assets,omitempty"`
 }
 
-func (x *ExportProofRequest) Reset() {
-	*x = ExportProofRequest{}
+func (x *ManagedUtxo) Reset() {
+	*x = ManagedUtxo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[27]
+		mi := &file_taro_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportProofRequest) String() string {
+func (x *ManagedUtxo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProofRequest) ProtoMessage() {}
+func (*ManagedUtxo) ProtoMessage() {}
 
-func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[27]
+func (x *ManagedUtxo) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,51 +2466,79 @@ func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
-func (*ExportProofRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ManagedUtxo.ProtoReflect.Descriptor instead.
+func (*ManagedUtxo) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ExportProofRequest) GetAssetId() []byte {
+func (x *ManagedUtxo) GetOutPoint() string {
 	if x != nil {
-		return x.AssetId
+		return x.OutPoint
+	}
+	return ""
+}
+
+func (x *ManagedUtxo) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
+}
+
+func (x *ManagedUtxo) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
 	}
 	return nil
 }
 
-func (x *ExportProofRequest) GetScriptKey() []byte {
+func (x *ManagedUtxo) GetTaroRoot() []byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.TaroRoot
 	}
 	return nil
 }
 
-type ImportProofRequest struct {
+func (x *ManagedUtxo) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *ManagedUtxo) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type ListUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProofFile    []byte `protobuf:"bytes,1,opt,name=proof_file,json=proofFile,proto3" json:"proof_file,omitempty"`
-	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// The set of UTXOs managed by the daemon, keyed by their outpoint.
+	ManagedUtxos map[string]*ManagedUtxo `protobuf:"bytes,1,rep,name=managed_utxos,json=managedUtxos,proto3" json:"managed_utxos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ImportProofRequest) Reset() {
-	*x = ImportProofRequest{}
+func (x *ListUtxosResponse) Reset() {
+	*x = ListUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[28]
+		mi := &file_taro_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportProofRequest) String() string {
+func (x *ListUtxosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportProofRequest) ProtoMessage() {}
+func (*ListUtxosResponse) ProtoMessage() {}
 
-func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[28]
+func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,48 +2549,41 @@ func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportProofRequest.ProtoReflect.Descriptor instead.
-func (*ImportProofRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListUtxosResponse.ProtoReflect.Descriptor instead.
+func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ImportProofRequest) GetProofFile() []byte {
+func (x *ListUtxosResponse) GetManagedUtxos() map[string]*ManagedUtxo {
 	if x != nil {
-		return x.ProofFile
+		return x.ManagedUtxos
 	}
 	return nil
 }
 
-func (x *ImportProofRequest) GetGenesisPoint() string {
-	if x != nil {
-		return x.GenesisPoint
-	}
-	return ""
-}
-
-type ImportProofResponse struct {
+type ListLeasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ImportProofResponse) Reset() {
-	*x = ImportProofResponse{}
+func (x *ListLeasesRequest) Reset() {
+	*x = ListLeasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[29]
+		mi := &file_taro_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportProofResponse) String() string {
+func (x *ListLeasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportProofResponse) ProtoMessage() {}
+func (*ListLeasesRequest) ProtoMessage() {}
 
-func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[29]
+func (x *ListLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,60 +2594,41 @@ func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportProofResponse.ProtoReflect.Descriptor instead.
-func (*ImportProofResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{28}
 }
 
-type AddrEvent struct {
+type UtxoLease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time the event was created in unix timestamp seconds.
-	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
-	// The address the event was created for.
-	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	// The current status of the event.
-	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=tarorpc.AddrEventStatus" json:"status,omitempty"`
-	// The outpoint that contains the inbound asset transfer.
-	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	//
-	//The amount in satoshis that were transferred on chain along with the asset.
-	//This amount is independent of the requested asset amount, which can be
-	//looked up on the address.
-	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
-	//
-	//The taproot sibling hash that was used to send to the Taproot output.
-	//NOTE: Not yet implemented.
-	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
-	//
-	//The height at which the on-chain output was confirmed. If this is zero, it
-	//means the output is unconfirmed.
-	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
-	//
-	//Indicates whether a proof file can be found for the address' asset ID and
-	//script key.
-	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	// The outpoint of the leased UTXO.
+	OutPoint string `protobuf:"bytes,1,opt,name=out_point,json=outPoint,proto3" json:"out_point,omitempty"`
+	// The ID of the owner of the lease.
+	LeaseOwner []byte `protobuf:"bytes,2,opt,name=lease_owner,json=leaseOwner,proto3" json:"lease_owner,omitempty"`
+	// The unix timestamp at which the lease expires.
+	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
-func (x *AddrEvent) Reset() {
-	*x = AddrEvent{}
+func (x *UtxoLease) Reset() {
+	*x = UtxoLease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[30]
+		mi := &file_taro_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrEvent) String() string {
+func (x *UtxoLease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrEvent) ProtoMessage() {}
+func (*UtxoLease) ProtoMessage() {}
 
-func (x *AddrEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[30]
+func (x *UtxoLease) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,95 +2639,58 @@ func (x *AddrEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
-func (*AddrEvent) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
-	if x != nil {
-		return x.CreationTimeUnixSeconds
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetAddr() *Addr {
-	if x != nil {
-		return x.Addr
-	}
-	return nil
-}
-
-func (x *AddrEvent) GetStatus() AddrEventStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+// Deprecated: Use UtxoLease.ProtoReflect.Descriptor instead.
+func (*UtxoLease) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddrEvent) GetOutpoint() string {
+func (x *UtxoLease) GetOutPoint() string {
 	if x != nil {
-		return x.Outpoint
+		return x.OutPoint
 	}
 	return ""
 }
 
-func (x *AddrEvent) GetUtxoAmtSat() uint64 {
-	if x != nil {
-		return x.UtxoAmtSat
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetTaprootSibling() []byte {
+func (x *UtxoLease) GetLeaseOwner() []byte {
 	if x != nil {
-		return x.TaprootSibling
+		return x.LeaseOwner
 	}
 	return nil
 }
 
-func (x *AddrEvent) GetConfirmationHeight() uint32 {
+func (x *UtxoLease) GetExpiry() int64 {
 	if x != nil {
-		return x.ConfirmationHeight
+		return x.Expiry
 	}
 	return 0
 }
 
-func (x *AddrEvent) GetHasProof() bool {
-	if x != nil {
-		return x.HasProof
-	}
-	return false
-}
-
-type AddrReceivesRequest struct {
+type ListLeasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Filter receives by a specific address. Leave empty to get all receives.
-	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
-	// Filter receives by a specific status. Leave empty to get all receives.
-	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=tarorpc.AddrEventStatus" json:"filter_status,omitempty"`
+	// The set of currently leased UTXOs.
+	Leases []*UtxoLease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
 }
 
-func (x *AddrReceivesRequest) Reset() {
-	*x = AddrReceivesRequest{}
+func (x *ListLeasesResponse) Reset() {
+	*x = ListLeasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[31]
+		mi := &file_taro_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesRequest) String() string {
+func (x *ListLeasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesRequest) ProtoMessage() {}
+func (*ListLeasesResponse) ProtoMessage() {}
 
-func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[31]
+func (x *ListLeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,51 +2701,44 @@ func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
-func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *AddrReceivesRequest) GetFilterAddr() string {
-	if x != nil {
-		return x.FilterAddr
-	}
-	return ""
+// Deprecated: Use ListLeasesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeasesResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+func (x *ListLeasesResponse) GetLeases() []*UtxoLease {
 	if x != nil {
-		return x.FilterStatus
+		return x.Leases
 	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	return nil
 }
 
-type AddrReceivesResponse struct {
+type ReleaseLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The events that match the filter criteria.
-	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// The outpoint of the UTXO to release the lease on.
+	OutPoint string `protobuf:"bytes,1,opt,name=out_point,json=outPoint,proto3" json:"out_point,omitempty"`
 }
 
-func (x *AddrReceivesResponse) Reset() {
-	*x = AddrReceivesResponse{}
+func (x *ReleaseLeaseRequest) Reset() {
+	*x = ReleaseLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[32]
+		mi := &file_taro_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesResponse) String() string {
+func (x *ReleaseLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesResponse) ProtoMessage() {}
+func (*ReleaseLeaseRequest) ProtoMessage() {}
 
-func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[32]
+func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,43 +2749,41 @@ func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
-func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
+func (x *ReleaseLeaseRequest) GetOutPoint() string {
 	if x != nil {
-		return x.Events
+		return x.OutPoint
 	}
-	return nil
+	return ""
 }
 
-type SendAssetRequest struct {
+type ReleaseLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TaroAddr string `protobuf:"bytes,1,opt,name=taro_addr,json=taroAddr,proto3" json:"taro_addr,omitempty"`
 }
 
-func (x *SendAssetRequest) Reset() {
-	*x = SendAssetRequest{}
+func (x *ReleaseLeaseResponse) Reset() {
+	*x = ReleaseLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[33]
+		mi := &file_taro_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendAssetRequest) String() string {
+func (x *ReleaseLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetRequest) ProtoMessage() {}
+func (*ReleaseLeaseResponse) ProtoMessage() {}
 
-func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[33]
+func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,46 +2794,46 @@ func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
-func (*SendAssetRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *SendAssetRequest) GetTaroAddr() string {
-	if x != nil {
-		return x.TaroAddr
-	}
-	return ""
+// Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{32}
 }
 
-type PrevInputAsset struct {
+type ListBalancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
-	AssetId     []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey   []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	Amount      int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Types that are assignable to GroupBy:
+	//	*ListBalancesRequest_AssetId
+	//	*ListBalancesRequest_FamKey
+	GroupBy isListBalancesRequest_GroupBy `protobuf_oneof:"group_by"`
+	// If the query results should grouped by asset ids, then an optional asset
+	// filter may be provided to query balance of a specific asset.
+	AssetFilter []byte `protobuf:"bytes,3,opt,name=asset_filter,json=assetFilter,proto3" json:"asset_filter,omitempty"`
+	// If the query results should be grouped by family keys, then an optional
+	// family key filter may be provided to query the balance of a specific
+	// asset family.
+	FamilyKeyFilter []byte `protobuf:"bytes,4,opt,name=family_key_filter,json=familyKeyFilter,proto3" json:"family_key_filter,omitempty"`
 }
 
-func (x *PrevInputAsset) Reset() {
-	*x = PrevInputAsset{}
+func (x *ListBalancesRequest) Reset() {
+	*x = ListBalancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[34]
+		mi := &file_taro_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrevInputAsset) String() string {
+func (x *ListBalancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrevInputAsset) ProtoMessage() {}
+func (*ListBalancesRequest) ProtoMessage() {}
 
-func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[34]
+func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,69 +2844,98 @@ func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrevInputAsset.ProtoReflect.Descriptor instead.
-func (*PrevInputAsset) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PrevInputAsset) GetAnchorPoint() string {
-	if x != nil {
-		return x.AnchorPoint
+func (m *ListBalancesRequest) GetGroupBy() isListBalancesRequest_GroupBy {
+	if m != nil {
+		return m.GroupBy
 	}
-	return ""
+	return nil
 }
 
-func (x *PrevInputAsset) GetAssetId() []byte {
-	if x != nil {
+func (x *ListBalancesRequest) GetAssetId() bool {
+	if x, ok := x.GetGroupBy().(*ListBalancesRequest_AssetId); ok {
 		return x.AssetId
 	}
-	return nil
+	return false
 }
 
-func (x *PrevInputAsset) GetScriptKey() []byte {
+func (x *ListBalancesRequest) GetFamKey() bool {
+	if x, ok := x.GetGroupBy().(*ListBalancesRequest_FamKey); ok {
+		return x.FamKey
+	}
+	return false
+}
+
+func (x *ListBalancesRequest) GetAssetFilter() []byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.AssetFilter
 	}
 	return nil
 }
 
-func (x *PrevInputAsset) GetAmount() int64 {
+func (x *ListBalancesRequest) GetFamilyKeyFilter() []byte {
 	if x != nil {
-		return x.Amount
+		return x.FamilyKeyFilter
 	}
-	return 0
+	return nil
 }
 
-type AssetOutput struct {
+type isListBalancesRequest_GroupBy interface {
+	isListBalancesRequest_GroupBy()
+}
+
+type ListBalancesRequest_AssetId struct {
+	// Group results by asset IDs.
+	AssetId bool `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type ListBalancesRequest_FamKey struct {
+	// Group results by family keys.
+	FamKey bool `protobuf:"varint,2,opt,name=fam_key,json=famKey,proto3,oneof"`
+}
+
+func (*ListBalancesRequest_AssetId) isListBalancesRequest_GroupBy() {}
+
+func (*ListBalancesRequest_FamKey) isListBalancesRequest_GroupBy() {}
+
+type AssetBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnchorPoint      string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
-	AssetId          []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey        []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	Amount           int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	NewProofBlob     []byte `protobuf:"bytes,5,opt,name=new_proof_blob,json=newProofBlob,proto3" json:"new_proof_blob,omitempty"`
-	SplitCommitProof []byte `protobuf:"bytes,6,opt,name=split_commit_proof,json=splitCommitProof,proto3" json:"split_commit_proof,omitempty"`
+	// The base genesis information of an asset. This information never changes.
+	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The balance of the asset owned by the target daemon.
+	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The number of decimal places the balance should be displayed with.
+	DecimalDisplay uint32 `protobuf:"varint,4,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
+	// The balance formatted with the decimal display of the asset.
+	DisplayBalance string `protobuf:"bytes,5,opt,name=display_balance,json=displayBalance,proto3" json:"display_balance,omitempty"`
 }
 
-func (x *AssetOutput) Reset() {
-	*x = AssetOutput{}
+func (x *AssetBalance) Reset() {
+	*x = AssetBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[35]
+		mi := &file_taro_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssetOutput) String() string {
+func (x *AssetBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetOutput) ProtoMessage() {}
+func (*AssetBalance) ProtoMessage() {}
 
-func (x *AssetOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[35]
+func (x *AssetBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,81 +2946,78 @@ func (x *AssetOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetOutput.ProtoReflect.Descriptor instead.
-func (*AssetOutput) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *AssetOutput) GetAnchorPoint() string {
-	if x != nil {
-		return x.AnchorPoint
-	}
-	return ""
+// Deprecated: Use AssetBalance.ProtoReflect.Descriptor instead.
+func (*AssetBalance) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AssetOutput) GetAssetId() []byte {
+func (x *AssetBalance) GetAssetGenesis() *GenesisInfo {
 	if x != nil {
-		return x.AssetId
+		return x.AssetGenesis
 	}
 	return nil
 }
 
-func (x *AssetOutput) GetScriptKey() []byte {
+func (x *AssetBalance) GetAssetType() AssetType {
 	if x != nil {
-		return x.ScriptKey
+		return x.AssetType
 	}
-	return nil
+	return AssetType_NORMAL
 }
 
-func (x *AssetOutput) GetAmount() int64 {
+func (x *AssetBalance) GetBalance() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Balance
 	}
 	return 0
 }
 
-func (x *AssetOutput) GetNewProofBlob() []byte {
+func (x *AssetBalance) GetDecimalDisplay() uint32 {
 	if x != nil {
-		return x.NewProofBlob
+		return x.DecimalDisplay
 	}
-	return nil
+	return 0
 }
 
-func (x *AssetOutput) GetSplitCommitProof() []byte {
+func (x *AssetBalance) GetDisplayBalance() string {
 	if x != nil {
-		return x.SplitCommitProof
+		return x.DisplayBalance
 	}
-	return nil
+	return ""
 }
 
-type TaroTransfer struct {
+type AssetFamilyBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldTaroRoot []byte            `protobuf:"bytes,1,opt,name=old_taro_root,json=oldTaroRoot,proto3" json:"old_taro_root,omitempty"`
-	NewTaroRoot []byte            `protobuf:"bytes,2,opt,name=new_taro_root,json=newTaroRoot,proto3" json:"new_taro_root,omitempty"`
-	PrevInputs  []*PrevInputAsset `protobuf:"bytes,3,rep,name=prev_inputs,json=prevInputs,proto3" json:"prev_inputs,omitempty"`
-	NewOutputs  []*AssetOutput    `protobuf:"bytes,4,rep,name=new_outputs,json=newOutputs,proto3" json:"new_outputs,omitempty"`
+	// The family key or nil aggregating assets that don't have a family.
+	FamilyKey []byte `protobuf:"bytes,1,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The total balance of the assets in the family.
+	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The number of decimal places the balance should be displayed with.
+	DecimalDisplay uint32 `protobuf:"varint,3,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
+	// The balance formatted with the decimal display of the family.
+	DisplayBalance string `protobuf:"bytes,4,opt,name=display_balance,json=displayBalance,proto3" json:"display_balance,omitempty"`
 }
 
-func (x *TaroTransfer) Reset() {
-	*x = TaroTransfer{}
+func (x *AssetFamilyBalance) Reset() {
+	*x = AssetFamilyBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[36]
+		mi := &file_taro_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaroTransfer) String() string {
+func (x *AssetFamilyBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaroTransfer) ProtoMessage() {}
+func (*AssetFamilyBalance) ProtoMessage() {}
 
-func (x *TaroTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[36]
+func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,53 +3028,102 @@ func (x *TaroTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaroTransfer.ProtoReflect.Descriptor instead.
-func (*TaroTransfer) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AssetFamilyBalance.ProtoReflect.Descriptor instead.
+func (*AssetFamilyBalance) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *TaroTransfer) GetOldTaroRoot() []byte {
+func (x *AssetFamilyBalance) GetFamilyKey() []byte {
 	if x != nil {
-		return x.OldTaroRoot
+		return x.FamilyKey
 	}
 	return nil
 }
 
-func (x *TaroTransfer) GetNewTaroRoot() []byte {
+func (x *AssetFamilyBalance) GetBalance() int64 {
 	if x != nil {
-		return x.NewTaroRoot
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-func (x *TaroTransfer) GetPrevInputs() []*PrevInputAsset {
+func (x *AssetFamilyBalance) GetDecimalDisplay() uint32 {
 	if x != nil {
-		return x.PrevInputs
+		return x.DecimalDisplay
 	}
-	return nil
+	return 0
 }
 
-func (x *TaroTransfer) GetNewOutputs() []*AssetOutput {
+func (x *AssetFamilyBalance) GetDisplayBalance() string {
 	if x != nil {
-		return x.NewOutputs
+		return x.DisplayBalance
 	}
-	return nil
+	return ""
 }
 
-type SendAssetResponse struct {
+type ListBalancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransferTxid      []byte        `protobuf:"bytes,1,opt,name=transfer_txid,json=transferTxid,proto3" json:"transfer_txid,omitempty"`
-	AnchorOutputIndex int32         `protobuf:"varint,2,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
-	TransferTxBytes   []byte        `protobuf:"bytes,3,opt,name=transfer_tx_bytes,json=transferTxBytes,proto3" json:"transfer_tx_bytes,omitempty"`
-	TaroTransfer      *TaroTransfer `protobuf:"bytes,4,opt,name=taro_transfer,json=taroTransfer,proto3" json:"taro_transfer,omitempty"`
-	TotalFeeSats      int64         `protobuf:"varint,5,opt,name=total_fee_sats,json=totalFeeSats,proto3" json:"total_fee_sats,omitempty"`
+	AssetBalances       map[string]*AssetBalance       `protobuf:"bytes,1,rep,name=asset_balances,json=assetBalances,proto3" json:"asset_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AssetFamilyBalances map[string]*AssetFamilyBalance `protobuf:"bytes,2,rep,name=asset_family_balances,json=assetFamilyBalances,proto3" json:"asset_family_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SendAssetResponse) Reset() {
-	*x = SendAssetResponse{}
+func (x *ListBalancesResponse) Reset() {
+	*x = ListBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalancesResponse) ProtoMessage() {}
+
+func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListBalancesResponse) GetAssetBalances() map[string]*AssetBalance {
+	if x != nil {
+		return x.AssetBalances
+	}
+	return nil
+}
+
+func (x *ListBalancesResponse) GetAssetFamilyBalances() map[string]*AssetFamilyBalance {
+	if x != nil {
+		return x.AssetFamilyBalances
+	}
+	return nil
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2597,13 +3131,13 @@ func (x *SendAssetResponse) Reset() {
 	}
 }
 
-func (x *SendAssetResponse) String() string {
+func (x *ListTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetResponse) ProtoMessage() {}
+func (*ListTransfersRequest) ProtoMessage() {}
 
-func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2615,581 +3149,5139 @@ func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetResponse.ProtoReflect.Descriptor instead.
-func (*SendAssetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SendAssetResponse) GetTransferTxid() []byte {
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unordered list of outgoing asset transfers.
+	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
 	if x != nil {
-		return x.TransferTxid
+		return x.Transfers
 	}
 	return nil
 }
 
-func (x *SendAssetResponse) GetAnchorOutputIndex() int32 {
+type AssetTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
+	// The old/current location of the Taro commitment that was spent as an
+	// input.
+	OldAnchorPoint string `protobuf:"bytes,2,opt,name=old_anchor_point,json=oldAnchorPoint,proto3" json:"old_anchor_point,omitempty"`
+	// The new location of the Taro commitment referenced by the old anchor
+	// point.
+	NewAnchorPoint string `protobuf:"bytes,3,opt,name=new_anchor_point,json=newAnchorPoint,proto3" json:"new_anchor_point,omitempty"`
+	// The new Taro root that commits to the set of modified and unmodified
+	// assets.
+	TaroRoot []byte `protobuf:"bytes,4,opt,name=taro_root,json=taroRoot,proto3" json:"taro_root,omitempty"`
+	// The new transaction that commits to the set of Taro assets found at the
+	// above new anchor point.
+	AnchorTxHash []byte `protobuf:"bytes,5,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
+	// Describes the set of mutated assets that now live at the new anchor tx
+	// point.
+	AssetSpendDeltas []*AssetSpendDelta `protobuf:"bytes,6,rep,name=asset_spend_deltas,json=assetSpendDeltas,proto3" json:"asset_spend_deltas,omitempty"`
+}
+
+func (x *AssetTransfer) Reset() {
+	*x = AssetTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetTransfer) ProtoMessage() {}
+
+func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssetTransfer) GetTransferTimestamp() int64 {
 	if x != nil {
-		return x.AnchorOutputIndex
+		return x.TransferTimestamp
 	}
 	return 0
 }
 
-func (x *SendAssetResponse) GetTransferTxBytes() []byte {
+func (x *AssetTransfer) GetOldAnchorPoint() string {
 	if x != nil {
-		return x.TransferTxBytes
+		return x.OldAnchorPoint
 	}
-	return nil
+	return ""
 }
 
-func (x *SendAssetResponse) GetTaroTransfer() *TaroTransfer {
+func (x *AssetTransfer) GetNewAnchorPoint() string {
 	if x != nil {
-		return x.TaroTransfer
+		return x.NewAnchorPoint
 	}
-	return nil
+	return ""
 }
 
-func (x *SendAssetResponse) GetTotalFeeSats() int64 {
+func (x *AssetTransfer) GetTaroRoot() []byte {
 	if x != nil {
-		return x.TotalFeeSats
+		return x.TaroRoot
 	}
-	return 0
+	return nil
 }
 
-var File_taro_proto protoreflect.FileDescriptor
+func (x *AssetTransfer) GetAnchorTxHash() []byte {
+	if x != nil {
+		return x.AnchorTxHash
+	}
+	return nil
+}
 
-var file_taro_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x30,
-	0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
-	0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78,
-	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69,
-	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a,
-	0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75,
-	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0xe8, 0x01, 0x0a, 0x0b, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61,
-	0x77, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x77,
-	0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x46,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22, 0xa9, 0x03, 0x0a, 0x05, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c,
-	0x79, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x36,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x6d,
-	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x22, 0x96,
-	0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x57, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x15, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x13, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x57, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a,
-	0x18, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0d, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c,
-	0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6e, 0x65, 0x77, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x46, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65,
-	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24,
-	0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6d, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x41, 0x6d, 0x74, 0x22, 0x0d, 0x0a,
-	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x53, 0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75,
-	0x62, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x04,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
-	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x22, 0x38, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x0e,
-	0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14,
-	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x22,
-	0x27, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x4d, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x4b, 0x65, 0x79, 0x22, 0x58, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x15,
-	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x21, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x41, 0x6d, 0x74,
-	0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73,
-	0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x61,
-	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x75, 0x0a, 0x13, 0x41, 0x64,
-	0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x42, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
-	0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
-	0x72, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6,
-	0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x6f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f,
-	0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d,
-	0x6e, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a,
-	0x70, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6e, 0x65,
-	0x77, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x22, 0xf6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x6f,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x72, 0x6f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
-	0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42,
-	0x4c, 0x45, 0x10, 0x01, 0x2a, 0xd0, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44, 0x52, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45, 0x43, 0x45,
-	0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbb, 0x07, 0x0a, 0x04, 0x54, 0x61, 0x72, 0x6f,
-	0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
-	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
-	0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *AssetTransfer) GetAssetSpendDeltas() []*AssetSpendDelta {
+	if x != nil {
+		return x.AssetSpendDeltas
+	}
+	return nil
 }
 
-var (
-	file_taro_proto_rawDescOnce sync.Once
-	file_taro_proto_rawDescData = file_taro_proto_rawDesc
-)
+type AssetSpendDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_taro_proto_rawDescGZIP() []byte {
-	file_taro_proto_rawDescOnce.Do(func() {
-		file_taro_proto_rawDescData = protoimpl.X.CompressGZIP(file_taro_proto_rawDescData)
-	})
-	return file_taro_proto_rawDescData
+	// The asset ID that uniquely identifies the asset.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The old script key that uniquely identified the spent asset on disk.
+	OldScriptKey []byte `protobuf:"bytes,2,opt,name=old_script_key,json=oldScriptKey,proto3" json:"old_script_key,omitempty"`
+	// The new script key. We assume BIP 86 usage when updating the script keys
+	// on disk.
+	NewScriptKey []byte `protobuf:"bytes,3,opt,name=new_script_key,json=newScriptKey,proto3" json:"new_script_key,omitempty"`
+	// The new amount for the asset.
+	NewAmt int64 `protobuf:"varint,4,opt,name=new_amt,json=newAmt,proto3" json:"new_amt,omitempty"`
 }
 
-var file_taro_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_taro_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
-var file_taro_proto_goTypes = []interface{}{
-	(AssetType)(0),                // 0: tarorpc.AssetType
-	(AddrEventStatus)(0),          // 1: tarorpc.AddrEventStatus
-	(*MintAssetRequest)(nil),      // 2: tarorpc.MintAssetRequest
-	(*MintAssetResponse)(nil),     // 3: tarorpc.MintAssetResponse
-	(*ListAssetRequest)(nil),      // 4: tarorpc.ListAssetRequest
-	(*AnchorInfo)(nil),            // 5: tarorpc.AnchorInfo
-	(*GenesisInfo)(nil),           // 6: tarorpc.GenesisInfo
-	(*AssetFamily)(nil),           // 7: tarorpc.AssetFamily
-	(*Asset)(nil),                 // 8: tarorpc.Asset
-	(*ListAssetResponse)(nil),     // 9: tarorpc.ListAssetResponse
-	(*ListBalancesRequest)(nil),   // 10: tarorpc.ListBalancesRequest
-	(*AssetBalance)(nil),          // 11: tarorpc.AssetBalance
-	(*AssetFamilyBalance)(nil),    // 12: tarorpc.AssetFamilyBalance
-	(*ListBalancesResponse)(nil),  // 13: tarorpc.ListBalancesResponse
-	(*ListTransfersRequest)(nil),  // 14: tarorpc.ListTransfersRequest
-	(*ListTransfersResponse)(nil), // 15: tarorpc.ListTransfersResponse
-	(*AssetTransfer)(nil),         // 16: tarorpc.AssetTransfer
-	(*AssetSpendDelta)(nil),       // 17: tarorpc.AssetSpendDelta
-	(*StopRequest)(nil),           // 18: tarorpc.StopRequest
-	(*StopResponse)(nil),          // 19: tarorpc.StopResponse
-	(*DebugLevelRequest)(nil),     // 20: tarorpc.DebugLevelRequest
-	(*DebugLevelResponse)(nil),    // 21: tarorpc.DebugLevelResponse
-	(*Addr)(nil),                  // 22: tarorpc.Addr
-	(*QueryAddrRequest)(nil),      // 23: tarorpc.QueryAddrRequest
-	(*QueryAddrResponse)(nil),     // 24: tarorpc.QueryAddrResponse
-	(*NewAddrRequest)(nil),        // 25: tarorpc.NewAddrRequest
-	(*DecodeAddrRequest)(nil),     // 26: tarorpc.DecodeAddrRequest
-	(*ProofFile)(nil),             // 27: tarorpc.ProofFile
-	(*ProofVerifyResponse)(nil),   // 28: tarorpc.ProofVerifyResponse
-	(*ExportProofRequest)(nil),    // 29: tarorpc.ExportProofRequest
-	(*ImportProofRequest)(nil),    // 30: tarorpc.ImportProofRequest
-	(*ImportProofResponse)(nil),   // 31: tarorpc.ImportProofResponse
-	(*AddrEvent)(nil),             // 32: tarorpc.AddrEvent
-	(*AddrReceivesRequest)(nil),   // 33: tarorpc.AddrReceivesRequest
-	(*AddrReceivesResponse)(nil),  // 34: tarorpc.AddrReceivesResponse
-	(*SendAssetRequest)(nil),      // 35: tarorpc.SendAssetRequest
-	(*PrevInputAsset)(nil),        // 36: tarorpc.PrevInputAsset
-	(*AssetOutput)(nil),           // 37: tarorpc.AssetOutput
-	(*TaroTransfer)(nil),          // 38: tarorpc.TaroTransfer
-	(*SendAssetResponse)(nil),     // 39: tarorpc.SendAssetResponse
-	nil,                           // 40: tarorpc.ListBalancesResponse.AssetBalancesEntry
-	nil,                           // 41: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+func (x *AssetSpendDelta) Reset() {
+	*x = AssetSpendDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_taro_proto_depIdxs = []int32{
-	0,  // 0: tarorpc.MintAssetRequest.asset_type:type_name -> tarorpc.AssetType
-	6,  // 1: tarorpc.Asset.asset_genesis:type_name -> tarorpc.GenesisInfo
-	0,  // 2: tarorpc.Asset.asset_type:type_name -> tarorpc.AssetType
-	7,  // 3: tarorpc.Asset.asset_family:type_name -> tarorpc.AssetFamily
-	5,  // 4: tarorpc.Asset.chain_anchor:type_name -> tarorpc.AnchorInfo
-	8,  // 5: tarorpc.ListAssetResponse.assets:type_name -> tarorpc.Asset
-	6,  // 6: tarorpc.AssetBalance.asset_genesis:type_name -> tarorpc.GenesisInfo
-	0,  // 7: tarorpc.AssetBalance.asset_type:type_name -> tarorpc.AssetType
-	40, // 8: tarorpc.ListBalancesResponse.asset_balances:type_name -> tarorpc.ListBalancesResponse.AssetBalancesEntry
-	41, // 9: tarorpc.ListBalancesResponse.asset_family_balances:type_name -> tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
-	16, // 10: tarorpc.ListTransfersResponse.transfers:type_name -> tarorpc.AssetTransfer
-	17, // 11: tarorpc.AssetTransfer.asset_spend_deltas:type_name -> tarorpc.AssetSpendDelta
-	0,  // 12: tarorpc.Addr.asset_type:type_name -> tarorpc.AssetType
-	22, // 13: tarorpc.QueryAddrResponse.addrs:type_name -> tarorpc.Addr
-	22, // 14: tarorpc.AddrEvent.addr:type_name -> tarorpc.Addr
-	1,  // 15: tarorpc.AddrEvent.status:type_name -> tarorpc.AddrEventStatus
-	1,  // 16: tarorpc.AddrReceivesRequest.filter_status:type_name -> tarorpc.AddrEventStatus
-	32, // 17: tarorpc.AddrReceivesResponse.events:type_name -> tarorpc.AddrEvent
-	36, // 18: tarorpc.TaroTransfer.prev_inputs:type_name -> tarorpc.PrevInputAsset
-	37, // 19: tarorpc.TaroTransfer.new_outputs:type_name -> tarorpc.AssetOutput
-	38, // 20: tarorpc.SendAssetResponse.taro_transfer:type_name -> tarorpc.TaroTransfer
-	11, // 21: tarorpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> tarorpc.AssetBalance
-	12, // 22: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry.value:type_name -> tarorpc.AssetFamilyBalance
-	2,  // 23: tarorpc.Taro.MintAsset:input_type -> tarorpc.MintAssetRequest
-	4,  // 24: tarorpc.Taro.ListAssets:input_type -> tarorpc.ListAssetRequest
-	10, // 25: tarorpc.Taro.ListBalances:input_type -> tarorpc.ListBalancesRequest
-	14, // 26: tarorpc.Taro.ListTransfers:input_type -> tarorpc.ListTransfersRequest
-	18, // 27: tarorpc.Taro.StopDaemon:input_type -> tarorpc.StopRequest
-	20, // 28: tarorpc.Taro.DebugLevel:input_type -> tarorpc.DebugLevelRequest
-	23, // 29: tarorpc.Taro.QueryAddrs:input_type -> tarorpc.QueryAddrRequest
-	25, // 30: tarorpc.Taro.NewAddr:input_type -> tarorpc.NewAddrRequest
-	26, // 31: tarorpc.Taro.DecodeAddr:input_type -> tarorpc.DecodeAddrRequest
-	33, // 32: tarorpc.Taro.AddrReceives:input_type -> tarorpc.AddrReceivesRequest
-	27, // 33: tarorpc.Taro.VerifyProof:input_type -> tarorpc.ProofFile
-	29, // 34: tarorpc.Taro.ExportProof:input_type -> tarorpc.ExportProofRequest
-	30, // 35: tarorpc.Taro.ImportProof:input_type -> tarorpc.ImportProofRequest
-	35, // 36: tarorpc.Taro.SendAsset:input_type -> tarorpc.SendAssetRequest
-	3,  // 37: tarorpc.Taro.MintAsset:output_type -> tarorpc.MintAssetResponse
-	9,  // 38: tarorpc.Taro.ListAssets:output_type -> tarorpc.ListAssetResponse
-	13, // 39: tarorpc.Taro.ListBalances:output_type -> tarorpc.ListBalancesResponse
-	15, // 40: tarorpc.Taro.ListTransfers:output_type -> tarorpc.ListTransfersResponse
-	19, // 41: tarorpc.Taro.StopDaemon:output_type -> tarorpc.StopResponse
-	21, // 42: tarorpc.Taro.DebugLevel:output_type -> tarorpc.DebugLevelResponse
-	24, // 43: tarorpc.Taro.QueryAddrs:output_type -> tarorpc.QueryAddrResponse
-	22, // 44: tarorpc.Taro.NewAddr:output_type -> tarorpc.Addr
-	22, // 45: tarorpc.Taro.DecodeAddr:output_type -> tarorpc.Addr
-	34, // 46: tarorpc.Taro.AddrReceives:output_type -> tarorpc.AddrReceivesResponse
-	28, // 47: tarorpc.Taro.VerifyProof:output_type -> tarorpc.ProofVerifyResponse
-	27, // 48: tarorpc.Taro.ExportProof:output_type -> tarorpc.ProofFile
-	31, // 49: tarorpc.Taro.ImportProof:output_type -> tarorpc.ImportProofResponse
-	39, // 50: tarorpc.Taro.SendAsset:output_type -> tarorpc.SendAssetResponse
-	37, // [37:51] is the sub-list for method output_type
-	23, // [23:37] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+func (x *AssetSpendDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_taro_proto_init() }
-func file_taro_proto_init() {
-	if File_taro_proto != nil {
-		return
+func (*AssetSpendDelta) ProtoMessage() {}
+
+func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_taro_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetSpendDelta.ProtoReflect.Descriptor instead.
+func (*AssetSpendDelta) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AssetSpendDelta) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetSpendDelta) GetOldScriptKey() []byte {
+	if x != nil {
+		return x.OldScriptKey
+	}
+	return nil
+}
+
+func (x *AssetSpendDelta) GetNewScriptKey() []byte {
+	if x != nil {
+		return x.NewScriptKey
+	}
+	return nil
+}
+
+func (x *AssetSpendDelta) GetNewAmt() int64 {
+	if x != nil {
+		return x.NewAmt
+	}
+	return 0
+}
+
+type AssetHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The asset or asset family to list the history of. If neither is set, the
+	//history of all assets is returned.
+	//
+	// Types that are assignable to Filter:
+	//	*AssetHistoryRequest_AssetId
+	//	*AssetHistoryRequest_FamilyKey
+	Filter isAssetHistoryRequest_Filter `protobuf_oneof:"filter"`
+	// The number of history entries that should be skipped.
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	//
+	//The max number of history entries that should be returned. If this is
+	//zero, then all entries are returned.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AssetHistoryRequest) Reset() {
+	*x = AssetHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHistoryRequest) ProtoMessage() {}
+
+func (x *AssetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AssetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *AssetHistoryRequest) GetFilter() isAssetHistoryRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *AssetHistoryRequest) GetAssetId() []byte {
+	if x, ok := x.GetFilter().(*AssetHistoryRequest_AssetId); ok {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetHistoryRequest) GetFamilyKey() []byte {
+	if x, ok := x.GetFilter().(*AssetHistoryRequest_FamilyKey); ok {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *AssetHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AssetHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isAssetHistoryRequest_Filter interface {
+	isAssetHistoryRequest_Filter()
+}
+
+type AssetHistoryRequest_AssetId struct {
+	// Only list the history of the asset with this asset ID.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type AssetHistoryRequest_FamilyKey struct {
+	// Only list the history of the assets with this family key.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3,oneof"`
+}
+
+func (*AssetHistoryRequest_AssetId) isAssetHistoryRequest_Filter() {}
+
+func (*AssetHistoryRequest_FamilyKey) isAssetHistoryRequest_Filter() {}
+
+type AssetHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the history event.
+	EventType AssetHistoryEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=tarorpc.AssetHistoryEventType" json:"event_type,omitempty"`
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The family key of the asset, if it has one.
+	FamilyKey []byte `protobuf:"bytes,3,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The number of asset units that were minted, received, sent or burned.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The txid of the on-chain transaction of the event, if it's known.
+	Txid []byte `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//The height of the block that confirmed the transaction of the event. This
+	//is zero if the transaction isn't confirmed yet.
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The time of the event in unix timestamp seconds.
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//
+	//The counterparty of the event. For receives, this is the Taro address
+	//the asset was received on. For sends and burns, this is the hex encoded
+	//script key the asset was sent to. This is empty for mints.
+	Counterparty string `protobuf:"bytes,8,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (x *AssetHistoryEntry) Reset() {
+	*x = AssetHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHistoryEntry) ProtoMessage() {}
+
+func (x *AssetHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHistoryEntry.ProtoReflect.Descriptor instead.
+func (*AssetHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AssetHistoryEntry) GetEventType() AssetHistoryEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AssetHistoryEventType_ASSET_HISTORY_EVENT_TYPE_MINT
+}
+
+func (x *AssetHistoryEntry) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetHistoryEntry) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *AssetHistoryEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AssetHistoryEntry) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *AssetHistoryEntry) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *AssetHistoryEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AssetHistoryEntry) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+type AssetHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The history entries, ordered by time.
+	Entries []*AssetHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *AssetHistoryResponse) Reset() {
+	*x = AssetHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHistoryResponse) ProtoMessage() {}
+
+func (x *AssetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AssetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AssetHistoryResponse) GetEntries() []*AssetHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{44}
+}
+
+type StopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{45}
+}
+
+type DebugLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, all the valid debug sub-systems will be returned.
+	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+}
+
+func (x *DebugLevelRequest) Reset() {
+	*x = DebugLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelRequest) ProtoMessage() {}
+
+func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DebugLevelRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+func (x *DebugLevelRequest) GetLevelSpec() string {
+	if x != nil {
+		return x.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+}
+
+func (x *DebugLevelResponse) Reset() {
+	*x = DebugLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelResponse) ProtoMessage() {}
+
+func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DebugLevelResponse) GetSubSystems() string {
+	if x != nil {
+		return x.SubSystems
+	}
+	return ""
+}
+
+type Addr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bech32 encoded Taro address.
+	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	// The asset ID that uniquely identifies the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The total amount of the asset stored in this Taro UTXO.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The family key of the asset (if it exists)
+	FamilyKey []byte `protobuf:"bytes,5,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	//
+	//The specific script key the asset must commit to in order to transfer
+	//ownership to the creator of the address.
+	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The internal key used for the on-chain output.
+	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	//
+	//The tweaked internal key that commits to the asset and represents the
+	//on-chain output key the Bitcoin transaction must send to in order to
+	//transfer assets described in this address.
+	TaprootOutputKey []byte `protobuf:"bytes,8,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
+	// The number of decimal places the amount should be displayed with.
+	DecimalDisplay uint32 `protobuf:"varint,9,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
+	// The amount formatted with the decimal display of the asset.
+	DisplayAmount string `protobuf:"bytes,10,opt,name=display_amount,json=displayAmount,proto3" json:"display_amount,omitempty"`
+	//
+	//The Unix timestamp after which the address no longer accepts incoming
+	//asset transfers. Zero if the address never expires.
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//
+	//The maximum number of incoming asset transfers the address accepts. Zero
+	//if the address can be received to any number of times.
+	MaxReceives uint32 `protobuf:"varint,12,opt,name=max_receives,json=maxReceives,proto3" json:"max_receives,omitempty"`
+	// An optional label of the address that is only stored locally.
+	Label string `protobuf:"bytes,13,opt,name=label,proto3" json:"label,omitempty"`
+	// An optional set of key/value tags of the address that are only stored
+	// locally.
+	Tags map[string]string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Addr) Reset() {
+	*x = Addr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addr) ProtoMessage() {}
+
+func (x *Addr) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
+func (*Addr) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Addr) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+func (x *Addr) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *Addr) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *Addr) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Addr) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *Addr) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *Addr) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *Addr) GetTaprootOutputKey() []byte {
+	if x != nil {
+		return x.TaprootOutputKey
+	}
+	return nil
+}
+
+func (x *Addr) GetDecimalDisplay() uint32 {
+	if x != nil {
+		return x.DecimalDisplay
+	}
+	return 0
+}
+
+func (x *Addr) GetDisplayAmount() string {
+	if x != nil {
+		return x.DisplayAmount
+	}
+	return ""
+}
+
+func (x *Addr) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *Addr) GetMaxReceives() uint32 {
+	if x != nil {
+		return x.MaxReceives
+	}
+	return 0
+}
+
+func (x *Addr) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Addr) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type QueryAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, then only addresses created after this Unix timestamp will be
+	//returned.
+	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	//
+	//If set, then only addresses created before this Unix timestamp will be
+	//returned.
+	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// The max number of addresses that should be returned.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The offset from the addresses that should be returned.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	//
+	//If set, then only addresses that no longer accept incoming asset transfers
+	//will be returned, either because they expired or because they already
+	//received the maximum number of transfers.
+	ExpiredOnly bool `protobuf:"varint,5,opt,name=expired_only,json=expiredOnly,proto3" json:"expired_only,omitempty"`
+	//
+	//If set, then only addresses that still accept incoming asset transfers
+	//will be returned.
+	ActiveOnly bool `protobuf:"varint,6,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// If set, then only addresses with exactly this label will be returned.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//If set, then only addresses that have a tag with this key will be
+	//returned.
+	TagKey string `protobuf:"bytes,8,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	//
+	//If set, then only addresses that have a tag with the tag_key and this
+	//value will be returned. Requires tag_key to be set.
+	TagValue string `protobuf:"bytes,9,opt,name=tag_value,json=tagValue,proto3" json:"tag_value,omitempty"`
+}
+
+func (x *QueryAddrRequest) Reset() {
+	*x = QueryAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrRequest) ProtoMessage() {}
+
+func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
+func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *QueryAddrRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetExpiredOnly() bool {
+	if x != nil {
+		return x.ExpiredOnly
+	}
+	return false
+}
+
+func (x *QueryAddrRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *QueryAddrRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *QueryAddrRequest) GetTagKey() string {
+	if x != nil {
+		return x.TagKey
+	}
+	return ""
+}
+
+func (x *QueryAddrRequest) GetTagValue() string {
+	if x != nil {
+		return x.TagValue
+	}
+	return ""
+}
+
+type QueryAddrResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *QueryAddrResponse) Reset() {
+	*x = QueryAddrResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrResponse) ProtoMessage() {}
+
+func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
+func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *QueryAddrResponse) GetAddrs() []*Addr {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type NewAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GenesisBootstrapInfo []byte `protobuf:"bytes,1,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
+	FamKey               []byte `protobuf:"bytes,2,opt,name=fam_key,json=famKey,proto3" json:"fam_key,omitempty"`
+	//
+	//The amount of the asset to receive. If zero, an amount-less address is
+	//created for a normal asset, which accepts any amount chosen by the sender.
+	//An amount-less address can only be received to once.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//An optional Unix timestamp after which the address no longer accepts
+	//incoming asset transfers. Transfers received after the expiry are
+	//flagged as expired and not taken custody of.
+	Expiry int64 `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//
+	//An optional maximum number of incoming asset transfers the address
+	//accepts. Any further transfers are flagged and not taken custody of. Set
+	//to 1 for a single-use address.
+	MaxReceives uint32 `protobuf:"varint,5,opt,name=max_receives,json=maxReceives,proto3" json:"max_receives,omitempty"`
+	//
+	//An optional label of the address, for example to identify the invoice or
+	//counterparty the address was created for. The label is only stored
+	//locally and not encoded in the address.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional set of key/value tags of the address. Like the label, the tags
+	//are only stored locally and can be used to filter addresses in
+	//QueryAddrs.
+	Tags map[string]string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NewAddrRequest) Reset() {
+	*x = NewAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewAddrRequest) ProtoMessage() {}
+
+func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
+func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *NewAddrRequest) GetGenesisBootstrapInfo() []byte {
+	if x != nil {
+		return x.GenesisBootstrapInfo
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetFamKey() []byte {
+	if x != nil {
+		return x.FamKey
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetMaxReceives() uint32 {
+	if x != nil {
+		return x.MaxReceives
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NewAddrRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DecodeAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *DecodeAddrRequest) Reset() {
+	*x = DecodeAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeAddrRequest) ProtoMessage() {}
+
+func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
+func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DecodeAddrRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type ProofFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
+	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+}
+
+func (x *ProofFile) Reset() {
+	*x = ProofFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofFile) ProtoMessage() {}
+
+func (x *ProofFile) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
+func (*ProofFile) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ProofFile) GetRawProof() []byte {
+	if x != nil {
+		return x.RawProof
+	}
+	return nil
+}
+
+func (x *ProofFile) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+type ProofVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *ProofVerifyResponse) Reset() {
+	*x = ProofVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofVerifyResponse) ProtoMessage() {}
+
+func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofVerifyResponse.ProtoReflect.Descriptor instead.
+func (*ProofVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ProofVerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type ExportProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ExportProofRequest) Reset() {
+	*x = ExportProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProofRequest) ProtoMessage() {}
+
+func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
+func (*ExportProofRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportProofRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ExportProofRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ImportProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofFile    []byte `protobuf:"bytes,1,opt,name=proof_file,json=proofFile,proto3" json:"proof_file,omitempty"`
+	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+}
+
+func (x *ImportProofRequest) Reset() {
+	*x = ImportProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofRequest) ProtoMessage() {}
+
+func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofRequest.ProtoReflect.Descriptor instead.
+func (*ImportProofRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportProofRequest) GetProofFile() []byte {
+	if x != nil {
+		return x.ProofFile
+	}
+	return nil
+}
+
+func (x *ImportProofRequest) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+type ImportProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImportProofResponse) Reset() {
+	*x = ImportProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofResponse) ProtoMessage() {}
+
+func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofResponse.ProtoReflect.Descriptor instead.
+func (*ImportProofResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{57}
+}
+
+type DebugVerifyTransitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw proof file that contains the state transition to replay.
+	RawProof []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
+	//
+	//The index of the proof within the file that contains the state transition
+	//to replay. A negative index selects the last proof of the file.
+	ProofIndex int32 `protobuf:"varint,2,opt,name=proof_index,json=proofIndex,proto3" json:"proof_index,omitempty"`
+}
+
+func (x *DebugVerifyTransitionRequest) Reset() {
+	*x = DebugVerifyTransitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugVerifyTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugVerifyTransitionRequest) ProtoMessage() {}
+
+func (x *DebugVerifyTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugVerifyTransitionRequest.ProtoReflect.Descriptor instead.
+func (*DebugVerifyTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DebugVerifyTransitionRequest) GetRawProof() []byte {
+	if x != nil {
+		return x.RawProof
+	}
+	return nil
+}
+
+func (x *DebugVerifyTransitionRequest) GetProofIndex() int32 {
+	if x != nil {
+		return x.ProofIndex
+	}
+	return 0
+}
+
+type VMStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of step that was executed.
+	StepType VMStepType `protobuf:"varint,1,opt,name=step_type,json=stepType,proto3,enum=tarorpc.VMStepType" json:"step_type,omitempty"`
+	//
+	//The index of the input witness the step relates to, if the step is input
+	//specific.
+	InputIndex uint32 `protobuf:"varint,2,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	// A human readable description of the step.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The disassembled opcode that was executed, for script opcode steps.
+	Opcode string `protobuf:"bytes,4,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// The main stack of the script engine after the step was executed.
+	Stack [][]byte `protobuf:"bytes,5,rep,name=stack,proto3" json:"stack,omitempty"`
+	// The alternative stack of the script engine after the step was executed.
+	AltStack [][]byte `protobuf:"bytes,6,rep,name=alt_stack,json=altStack,proto3" json:"alt_stack,omitempty"`
+	// The error encountered during the step, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *VMStep) Reset() {
+	*x = VMStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VMStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMStep) ProtoMessage() {}
+
+func (x *VMStep) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMStep.ProtoReflect.Descriptor instead.
+func (*VMStep) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *VMStep) GetStepType() VMStepType {
+	if x != nil {
+		return x.StepType
+	}
+	return VMStepType_VM_STEP_TYPE_UNKNOWN
+}
+
+func (x *VMStep) GetInputIndex() uint32 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+func (x *VMStep) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VMStep) GetOpcode() string {
+	if x != nil {
+		return x.Opcode
+	}
+	return ""
+}
+
+func (x *VMStep) GetStack() [][]byte {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *VMStep) GetAltStack() [][]byte {
+	if x != nil {
+		return x.AltStack
+	}
+	return nil
+}
+
+func (x *VMStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DebugVerifyTransitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Whether the proof file is valid up to and including the replayed state
+	//transition.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The error that caused the verification to fail, if any.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The index of the proof within the file that was replayed.
+	ProofIndex uint32 `protobuf:"varint,3,opt,name=proof_index,json=proofIndex,proto3" json:"proof_index,omitempty"`
+	//
+	//The steps the Taro VM took while validating the replayed state transition,
+	//in execution order.
+	Steps []*VMStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *DebugVerifyTransitionResponse) Reset() {
+	*x = DebugVerifyTransitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugVerifyTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugVerifyTransitionResponse) ProtoMessage() {}
+
+func (x *DebugVerifyTransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugVerifyTransitionResponse.ProtoReflect.Descriptor instead.
+func (*DebugVerifyTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DebugVerifyTransitionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *DebugVerifyTransitionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DebugVerifyTransitionResponse) GetProofIndex() uint32 {
+	if x != nil {
+		return x.ProofIndex
+	}
+	return 0
+}
+
+func (x *DebugVerifyTransitionResponse) GetSteps() []*VMStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type AddrEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the event was created in unix timestamp seconds.
+	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
+	// The address the event was created for.
+	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The current status of the event.
+	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=tarorpc.AddrEventStatus" json:"status,omitempty"`
+	// The outpoint that contains the inbound asset transfer.
+	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The amount in satoshis that were transferred on chain along with the asset.
+	//This amount is independent of the requested asset amount, which can be
+	//looked up on the address.
+	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
+	//
+	//The taproot sibling hash that was used to send to the Taproot output.
+	//NOTE: Not yet implemented.
+	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
+	//
+	//The height at which the on-chain output was confirmed. If this is zero, it
+	//means the output is unconfirmed.
+	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	//
+	//Indicates whether a proof file can be found for the address' asset ID and
+	//script key.
+	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	//
+	//The amount of the asset that was received. This is the amount of the
+	//address, unless the address is amount-less.
+	AssetAmount uint64 `protobuf:"varint,9,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	//
+	//A human readable description of why the inbound asset transfer failed. This
+	//is only set for events in a failure status.
+	FailureReason string `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (x *AddrEvent) Reset() {
+	*x = AddrEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrEvent) ProtoMessage() {}
+
+func (x *AddrEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
+func (*AddrEvent) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
+	if x != nil {
+		return x.CreationTimeUnixSeconds
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetAddr() *Addr {
+	if x != nil {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetStatus() AddrEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *AddrEvent) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *AddrEvent) GetUtxoAmtSat() uint64 {
+	if x != nil {
+		return x.UtxoAmtSat
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetTaprootSibling() []byte {
+	if x != nil {
+		return x.TaprootSibling
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetConfirmationHeight() uint32 {
+	if x != nil {
+		return x.ConfirmationHeight
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetHasProof() bool {
+	if x != nil {
+		return x.HasProof
+	}
+	return false
+}
+
+func (x *AddrEvent) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type AddrReceivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter receives by a specific address. Leave empty to get all receives.
+	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
+	// Filter receives by a specific status. Leave empty to get all receives.
+	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=tarorpc.AddrEventStatus" json:"filter_status,omitempty"`
+	//
+	//Only return receives that failed. Cannot be combined with filter_status.
+	FailedOnly bool `protobuf:"varint,3,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+}
+
+func (x *AddrReceivesRequest) Reset() {
+	*x = AddrReceivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrReceivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrReceivesRequest) ProtoMessage() {}
+
+func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
+func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AddrReceivesRequest) GetFilterAddr() string {
+	if x != nil {
+		return x.FilterAddr
+	}
+	return ""
+}
+
+func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+	if x != nil {
+		return x.FilterStatus
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *AddrReceivesRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+type SubscribeReceiveEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter events by a specific address. Leave empty to get all events.
+	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
+	// Filter events by a specific status. Leave empty to get all events.
+	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=tarorpc.AddrEventStatus" json:"filter_status,omitempty"`
+	//
+	//Whether to also send all existing events that match the filter criteria
+	//when the subscription is started.
+	IncludeExisting bool `protobuf:"varint,3,opt,name=include_existing,json=includeExisting,proto3" json:"include_existing,omitempty"`
+}
+
+func (x *SubscribeReceiveEventsRequest) Reset() {
+	*x = SubscribeReceiveEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeReceiveEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeReceiveEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeReceiveEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeReceiveEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeReceiveEventsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubscribeReceiveEventsRequest) GetFilterAddr() string {
+	if x != nil {
+		return x.FilterAddr
+	}
+	return ""
+}
+
+func (x *SubscribeReceiveEventsRequest) GetFilterStatus() AddrEventStatus {
+	if x != nil {
+		return x.FilterStatus
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *SubscribeReceiveEventsRequest) GetIncludeExisting() bool {
+	if x != nil {
+		return x.IncludeExisting
+	}
+	return false
+}
+
+type AddrReceivesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events that match the filter criteria.
+	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AddrReceivesResponse) Reset() {
+	*x = AddrReceivesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrReceivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrReceivesResponse) ProtoMessage() {}
+
+func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
+func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ExportAddrsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportAddrsRequest) Reset() {
+	*x = ExportAddrsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAddrsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAddrsRequest) ProtoMessage() {}
+
+func (x *ExportAddrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAddrsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAddrsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{65}
+}
+
+type ExportAddrsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw address export file.
+	AddrFile []byte `protobuf:"bytes,1,opt,name=addr_file,json=addrFile,proto3" json:"addr_file,omitempty"`
+	// The number of addresses contained in the export file.
+	NumAddrs uint32 `protobuf:"varint,2,opt,name=num_addrs,json=numAddrs,proto3" json:"num_addrs,omitempty"`
+}
+
+func (x *ExportAddrsResponse) Reset() {
+	*x = ExportAddrsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAddrsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAddrsResponse) ProtoMessage() {}
+
+func (x *ExportAddrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAddrsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAddrsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportAddrsResponse) GetAddrFile() []byte {
+	if x != nil {
+		return x.AddrFile
+	}
+	return nil
+}
+
+func (x *ExportAddrsResponse) GetNumAddrs() uint32 {
+	if x != nil {
+		return x.NumAddrs
+	}
+	return 0
+}
+
+type ImportAddrsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw address export file, as created by ExportAddrs.
+	AddrFile []byte `protobuf:"bytes,1,opt,name=addr_file,json=addrFile,proto3" json:"addr_file,omitempty"`
+}
+
+func (x *ImportAddrsRequest) Reset() {
+	*x = ImportAddrsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAddrsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAddrsRequest) ProtoMessage() {}
+
+func (x *ImportAddrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAddrsRequest.ProtoReflect.Descriptor instead.
+func (*ImportAddrsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ImportAddrsRequest) GetAddrFile() []byte {
+	if x != nil {
+		return x.AddrFile
+	}
+	return nil
+}
+
+type ImportAddrsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The addresses of the export file that were imported.
+	ImportedAddrs []*Addr `protobuf:"bytes,1,rep,name=imported_addrs,json=importedAddrs,proto3" json:"imported_addrs,omitempty"`
+	// The number of addresses of the export file that were already known
+	// and therefore skipped.
+	NumSkipped uint32 `protobuf:"varint,2,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
+}
+
+func (x *ImportAddrsResponse) Reset() {
+	*x = ImportAddrsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAddrsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAddrsResponse) ProtoMessage() {}
+
+func (x *ImportAddrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAddrsResponse.ProtoReflect.Descriptor instead.
+func (*ImportAddrsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImportAddrsResponse) GetImportedAddrs() []*Addr {
+	if x != nil {
+		return x.ImportedAddrs
+	}
+	return nil
+}
+
+func (x *ImportAddrsResponse) GetNumSkipped() uint32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+type RescanAddrsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block height to start the rescan at.
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (x *RescanAddrsRequest) Reset() {
+	*x = RescanAddrsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanAddrsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanAddrsRequest) ProtoMessage() {}
+
+func (x *RescanAddrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanAddrsRequest.ProtoReflect.Descriptor instead.
+func (*RescanAddrsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RescanAddrsRequest) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+type RescanAddrsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block height the rescan started at.
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The total number of wallet transactions the rescan inspects.
+	NumTxns uint32 `protobuf:"varint,2,opt,name=num_txns,json=numTxns,proto3" json:"num_txns,omitempty"`
+	// The number of wallet transactions that were inspected so far.
+	NumInspected uint32 `protobuf:"varint,3,opt,name=num_inspected,json=numInspected,proto3" json:"num_inspected,omitempty"`
+	//
+	//The block height of the last inspected transaction. This is zero if the
+	//transaction is unconfirmed.
+	Height uint32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *RescanAddrsResponse) Reset() {
+	*x = RescanAddrsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanAddrsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanAddrsResponse) ProtoMessage() {}
+
+func (x *RescanAddrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanAddrsResponse.ProtoReflect.Descriptor instead.
+func (*RescanAddrsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RescanAddrsResponse) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *RescanAddrsResponse) GetNumTxns() uint32 {
+	if x != nil {
+		return x.NumTxns
+	}
+	return 0
+}
+
+func (x *RescanAddrsResponse) GetNumInspected() uint32 {
+	if x != nil {
+		return x.NumInspected
+	}
+	return 0
+}
+
+func (x *RescanAddrsResponse) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SendAssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaroAddr string `protobuf:"bytes,1,opt,name=taro_addr,json=taroAddr,proto3" json:"taro_addr,omitempty"`
+	// The strategy used to select the asset input of the send.
+	CoinSelectStrategy CoinSelectStrategy `protobuf:"varint,2,opt,name=coin_select_strategy,json=coinSelectStrategy,proto3,enum=tarorpc.CoinSelectStrategy" json:"coin_select_strategy,omitempty"`
+	//
+	//An optional list of anchor outpoints, in the form txid:vout. If set, the
+	//asset input of the send is only selected from these outpoints.
+	InputOutpoints []string `protobuf:"bytes,3,rep,name=input_outpoints,json=inputOutpoints,proto3" json:"input_outpoints,omitempty"`
+	//
+	//The amount of the asset to send. Must only be set if the address is
+	//amount-less, in which case it is required.
+	Amt int64 `protobuf:"varint,4,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (x *SendAssetRequest) Reset() {
+	*x = SendAssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAssetRequest) ProtoMessage() {}
+
+func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
+func (*SendAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SendAssetRequest) GetTaroAddr() string {
+	if x != nil {
+		return x.TaroAddr
+	}
+	return ""
+}
+
+func (x *SendAssetRequest) GetCoinSelectStrategy() CoinSelectStrategy {
+	if x != nil {
+		return x.CoinSelectStrategy
+	}
+	return CoinSelectStrategy_COIN_SELECT_DEFAULT
+}
+
+func (x *SendAssetRequest) GetInputOutpoints() []string {
+	if x != nil {
+		return x.InputOutpoints
+	}
+	return nil
+}
+
+func (x *SendAssetRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+type PrevInputAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
+	AssetId     []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey   []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	Amount      int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PrevInputAsset) Reset() {
+	*x = PrevInputAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevInputAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevInputAsset) ProtoMessage() {}
+
+func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevInputAsset.ProtoReflect.Descriptor instead.
+func (*PrevInputAsset) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PrevInputAsset) GetAnchorPoint() string {
+	if x != nil {
+		return x.AnchorPoint
+	}
+	return ""
+}
+
+func (x *PrevInputAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PrevInputAsset) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *PrevInputAsset) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AssetOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorPoint      string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
+	AssetId          []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey        []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	Amount           int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	NewProofBlob     []byte `protobuf:"bytes,5,opt,name=new_proof_blob,json=newProofBlob,proto3" json:"new_proof_blob,omitempty"`
+	SplitCommitProof []byte `protobuf:"bytes,6,opt,name=split_commit_proof,json=splitCommitProof,proto3" json:"split_commit_proof,omitempty"`
+}
+
+func (x *AssetOutput) Reset() {
+	*x = AssetOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetOutput) ProtoMessage() {}
+
+func (x *AssetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetOutput.ProtoReflect.Descriptor instead.
+func (*AssetOutput) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AssetOutput) GetAnchorPoint() string {
+	if x != nil {
+		return x.AnchorPoint
+	}
+	return ""
+}
+
+func (x *AssetOutput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetOutput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *AssetOutput) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AssetOutput) GetNewProofBlob() []byte {
+	if x != nil {
+		return x.NewProofBlob
+	}
+	return nil
+}
+
+func (x *AssetOutput) GetSplitCommitProof() []byte {
+	if x != nil {
+		return x.SplitCommitProof
+	}
+	return nil
+}
+
+type TaroTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldTaroRoot []byte            `protobuf:"bytes,1,opt,name=old_taro_root,json=oldTaroRoot,proto3" json:"old_taro_root,omitempty"`
+	NewTaroRoot []byte            `protobuf:"bytes,2,opt,name=new_taro_root,json=newTaroRoot,proto3" json:"new_taro_root,omitempty"`
+	PrevInputs  []*PrevInputAsset `protobuf:"bytes,3,rep,name=prev_inputs,json=prevInputs,proto3" json:"prev_inputs,omitempty"`
+	NewOutputs  []*AssetOutput    `protobuf:"bytes,4,rep,name=new_outputs,json=newOutputs,proto3" json:"new_outputs,omitempty"`
+}
+
+func (x *TaroTransfer) Reset() {
+	*x = TaroTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaroTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaroTransfer) ProtoMessage() {}
+
+func (x *TaroTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaroTransfer.ProtoReflect.Descriptor instead.
+func (*TaroTransfer) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TaroTransfer) GetOldTaroRoot() []byte {
+	if x != nil {
+		return x.OldTaroRoot
+	}
+	return nil
+}
+
+func (x *TaroTransfer) GetNewTaroRoot() []byte {
+	if x != nil {
+		return x.NewTaroRoot
+	}
+	return nil
+}
+
+func (x *TaroTransfer) GetPrevInputs() []*PrevInputAsset {
+	if x != nil {
+		return x.PrevInputs
+	}
+	return nil
+}
+
+func (x *TaroTransfer) GetNewOutputs() []*AssetOutput {
+	if x != nil {
+		return x.NewOutputs
+	}
+	return nil
+}
+
+type SendAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferTxid      []byte        `protobuf:"bytes,1,opt,name=transfer_txid,json=transferTxid,proto3" json:"transfer_txid,omitempty"`
+	AnchorOutputIndex int32         `protobuf:"varint,2,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+	TransferTxBytes   []byte        `protobuf:"bytes,3,opt,name=transfer_tx_bytes,json=transferTxBytes,proto3" json:"transfer_tx_bytes,omitempty"`
+	TaroTransfer      *TaroTransfer `protobuf:"bytes,4,opt,name=taro_transfer,json=taroTransfer,proto3" json:"taro_transfer,omitempty"`
+	TotalFeeSats      int64         `protobuf:"varint,5,opt,name=total_fee_sats,json=totalFeeSats,proto3" json:"total_fee_sats,omitempty"`
+}
+
+func (x *SendAssetResponse) Reset() {
+	*x = SendAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAssetResponse) ProtoMessage() {}
+
+func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAssetResponse.ProtoReflect.Descriptor instead.
+func (*SendAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SendAssetResponse) GetTransferTxid() []byte {
+	if x != nil {
+		return x.TransferTxid
+	}
+	return nil
+}
+
+func (x *SendAssetResponse) GetAnchorOutputIndex() int32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+func (x *SendAssetResponse) GetTransferTxBytes() []byte {
+	if x != nil {
+		return x.TransferTxBytes
+	}
+	return nil
+}
+
+func (x *SendAssetResponse) GetTaroTransfer() *TaroTransfer {
+	if x != nil {
+		return x.TaroTransfer
+	}
+	return nil
+}
+
+func (x *SendAssetResponse) GetTotalFeeSats() int64 {
+	if x != nil {
+		return x.TotalFeeSats
+	}
+	return 0
+}
+
+type EstimateSendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The taro address to estimate the send to.
+	TaroAddr string `protobuf:"bytes,1,opt,name=taro_addr,json=taroAddr,proto3" json:"taro_addr,omitempty"`
+	// The strategy used to select the asset input of the send.
+	CoinSelectStrategy CoinSelectStrategy `protobuf:"varint,2,opt,name=coin_select_strategy,json=coinSelectStrategy,proto3,enum=tarorpc.CoinSelectStrategy" json:"coin_select_strategy,omitempty"`
+	//
+	//An optional list of anchor outpoints, in the form txid:vout. If set, the
+	//asset input of the send is only selected from these outpoints.
+	InputOutpoints []string `protobuf:"bytes,3,rep,name=input_outpoints,json=inputOutpoints,proto3" json:"input_outpoints,omitempty"`
+	//
+	//The amount of the asset to send. Must only be set if the address is
+	//amount-less, in which case it is required.
+	Amt int64 `protobuf:"varint,4,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (x *EstimateSendRequest) Reset() {
+	*x = EstimateSendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateSendRequest) ProtoMessage() {}
+
+func (x *EstimateSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateSendRequest.ProtoReflect.Descriptor instead.
+func (*EstimateSendRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EstimateSendRequest) GetTaroAddr() string {
+	if x != nil {
+		return x.TaroAddr
+	}
+	return ""
+}
+
+func (x *EstimateSendRequest) GetCoinSelectStrategy() CoinSelectStrategy {
+	if x != nil {
+		return x.CoinSelectStrategy
+	}
+	return CoinSelectStrategy_COIN_SELECT_DEFAULT
+}
+
+func (x *EstimateSendRequest) GetInputOutpoints() []string {
+	if x != nil {
+		return x.InputOutpoints
+	}
+	return nil
+}
+
+func (x *EstimateSendRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+type EstimatedOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The index of the output of the transfer transaction that would anchor the
+	//asset.
+	AnchorOutputIndex uint32 `protobuf:"varint,1,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+	// The asset ID of the output.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the output.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The amount of the asset in the output.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the output holds the change of the sender.
+	IsChange bool `protobuf:"varint,5,opt,name=is_change,json=isChange,proto3" json:"is_change,omitempty"`
+}
+
+func (x *EstimatedOutput) Reset() {
+	*x = EstimatedOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatedOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatedOutput) ProtoMessage() {}
+
+func (x *EstimatedOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatedOutput.ProtoReflect.Descriptor instead.
+func (*EstimatedOutput) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *EstimatedOutput) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+func (x *EstimatedOutput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *EstimatedOutput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *EstimatedOutput) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EstimatedOutput) GetIsChange() bool {
+	if x != nil {
+		return x.IsChange
+	}
+	return false
+}
+
+type EstimateSendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset input that would be spent by the send.
+	Input *PrevInputAsset `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	//
+	//Whether only a part of the input is sent, so the rest needs to be split off
+	//into a change output.
+	NeedsSplit bool `protobuf:"varint,2,opt,name=needs_split,json=needsSplit,proto3" json:"needs_split,omitempty"`
+	// The asset outputs the send would create.
+	Outputs []*EstimatedOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// The fee rate the transfer transaction was funded with, in sat/kw.
+	SatPerKw int64 `protobuf:"varint,4,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
+	// The estimated on-chain fee of the transfer transaction.
+	TotalFeeSats int64 `protobuf:"varint,5,opt,name=total_fee_sats,json=totalFeeSats,proto3" json:"total_fee_sats,omitempty"`
+}
+
+func (x *EstimateSendResponse) Reset() {
+	*x = EstimateSendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateSendResponse) ProtoMessage() {}
+
+func (x *EstimateSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateSendResponse.ProtoReflect.Descriptor instead.
+func (*EstimateSendResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *EstimateSendResponse) GetInput() *PrevInputAsset {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *EstimateSendResponse) GetNeedsSplit() bool {
+	if x != nil {
+		return x.NeedsSplit
+	}
+	return false
+}
+
+func (x *EstimateSendResponse) GetOutputs() []*EstimatedOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *EstimateSendResponse) GetSatPerKw() int64 {
+	if x != nil {
+		return x.SatPerKw
+	}
+	return 0
+}
+
+func (x *EstimateSendResponse) GetTotalFeeSats() int64 {
+	if x != nil {
+		return x.TotalFeeSats
+	}
+	return 0
+}
+
+type BurnAssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset to burn units of.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The number of asset units to burn. This must be greater than zero.
+	AmountToBurn int64 `protobuf:"varint,2,opt,name=amount_to_burn,json=amountToBurn,proto3" json:"amount_to_burn,omitempty"`
+}
+
+func (x *BurnAssetRequest) Reset() {
+	*x = BurnAssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnAssetRequest) ProtoMessage() {}
+
+func (x *BurnAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnAssetRequest.ProtoReflect.Descriptor instead.
+func (*BurnAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BurnAssetRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *BurnAssetRequest) GetAmountToBurn() int64 {
+	if x != nil {
+		return x.AmountToBurn
+	}
+	return 0
+}
+
+type BurnAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transfer that sends the burned units to the burn key.
+	BurnTransfer *SendAssetResponse `protobuf:"bytes,1,opt,name=burn_transfer,json=burnTransfer,proto3" json:"burn_transfer,omitempty"`
+	//
+	//The provably unspendable script key the burned units were sent to. Once the
+	//transfer confirms, the burn proof can be exported using this key.
+	BurnScriptKey []byte `protobuf:"bytes,2,opt,name=burn_script_key,json=burnScriptKey,proto3" json:"burn_script_key,omitempty"`
+}
+
+func (x *BurnAssetResponse) Reset() {
+	*x = BurnAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnAssetResponse) ProtoMessage() {}
+
+func (x *BurnAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnAssetResponse.ProtoReflect.Descriptor instead.
+func (*BurnAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BurnAssetResponse) GetBurnTransfer() *SendAssetResponse {
+	if x != nil {
+		return x.BurnTransfer
+	}
+	return nil
+}
+
+func (x *BurnAssetResponse) GetBurnScriptKey() []byte {
+	if x != nil {
+		return x.BurnScriptKey
+	}
+	return nil
+}
+
+type ListBurnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the burns of the asset with the given ID are returned.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (x *ListBurnsRequest) Reset() {
+	*x = ListBurnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBurnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBurnsRequest) ProtoMessage() {}
+
+func (x *ListBurnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBurnsRequest.ProtoReflect.Descriptor instead.
+func (*ListBurnsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListBurnsRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+type AssetBurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset that was burned.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The family key of the asset that was burned, if the asset has one.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The number of asset units that were burned.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The provably unspendable script key the burned units were sent to.
+	BurnScriptKey []byte `protobuf:"bytes,4,opt,name=burn_script_key,json=burnScriptKey,proto3" json:"burn_script_key,omitempty"`
+	// The txid of the transaction that burned the asset units.
+	AnchorTxid []byte `protobuf:"bytes,5,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// Whether the transaction that burned the asset units is confirmed.
+	Confirmed bool `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The time the burn was initiated, as a unix timestamp.
+	BurnTimeUnix int64 `protobuf:"varint,7,opt,name=burn_time_unix,json=burnTimeUnix,proto3" json:"burn_time_unix,omitempty"`
+}
+
+func (x *AssetBurn) Reset() {
+	*x = AssetBurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetBurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetBurn) ProtoMessage() {}
+
+func (x *AssetBurn) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetBurn.ProtoReflect.Descriptor instead.
+func (*AssetBurn) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AssetBurn) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetBurn) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *AssetBurn) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AssetBurn) GetBurnScriptKey() []byte {
+	if x != nil {
+		return x.BurnScriptKey
+	}
+	return nil
+}
+
+func (x *AssetBurn) GetAnchorTxid() []byte {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return nil
+}
+
+func (x *AssetBurn) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *AssetBurn) GetBurnTimeUnix() int64 {
+	if x != nil {
+		return x.BurnTimeUnix
+	}
+	return 0
+}
+
+type ListBurnsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Burns []*AssetBurn `protobuf:"bytes,1,rep,name=burns,proto3" json:"burns,omitempty"`
+}
+
+func (x *ListBurnsResponse) Reset() {
+	*x = ListBurnsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBurnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBurnsResponse) ProtoMessage() {}
+
+func (x *ListBurnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBurnsResponse.ProtoReflect.Descriptor instead.
+func (*ListBurnsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListBurnsResponse) GetBurns() []*AssetBurn {
+	if x != nil {
+		return x.Burns
+	}
+	return nil
+}
+
+type FetchAssetMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Asset:
+	//	*FetchAssetMetaRequest_AssetId
+	//	*FetchAssetMetaRequest_MetaHash
+	Asset isFetchAssetMetaRequest_Asset `protobuf_oneof:"asset"`
+}
+
+func (x *FetchAssetMetaRequest) Reset() {
+	*x = FetchAssetMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchAssetMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAssetMetaRequest) ProtoMessage() {}
+
+func (x *FetchAssetMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAssetMetaRequest.ProtoReflect.Descriptor instead.
+func (*FetchAssetMetaRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{84}
+}
+
+func (m *FetchAssetMetaRequest) GetAsset() isFetchAssetMetaRequest_Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (x *FetchAssetMetaRequest) GetAssetId() []byte {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_AssetId); ok {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *FetchAssetMetaRequest) GetMetaHash() []byte {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_MetaHash); ok {
+		return x.MetaHash
+	}
+	return nil
+}
+
+type isFetchAssetMetaRequest_Asset interface {
+	isFetchAssetMetaRequest_Asset()
+}
+
+type FetchAssetMetaRequest_AssetId struct {
+	// The asset ID of the asset to fetch the metadata for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type FetchAssetMetaRequest_MetaHash struct {
+	// The sha256 hash of the metadata to fetch the preimage for.
+	MetaHash []byte `protobuf:"bytes,2,opt,name=meta_hash,json=metaHash,proto3,oneof"`
+}
+
+func (*FetchAssetMetaRequest_AssetId) isFetchAssetMetaRequest_Asset() {}
+
+func (*FetchAssetMetaRequest_MetaHash) isFetchAssetMetaRequest_Asset() {}
+
+type AssetMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The raw metadata as committed to in the asset genesis. For typed metadata
+	//this is the payload of the metadata envelope.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The type of the metadata.
+	Type AssetMetaType `protobuf:"varint,2,opt,name=type,proto3,enum=tarorpc.AssetMetaType" json:"type,omitempty"`
+	// The MIME type of the metadata, only set for media metadata.
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// The sha256 hash of the full metadata as committed to in the asset ID.
+	MetaHash []byte `protobuf:"bytes,4,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+}
+
+func (x *AssetMeta) Reset() {
+	*x = AssetMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetMeta) ProtoMessage() {}
+
+func (x *AssetMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetMeta.ProtoReflect.Descriptor instead.
+func (*AssetMeta) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AssetMeta) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AssetMeta) GetType() AssetMetaType {
+	if x != nil {
+		return x.Type
+	}
+	return AssetMetaType_META_TYPE_OPAQUE
+}
+
+func (x *AssetMeta) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AssetMeta) GetMetaHash() []byte {
+	if x != nil {
+		return x.MetaHash
+	}
+	return nil
+}
+
+var File_taro_proto protoreflect.FileDescriptor
+
+var file_taro_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x22, 0xf9, 0x02, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a,
+	0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61,
+	0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x22, 0x30, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x22, 0x4a, 0x0a, 0x15, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22,
+	0x55, 0x0a, 0x13, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x16, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xef, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x09, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x4d, 0x69, 0x6d, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12,
+	0x2b, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x54, 0x78,
+	0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x53, 0x61, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x4f, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2b, 0x0a,
+	0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xfd, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x75,
+	0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0x89, 0x03, 0x0a,
+	0x0b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77, 0x5f,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x72, 0x61, 0x77, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x12, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x77, 0x65, 0x61,
+	0x6b, 0x65, 0x64, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22, 0xd0,
+	0x03, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x36,
+	0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x83, 0x03, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x54, 0x78, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x22, 0x12, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
+	0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x61,
+	0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x1a, 0x55, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x61, 0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x4b, 0x65,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x0a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x22, 0xe8, 0x01, 0x0a,
+	0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x15, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x13, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x57, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x63, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x9d, 0x02, 0x0a,
+	0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2d,
+	0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a,
+	0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x22, 0x91, 0x01, 0x0a,
+	0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f,
+	0x6c, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x61,
+	0x6d, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x41, 0x6d, 0x74,
+	0x22, 0x8b, 0x01, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9d,
+	0x02, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x4c,
+	0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x0d, 0x0a, 0x0b,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53,
+	0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x9c, 0x04, 0x0a, 0x04, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9c, 0x02, 0x0a, 0x10, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x61, 0x64, 0x64,
+	0x72, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37,
+	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x22, 0x4d, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0x2b, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x58, 0x0a, 0x12,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a,
+	0x1c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xde, 0x01, 0x0a, 0x06,
+	0x56, 0x4d, 0x53, 0x74, 0x65, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x4d, 0x53, 0x74, 0x65, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x73, 0x74, 0x65, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6c,
+	0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a,
+	0x1d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x4d, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68,
+	0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xaa, 0x01, 0x0a, 0x1d, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3d, 0x0a,
+	0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x42, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x72, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4f, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x64, 0x64,
+	0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x41, 0x64, 0x64,
+	0x72, 0x73, 0x22, 0x31, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x64, 0x64,
+	0x72, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x6c, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x53, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x90, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x74,
+	0x78, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x54, 0x78,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xb9, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x12, 0x63, 0x6f,
+	0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0e,
+	0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xc7, 0x01, 0x0a,
+	0x0c, 0x54, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x72,
+	0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x35, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x22,
+	0xbc, 0x01, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x12, 0x63, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6d, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x22, 0xb0,
+	0x01, 0x0a, 0x0f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0xde, 0x01, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x65,
+	0x64, 0x73, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x6e, 0x65, 0x65, 0x64, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x0a, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x24, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x73, 0x22, 0x53, 0x0a, 0x10, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x22, 0x7c, 0x0a, 0x11, 0x42, 0x75, 0x72, 0x6e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d,
+	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0c, 0x62, 0x75, 0x72, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x72,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75,
+	0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62,
+	0x75, 0x72, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
+	0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x05, 0x62, 0x75, 0x72, 0x6e, 0x73,
+	0x22, 0x5c, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x48, 0x61, 0x73, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x85,
+	0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01,
+	0x2a, 0x4e, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f,
+	0x50, 0x41, 0x51, 0x55, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x54, 0x41, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d,
+	0x45, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x02,
+	0x2a, 0xdd, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12,
+	0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49,
+	0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07,
+	0x2a, 0xa6, 0x01, 0x0a, 0x15, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x53,
+	0x53, 0x45, 0x54, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x48, 0x49, 0x53,
+	0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x03, 0x2a, 0x90, 0x02, 0x0a, 0x0a, 0x56, 0x4d,
+	0x53, 0x74, 0x65, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4d, 0x5f, 0x53,
+	0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x4d, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x4d, 0x5f, 0x53, 0x54,
+	0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x4d,
+	0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a,
+	0x56, 0x4d, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a,
+	0x56, 0x4d, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x54,
+	0x4e, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a,
+	0x56, 0x4d, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x52,
+	0x49, 0x50, 0x54, 0x5f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a,
+	0x56, 0x4d, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x52,
+	0x49, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x07, 0x2a, 0x91, 0x03, 0x0a,
+	0x0f, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41,
+	0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52,
+	0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f,
+	0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2b,
+	0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x53,
+	0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x41,
+	0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x07,
+	0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x10, 0x08, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09,
+	0x2a, 0xc8, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x49, 0x4e, 0x5f,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00,
+	0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f,
+	0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49,
+	0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52,
+	0x53, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43,
+	0x54, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d,
+	0x49, 0x5a, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x05, 0x32, 0xf5, 0x12, 0x0a, 0x04,
+	0x54, 0x61, 0x72, 0x6f, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x6f,
+	0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x4b, 0x0a, 0x0c,
+	0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x16, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x48, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x63,
+	0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x72,
+	0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_taro_proto_rawDescOnce sync.Once
+	file_taro_proto_rawDescData = file_taro_proto_rawDesc
+)
+
+func file_taro_proto_rawDescGZIP() []byte {
+	file_taro_proto_rawDescOnce.Do(func() {
+		file_taro_proto_rawDescData = protoimpl.X.CompressGZIP(file_taro_proto_rawDescData)
+	})
+	return file_taro_proto_rawDescData
+}
+
+var file_taro_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_taro_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_taro_proto_goTypes = []interface{}{
+	(AssetType)(0),                        // 0: tarorpc.AssetType
+	(AssetMetaType)(0),                    // 1: tarorpc.AssetMetaType
+	(BatchState)(0),                       // 2: tarorpc.BatchState
+	(AssetHistoryEventType)(0),            // 3: tarorpc.AssetHistoryEventType
+	(VMStepType)(0),                       // 4: tarorpc.VMStepType
+	(AddrEventStatus)(0),                  // 5: tarorpc.AddrEventStatus
+	(CoinSelectStrategy)(0),               // 6: tarorpc.CoinSelectStrategy
+	(*MintAssetRequest)(nil),              // 7: tarorpc.MintAssetRequest
+	(*MintAssetResponse)(nil),             // 8: tarorpc.MintAssetResponse
+	(*MintAssetBatchRequest)(nil),         // 9: tarorpc.MintAssetBatchRequest
+	(*MintAssetBatchError)(nil),           // 10: tarorpc.MintAssetBatchError
+	(*MintAssetBatchResponse)(nil),        // 11: tarorpc.MintAssetBatchResponse
+	(*ListBatchesRequest)(nil),            // 12: tarorpc.ListBatchesRequest
+	(*BatchAsset)(nil),                    // 13: tarorpc.BatchAsset
+	(*MintingBatch)(nil),                  // 14: tarorpc.MintingBatch
+	(*ListBatchesResponse)(nil),           // 15: tarorpc.ListBatchesResponse
+	(*ListSeedlingsRequest)(nil),          // 16: tarorpc.ListSeedlingsRequest
+	(*PendingSeedling)(nil),               // 17: tarorpc.PendingSeedling
+	(*ListSeedlingsResponse)(nil),         // 18: tarorpc.ListSeedlingsResponse
+	(*CancelSeedlingRequest)(nil),         // 19: tarorpc.CancelSeedlingRequest
+	(*CancelSeedlingResponse)(nil),        // 20: tarorpc.CancelSeedlingResponse
+	(*CancelBatchRequest)(nil),            // 21: tarorpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),           // 22: tarorpc.CancelBatchResponse
+	(*FinalizeBatchRequest)(nil),          // 23: tarorpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),         // 24: tarorpc.FinalizeBatchResponse
+	(*ListAssetRequest)(nil),              // 25: tarorpc.ListAssetRequest
+	(*AnchorInfo)(nil),                    // 26: tarorpc.AnchorInfo
+	(*GenesisInfo)(nil),                   // 27: tarorpc.GenesisInfo
+	(*AssetFamily)(nil),                   // 28: tarorpc.AssetFamily
+	(*Asset)(nil),                         // 29: tarorpc.Asset
+	(*ListAssetResponse)(nil),             // 30: tarorpc.ListAssetResponse
+	(*SpentAsset)(nil),                    // 31: tarorpc.SpentAsset
+	(*ListUtxosRequest)(nil),              // 32: tarorpc.ListUtxosRequest
+	(*ManagedUtxo)(nil),                   // 33: tarorpc.ManagedUtxo
+	(*ListUtxosResponse)(nil),             // 34: tarorpc.ListUtxosResponse
+	(*ListLeasesRequest)(nil),             // 35: tarorpc.ListLeasesRequest
+	(*UtxoLease)(nil),                     // 36: tarorpc.UtxoLease
+	(*ListLeasesResponse)(nil),            // 37: tarorpc.ListLeasesResponse
+	(*ReleaseLeaseRequest)(nil),           // 38: tarorpc.ReleaseLeaseRequest
+	(*ReleaseLeaseResponse)(nil),          // 39: tarorpc.ReleaseLeaseResponse
+	(*ListBalancesRequest)(nil),           // 40: tarorpc.ListBalancesRequest
+	(*AssetBalance)(nil),                  // 41: tarorpc.AssetBalance
+	(*AssetFamilyBalance)(nil),            // 42: tarorpc.AssetFamilyBalance
+	(*ListBalancesResponse)(nil),          // 43: tarorpc.ListBalancesResponse
+	(*ListTransfersRequest)(nil),          // 44: tarorpc.ListTransfersRequest
+	(*ListTransfersResponse)(nil),         // 45: tarorpc.ListTransfersResponse
+	(*AssetTransfer)(nil),                 // 46: tarorpc.AssetTransfer
+	(*AssetSpendDelta)(nil),               // 47: tarorpc.AssetSpendDelta
+	(*AssetHistoryRequest)(nil),           // 48: tarorpc.AssetHistoryRequest
+	(*AssetHistoryEntry)(nil),             // 49: tarorpc.AssetHistoryEntry
+	(*AssetHistoryResponse)(nil),          // 50: tarorpc.AssetHistoryResponse
+	(*StopRequest)(nil),                   // 51: tarorpc.StopRequest
+	(*StopResponse)(nil),                  // 52: tarorpc.StopResponse
+	(*DebugLevelRequest)(nil),             // 53: tarorpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),            // 54: tarorpc.DebugLevelResponse
+	(*Addr)(nil),                          // 55: tarorpc.Addr
+	(*QueryAddrRequest)(nil),              // 56: tarorpc.QueryAddrRequest
+	(*QueryAddrResponse)(nil),             // 57: tarorpc.QueryAddrResponse
+	(*NewAddrRequest)(nil),                // 58: tarorpc.NewAddrRequest
+	(*DecodeAddrRequest)(nil),             // 59: tarorpc.DecodeAddrRequest
+	(*ProofFile)(nil),                     // 60: tarorpc.ProofFile
+	(*ProofVerifyResponse)(nil),           // 61: tarorpc.ProofVerifyResponse
+	(*ExportProofRequest)(nil),            // 62: tarorpc.ExportProofRequest
+	(*ImportProofRequest)(nil),            // 63: tarorpc.ImportProofRequest
+	(*ImportProofResponse)(nil),           // 64: tarorpc.ImportProofResponse
+	(*DebugVerifyTransitionRequest)(nil),  // 65: tarorpc.DebugVerifyTransitionRequest
+	(*VMStep)(nil),                        // 66: tarorpc.VMStep
+	(*DebugVerifyTransitionResponse)(nil), // 67: tarorpc.DebugVerifyTransitionResponse
+	(*AddrEvent)(nil),                     // 68: tarorpc.AddrEvent
+	(*AddrReceivesRequest)(nil),           // 69: tarorpc.AddrReceivesRequest
+	(*SubscribeReceiveEventsRequest)(nil), // 70: tarorpc.SubscribeReceiveEventsRequest
+	(*AddrReceivesResponse)(nil),          // 71: tarorpc.AddrReceivesResponse
+	(*ExportAddrsRequest)(nil),            // 72: tarorpc.ExportAddrsRequest
+	(*ExportAddrsResponse)(nil),           // 73: tarorpc.ExportAddrsResponse
+	(*ImportAddrsRequest)(nil),            // 74: tarorpc.ImportAddrsRequest
+	(*ImportAddrsResponse)(nil),           // 75: tarorpc.ImportAddrsResponse
+	(*RescanAddrsRequest)(nil),            // 76: tarorpc.RescanAddrsRequest
+	(*RescanAddrsResponse)(nil),           // 77: tarorpc.RescanAddrsResponse
+	(*SendAssetRequest)(nil),              // 78: tarorpc.SendAssetRequest
+	(*PrevInputAsset)(nil),                // 79: tarorpc.PrevInputAsset
+	(*AssetOutput)(nil),                   // 80: tarorpc.AssetOutput
+	(*TaroTransfer)(nil),                  // 81: tarorpc.TaroTransfer
+	(*SendAssetResponse)(nil),             // 82: tarorpc.SendAssetResponse
+	(*EstimateSendRequest)(nil),           // 83: tarorpc.EstimateSendRequest
+	(*EstimatedOutput)(nil),               // 84: tarorpc.EstimatedOutput
+	(*EstimateSendResponse)(nil),          // 85: tarorpc.EstimateSendResponse
+	(*BurnAssetRequest)(nil),              // 86: tarorpc.BurnAssetRequest
+	(*BurnAssetResponse)(nil),             // 87: tarorpc.BurnAssetResponse
+	(*ListBurnsRequest)(nil),              // 88: tarorpc.ListBurnsRequest
+	(*AssetBurn)(nil),                     // 89: tarorpc.AssetBurn
+	(*ListBurnsResponse)(nil),             // 90: tarorpc.ListBurnsResponse
+	(*FetchAssetMetaRequest)(nil),         // 91: tarorpc.FetchAssetMetaRequest
+	(*AssetMeta)(nil),                     // 92: tarorpc.AssetMeta
+	nil,                                   // 93: tarorpc.ListUtxosResponse.ManagedUtxosEntry
+	nil,                                   // 94: tarorpc.ListBalancesResponse.AssetBalancesEntry
+	nil,                                   // 95: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+	nil,                                   // 96: tarorpc.Addr.TagsEntry
+	nil,                                   // 97: tarorpc.NewAddrRequest.TagsEntry
+}
+var file_taro_proto_depIdxs = []int32{
+	0,  // 0: tarorpc.MintAssetRequest.asset_type:type_name -> tarorpc.AssetType
+	1,  // 1: tarorpc.MintAssetRequest.meta_type:type_name -> tarorpc.AssetMetaType
+	7,  // 2: tarorpc.MintAssetBatchRequest.assets:type_name -> tarorpc.MintAssetRequest
+	10, // 3: tarorpc.MintAssetBatchResponse.errors:type_name -> tarorpc.MintAssetBatchError
+	0,  // 4: tarorpc.BatchAsset.asset_type:type_name -> tarorpc.AssetType
+	1,  // 5: tarorpc.BatchAsset.meta_type:type_name -> tarorpc.AssetMetaType
+	2,  // 6: tarorpc.MintingBatch.state:type_name -> tarorpc.BatchState
+	13, // 7: tarorpc.MintingBatch.assets:type_name -> tarorpc.BatchAsset
+	14, // 8: tarorpc.ListBatchesResponse.batches:type_name -> tarorpc.MintingBatch
+	2,  // 9: tarorpc.PendingSeedling.batch_state:type_name -> tarorpc.BatchState
+	13, // 10: tarorpc.PendingSeedling.asset:type_name -> tarorpc.BatchAsset
+	17, // 11: tarorpc.ListSeedlingsResponse.seedlings:type_name -> tarorpc.PendingSeedling
+	14, // 12: tarorpc.FinalizeBatchResponse.batch:type_name -> tarorpc.MintingBatch
+	0,  // 13: tarorpc.ListAssetRequest.asset_type:type_name -> tarorpc.AssetType
+	1,  // 14: tarorpc.GenesisInfo.meta_type:type_name -> tarorpc.AssetMetaType
+	27, // 15: tarorpc.Asset.asset_genesis:type_name -> tarorpc.GenesisInfo
+	0,  // 16: tarorpc.Asset.asset_type:type_name -> tarorpc.AssetType
+	28, // 17: tarorpc.Asset.asset_family:type_name -> tarorpc.AssetFamily
+	26, // 18: tarorpc.Asset.chain_anchor:type_name -> tarorpc.AnchorInfo
+	29, // 19: tarorpc.ListAssetResponse.assets:type_name -> tarorpc.Asset
+	31, // 20: tarorpc.ListAssetResponse.spent_assets:type_name -> tarorpc.SpentAsset
+	27, // 21: tarorpc.SpentAsset.asset_genesis:type_name -> tarorpc.GenesisInfo
+	0,  // 22: tarorpc.SpentAsset.asset_type:type_name -> tarorpc.AssetType
+	29, // 23: tarorpc.ManagedUtxo.assets:type_name -> tarorpc.Asset
+	93, // 24: tarorpc.ListUtxosResponse.managed_utxos:type_name -> tarorpc.ListUtxosResponse.ManagedUtxosEntry
+	36, // 25: tarorpc.ListLeasesResponse.leases:type_name -> tarorpc.UtxoLease
+	27, // 26: tarorpc.AssetBalance.asset_genesis:type_name -> tarorpc.GenesisInfo
+	0,  // 27: tarorpc.AssetBalance.asset_type:type_name -> tarorpc.AssetType
+	94, // 28: tarorpc.ListBalancesResponse.asset_balances:type_name -> tarorpc.ListBalancesResponse.AssetBalancesEntry
+	95, // 29: tarorpc.ListBalancesResponse.asset_family_balances:type_name -> tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+	46, // 30: tarorpc.ListTransfersResponse.transfers:type_name -> tarorpc.AssetTransfer
+	47, // 31: tarorpc.AssetTransfer.asset_spend_deltas:type_name -> tarorpc.AssetSpendDelta
+	3,  // 32: tarorpc.AssetHistoryEntry.event_type:type_name -> tarorpc.AssetHistoryEventType
+	49, // 33: tarorpc.AssetHistoryResponse.entries:type_name -> tarorpc.AssetHistoryEntry
+	0,  // 34: tarorpc.Addr.asset_type:type_name -> tarorpc.AssetType
+	96, // 35: tarorpc.Addr.tags:type_name -> tarorpc.Addr.TagsEntry
+	55, // 36: tarorpc.QueryAddrResponse.addrs:type_name -> tarorpc.Addr
+	97, // 37: tarorpc.NewAddrRequest.tags:type_name -> tarorpc.NewAddrRequest.TagsEntry
+	4,  // 38: tarorpc.VMStep.step_type:type_name -> tarorpc.VMStepType
+	66, // 39: tarorpc.DebugVerifyTransitionResponse.steps:type_name -> tarorpc.VMStep
+	55, // 40: tarorpc.AddrEvent.addr:type_name -> tarorpc.Addr
+	5,  // 41: tarorpc.AddrEvent.status:type_name -> tarorpc.AddrEventStatus
+	5,  // 42: tarorpc.AddrReceivesRequest.filter_status:type_name -> tarorpc.AddrEventStatus
+	5,  // 43: tarorpc.SubscribeReceiveEventsRequest.filter_status:type_name -> tarorpc.AddrEventStatus
+	68, // 44: tarorpc.AddrReceivesResponse.events:type_name -> tarorpc.AddrEvent
+	55, // 45: tarorpc.ImportAddrsResponse.imported_addrs:type_name -> tarorpc.Addr
+	6,  // 46: tarorpc.SendAssetRequest.coin_select_strategy:type_name -> tarorpc.CoinSelectStrategy
+	79, // 47: tarorpc.TaroTransfer.prev_inputs:type_name -> tarorpc.PrevInputAsset
+	80, // 48: tarorpc.TaroTransfer.new_outputs:type_name -> tarorpc.AssetOutput
+	81, // 49: tarorpc.SendAssetResponse.taro_transfer:type_name -> tarorpc.TaroTransfer
+	6,  // 50: tarorpc.EstimateSendRequest.coin_select_strategy:type_name -> tarorpc.CoinSelectStrategy
+	79, // 51: tarorpc.EstimateSendResponse.input:type_name -> tarorpc.PrevInputAsset
+	84, // 52: tarorpc.EstimateSendResponse.outputs:type_name -> tarorpc.EstimatedOutput
+	82, // 53: tarorpc.BurnAssetResponse.burn_transfer:type_name -> tarorpc.SendAssetResponse
+	89, // 54: tarorpc.ListBurnsResponse.burns:type_name -> tarorpc.AssetBurn
+	1,  // 55: tarorpc.AssetMeta.type:type_name -> tarorpc.AssetMetaType
+	33, // 56: tarorpc.ListUtxosResponse.ManagedUtxosEntry.value:type_name -> tarorpc.ManagedUtxo
+	41, // 57: tarorpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> tarorpc.AssetBalance
+	42, // 58: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry.value:type_name -> tarorpc.AssetFamilyBalance
+	7,  // 59: tarorpc.Taro.MintAsset:input_type -> tarorpc.MintAssetRequest
+	9,  // 60: tarorpc.Taro.MintAssetBatch:input_type -> tarorpc.MintAssetBatchRequest
+	12, // 61: tarorpc.Taro.ListBatches:input_type -> tarorpc.ListBatchesRequest
+	16, // 62: tarorpc.Taro.ListSeedlings:input_type -> tarorpc.ListSeedlingsRequest
+	19, // 63: tarorpc.Taro.CancelSeedling:input_type -> tarorpc.CancelSeedlingRequest
+	21, // 64: tarorpc.Taro.CancelBatch:input_type -> tarorpc.CancelBatchRequest
+	23, // 65: tarorpc.Taro.FinalizeBatch:input_type -> tarorpc.FinalizeBatchRequest
+	25, // 66: tarorpc.Taro.ListAssets:input_type -> tarorpc.ListAssetRequest
+	32, // 67: tarorpc.Taro.ListUtxos:input_type -> tarorpc.ListUtxosRequest
+	35, // 68: tarorpc.Taro.ListLeases:input_type -> tarorpc.ListLeasesRequest
+	38, // 69: tarorpc.Taro.ReleaseLease:input_type -> tarorpc.ReleaseLeaseRequest
+	40, // 70: tarorpc.Taro.ListBalances:input_type -> tarorpc.ListBalancesRequest
+	44, // 71: tarorpc.Taro.ListTransfers:input_type -> tarorpc.ListTransfersRequest
+	48, // 72: tarorpc.Taro.AssetHistory:input_type -> tarorpc.AssetHistoryRequest
+	51, // 73: tarorpc.Taro.StopDaemon:input_type -> tarorpc.StopRequest
+	53, // 74: tarorpc.Taro.DebugLevel:input_type -> tarorpc.DebugLevelRequest
+	56, // 75: tarorpc.Taro.QueryAddrs:input_type -> tarorpc.QueryAddrRequest
+	58, // 76: tarorpc.Taro.NewAddr:input_type -> tarorpc.NewAddrRequest
+	59, // 77: tarorpc.Taro.DecodeAddr:input_type -> tarorpc.DecodeAddrRequest
+	69, // 78: tarorpc.Taro.AddrReceives:input_type -> tarorpc.AddrReceivesRequest
+	70, // 79: tarorpc.Taro.SubscribeReceiveEvents:input_type -> tarorpc.SubscribeReceiveEventsRequest
+	72, // 80: tarorpc.Taro.ExportAddrs:input_type -> tarorpc.ExportAddrsRequest
+	74, // 81: tarorpc.Taro.ImportAddrs:input_type -> tarorpc.ImportAddrsRequest
+	76, // 82: tarorpc.Taro.RescanAddrs:input_type -> tarorpc.RescanAddrsRequest
+	60, // 83: tarorpc.Taro.VerifyProof:input_type -> tarorpc.ProofFile
+	62, // 84: tarorpc.Taro.ExportProof:input_type -> tarorpc.ExportProofRequest
+	63, // 85: tarorpc.Taro.ImportProof:input_type -> tarorpc.ImportProofRequest
+	65, // 86: tarorpc.Taro.DebugVerifyTransition:input_type -> tarorpc.DebugVerifyTransitionRequest
+	78, // 87: tarorpc.Taro.SendAsset:input_type -> tarorpc.SendAssetRequest
+	83, // 88: tarorpc.Taro.EstimateSend:input_type -> tarorpc.EstimateSendRequest
+	86, // 89: tarorpc.Taro.BurnAsset:input_type -> tarorpc.BurnAssetRequest
+	88, // 90: tarorpc.Taro.ListBurns:input_type -> tarorpc.ListBurnsRequest
+	91, // 91: tarorpc.Taro.FetchAssetMeta:input_type -> tarorpc.FetchAssetMetaRequest
+	8,  // 92: tarorpc.Taro.MintAsset:output_type -> tarorpc.MintAssetResponse
+	11, // 93: tarorpc.Taro.MintAssetBatch:output_type -> tarorpc.MintAssetBatchResponse
+	15, // 94: tarorpc.Taro.ListBatches:output_type -> tarorpc.ListBatchesResponse
+	18, // 95: tarorpc.Taro.ListSeedlings:output_type -> tarorpc.ListSeedlingsResponse
+	20, // 96: tarorpc.Taro.CancelSeedling:output_type -> tarorpc.CancelSeedlingResponse
+	22, // 97: tarorpc.Taro.CancelBatch:output_type -> tarorpc.CancelBatchResponse
+	24, // 98: tarorpc.Taro.FinalizeBatch:output_type -> tarorpc.FinalizeBatchResponse
+	30, // 99: tarorpc.Taro.ListAssets:output_type -> tarorpc.ListAssetResponse
+	34, // 100: tarorpc.Taro.ListUtxos:output_type -> tarorpc.ListUtxosResponse
+	37, // 101: tarorpc.Taro.ListLeases:output_type -> tarorpc.ListLeasesResponse
+	39, // 102: tarorpc.Taro.ReleaseLease:output_type -> tarorpc.ReleaseLeaseResponse
+	43, // 103: tarorpc.Taro.ListBalances:output_type -> tarorpc.ListBalancesResponse
+	45, // 104: tarorpc.Taro.ListTransfers:output_type -> tarorpc.ListTransfersResponse
+	50, // 105: tarorpc.Taro.AssetHistory:output_type -> tarorpc.AssetHistoryResponse
+	52, // 106: tarorpc.Taro.StopDaemon:output_type -> tarorpc.StopResponse
+	54, // 107: tarorpc.Taro.DebugLevel:output_type -> tarorpc.DebugLevelResponse
+	57, // 108: tarorpc.Taro.QueryAddrs:output_type -> tarorpc.QueryAddrResponse
+	55, // 109: tarorpc.Taro.NewAddr:output_type -> tarorpc.Addr
+	55, // 110: tarorpc.Taro.DecodeAddr:output_type -> tarorpc.Addr
+	71, // 111: tarorpc.Taro.AddrReceives:output_type -> tarorpc.AddrReceivesResponse
+	68, // 112: tarorpc.Taro.SubscribeReceiveEvents:output_type -> tarorpc.AddrEvent
+	73, // 113: tarorpc.Taro.ExportAddrs:output_type -> tarorpc.ExportAddrsResponse
+	75, // 114: tarorpc.Taro.ImportAddrs:output_type -> tarorpc.ImportAddrsResponse
+	77, // 115: tarorpc.Taro.RescanAddrs:output_type -> tarorpc.RescanAddrsResponse
+	61, // 116: tarorpc.Taro.VerifyProof:output_type -> tarorpc.ProofVerifyResponse
+	60, // 117: tarorpc.Taro.ExportProof:output_type -> tarorpc.ProofFile
+	64, // 118: tarorpc.Taro.ImportProof:output_type -> tarorpc.ImportProofResponse
+	67, // 119: tarorpc.Taro.DebugVerifyTransition:output_type -> tarorpc.DebugVerifyTransitionResponse
+	82, // 120: tarorpc.Taro.SendAsset:output_type -> tarorpc.SendAssetResponse
+	85, // 121: tarorpc.Taro.EstimateSend:output_type -> tarorpc.EstimateSendResponse
+	87, // 122: tarorpc.Taro.BurnAsset:output_type -> tarorpc.BurnAssetResponse
+	90, // 123: tarorpc.Taro.ListBurns:output_type -> tarorpc.ListBurnsResponse
+	92, // 124: tarorpc.Taro.FetchAssetMeta:output_type -> tarorpc.AssetMeta
+	92, // [92:125] is the sub-list for method output_type
+	59, // [59:92] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_taro_proto_init() }
+func file_taro_proto_init() {
+	if File_taro_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_taro_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetBatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintingBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeedlingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingSeedling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeedlingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetFamily); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Asset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpentAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManagedUtxo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoLease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,8 +8292,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetResponse); i {
+		file_taro_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,8 +8304,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetRequest); i {
+		file_taro_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetFamilyBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,8 +8316,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnchorInfo); i {
+		file_taro_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,8 +8328,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenesisInfo); i {
+		file_taro_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,8 +8340,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetFamily); i {
+		file_taro_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,8 +8352,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Asset); i {
+		file_taro_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,8 +8364,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetResponse); i {
+		file_taro_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetSpendDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,8 +8376,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesRequest); i {
+		file_taro_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,8 +8388,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetBalance); i {
+		file_taro_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,8 +8400,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetFamilyBalance); i {
+		file_taro_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,8 +8412,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesResponse); i {
+		file_taro_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,8 +8424,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersRequest); i {
+		file_taro_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,8 +8436,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersResponse); i {
+		file_taro_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,8 +8448,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetTransfer); i {
+		file_taro_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,8 +8460,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetSpendDelta); i {
+		file_taro_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3380,8 +8472,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+		file_taro_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3392,8 +8484,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+		file_taro_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3404,8 +8496,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelRequest); i {
+		file_taro_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3416,8 +8508,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelResponse); i {
+		file_taro_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3428,8 +8520,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Addr); i {
+		file_taro_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3440,8 +8532,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrRequest); i {
+		file_taro_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofVerifyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3452,8 +8544,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrResponse); i {
+		file_taro_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3464,8 +8556,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddrRequest); i {
+		file_taro_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3476,8 +8568,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeAddrRequest); i {
+		file_taro_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3488,8 +8580,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofFile); i {
+		file_taro_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVerifyTransitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3500,8 +8592,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofVerifyResponse); i {
+		file_taro_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VMStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3512,8 +8604,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportProofRequest); i {
+		file_taro_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVerifyTransitionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3524,8 +8616,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProofRequest); i {
+		file_taro_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3536,8 +8628,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProofResponse); i {
+		file_taro_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrReceivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3548,8 +8640,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrEvent); i {
+		file_taro_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeReceiveEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3560,8 +8652,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrReceivesRequest); i {
+		file_taro_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrReceivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3572,8 +8664,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrReceivesResponse); i {
+		file_taro_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAddrsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3584,7 +8676,67 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAddrsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddrsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddrsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAddrsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAddrsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendAssetRequest); i {
 			case 0:
 				return &v.state
@@ -3596,7 +8748,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrevInputAsset); i {
 			case 0:
 				return &v.state
@@ -3608,7 +8760,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssetOutput); i {
 			case 0:
 				return &v.state
@@ -3620,7 +8772,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaroTransfer); i {
 			case 0:
 				return &v.state
@@ -3632,7 +8784,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendAssetResponse); i {
 			case 0:
 				return &v.state
@@ -3644,18 +8796,149 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
+		file_taro_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateSendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatedOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateSendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBurnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBurnsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchAssetMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_taro_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*ListAssetRequest_AssetType)(nil),
 	}
-	file_taro_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_taro_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*ListBalancesRequest_AssetId)(nil),
 		(*ListBalancesRequest_FamKey)(nil),
 	}
+	file_taro_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*AssetHistoryRequest_AssetId)(nil),
+		(*AssetHistoryRequest_FamilyKey)(nil),
+	}
+	file_taro_proto_msgTypes[84].OneofWrappers = []interface{}{
+		(*FetchAssetMetaRequest_AssetId)(nil),
+		(*FetchAssetMetaRequest_MetaHash)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_taro_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   40,
+			NumEnums:      7,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,15 +22,60 @@ type TaroClient interface {
 	//MintAsset will attempts to mint the set of assets (async by default to
 	//ensure proper batching) specified in the request.
 	MintAsset(ctx context.Context, in *MintAssetRequest, opts ...grpc.CallOption) (*MintAssetResponse, error)
+	// tarocli: `assets mint-batch`
+	//MintAssetBatch attempts to atomically queue a set of assets to be minted
+	//in the pending batch. All assets are validated first, if any of them is
+	//invalid then none of them are queued and the reason for each invalid asset
+	//is returned.
+	MintAssetBatch(ctx context.Context, in *MintAssetBatchRequest, opts ...grpc.CallOption) (*MintAssetBatchResponse, error)
+	// tarocli: `assets batches`
+	//ListBatches lists the minting batches of the target daemon, including the
+	//assets they create and their genesis transaction.
+	ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error)
+	// tarocli: `assets seedlings`
+	//ListSeedlings lists the asset seedlings that are queued for minting, but
+	//aren't yet part of a funded genesis transaction.
+	ListSeedlings(ctx context.Context, in *ListSeedlingsRequest, opts ...grpc.CallOption) (*ListSeedlingsResponse, error)
+	// tarocli: `assets cancelseedling`
+	//CancelSeedling removes a seedling from the pending minting batch. If it's
+	//the last seedling of the batch, the whole batch is cancelled.
+	CancelSeedling(ctx context.Context, in *CancelSeedlingRequest, opts ...grpc.CallOption) (*CancelSeedlingResponse, error)
+	// tarocli: `assets cancelbatch`
+	//CancelBatch cancels a minting batch whose genesis transaction hasn't been
+	//broadcast yet, and releases the wallet inputs locked for it.
+	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
+	// tarocli: `assets finalizebatch`
+	//FinalizeBatch freezes the pending minting batch immediately, without
+	//waiting for the next batch tick, and starts to mint its assets.
+	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
 	// tarocli: `assets list`
 	//ListAssets lists the set of assets owned by the target daemon.
 	ListAssets(ctx context.Context, in *ListAssetRequest, opts ...grpc.CallOption) (*ListAssetResponse, error)
+	// tarocli: `assets utxos`
+	//ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+	//hold.
+	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	// tarocli: `assets leases`
+	//ListLeases lists the UTXOs managed by the target daemon that are currently
+	//leased. The assets anchored at a leased UTXO can't be selected as the input
+	//of a new send.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
+	// tarocli: `assets releaselease`
+	//ReleaseLease releases the lease on a UTXO managed by the target daemon,
+	//which makes the assets anchored at it available for coin selection again.
+	//The lease on the input of a transfer that was already logged or broadcast
+	//can't be released.
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error)
 	// tarocli: `assets balance`
 	//ListBalances lists asset balances
 	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
 	// tarocli: `assets transfers`
 	//ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// tarocli: `assets history`
+	//AssetHistory lists the mints, receives, sends and burns of an asset or an
+	//asset family in the order they happened.
+	AssetHistory(ctx context.Context, in *AssetHistoryRequest, opts ...grpc.CallOption) (*AssetHistoryResponse, error)
 	// tarocli: `stop`
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
@@ -55,6 +100,29 @@ type TaroClient interface {
 	//List all receives for incoming asset transfers for addresses that were
 	//created previously.
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
+	// tarocli: `addrs subscribe`
+	//SubscribeReceiveEvents streams status changes of incoming asset transfers
+	//for addresses that were created previously, optionally filtered by address
+	//or status. The same state of a transfer might be sent more than once.
+	SubscribeReceiveEvents(ctx context.Context, in *SubscribeReceiveEventsRequest, opts ...grpc.CallOption) (Taro_SubscribeReceiveEventsClient, error)
+	// tarocli: `addrs export`
+	//ExportAddrs exports all Taro addresses, including the key information
+	//needed to re-derive their keys, as a versioned address export file. The
+	//file can be imported into another tarod instance using the same lnd seed
+	//with ImportAddrs.
+	ExportAddrs(ctx context.Context, in *ExportAddrsRequest, opts ...grpc.CallOption) (*ExportAddrsResponse, error)
+	// tarocli: `addrs import`
+	//ImportAddrs imports the Taro addresses of an address export file. Each
+	//address that isn't known yet is imported into the wallet again so incoming
+	//asset transfers to it are detected. Known addresses are skipped.
+	ImportAddrs(ctx context.Context, in *ImportAddrsRequest, opts ...grpc.CallOption) (*ImportAddrsResponse, error)
+	// tarocli: `addrs rescan`
+	//RescanAddrs replays all wallet transactions starting at the given block
+	//height and inspects them for inbound asset transfers to our addresses that
+	//were missed before. Transfers that are already known are left untouched, so
+	//a rescan can safely be repeated. The progress of the rescan is reported over
+	//the stream, which is closed once the rescan is complete.
+	RescanAddrs(ctx context.Context, in *RescanAddrsRequest, opts ...grpc.CallOption) (Taro_RescanAddrsClient, error)
 	// tarocli: `proofs verify`
 	//VerifyProof attempts to verify a given proof file that claims to be anchored
 	//at the specified genesis point.
@@ -68,11 +136,34 @@ type TaroClient interface {
 	//a new asset will be inserted on disk, spendable using the specified target
 	//script key, and internal key.
 	ImportProof(ctx context.Context, in *ImportProofRequest, opts ...grpc.CallOption) (*ImportProofResponse, error)
+	// tarocli: `proofs debug`
+	//DebugVerifyTransition replays a single state transition of the given proof
+	//file and returns each step the Taro VM took while validating it. This can
+	//be used to find out why a proof fails to verify.
+	DebugVerifyTransition(ctx context.Context, in *DebugVerifyTransitionRequest, opts ...grpc.CallOption) (*DebugVerifyTransitionResponse, error)
 	// tarocli: `assets send`
 	//SendAsset uses a passed taro address to attempt to complete an asset send.
 	//The method returns information w.r.t the on chain send, as well as the
 	//proof file information the receiver needs to fully receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tarocli: `assets estimatesend`
+	//EstimateSend does a dry run of an asset send to a taro address. It returns
+	//the asset input that would be spent, whether the input needs to be split,
+	//the resulting asset outputs and the estimated on-chain fee, without
+	//committing to the send.
+	EstimateSend(ctx context.Context, in *EstimateSendRequest, opts ...grpc.CallOption) (*EstimateSendResponse, error)
+	// tarocli: `assets burn`
+	//BurnAsset burns the given number of units of a given asset by sending them
+	//to a provably unspendable script key. Burning means irrevocably destroying
+	//a certain number of assets, reducing the total supply of the asset.
+	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
+	// tarocli: `assets listburns`
+	//ListBurns lists the asset burns that were initiated by the target daemon.
+	ListBurns(ctx context.Context, in *ListBurnsRequest, opts ...grpc.CallOption) (*ListBurnsResponse, error)
+	// tarocli: `assets meta`
+	//FetchAssetMeta reveals the metadata committed to in the genesis of an
+	//asset, looked up either by the asset ID or by the hash of the metadata.
+	FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error)
 }
 
 type taroClient struct {
@@ -92,6 +183,60 @@ func (c *taroClient) MintAsset(ctx context.Context, in *MintAssetRequest, opts .
 	return out, nil
 }
 
+func (c *taroClient) MintAssetBatch(ctx context.Context, in *MintAssetBatchRequest, opts ...grpc.CallOption) (*MintAssetBatchResponse, error) {
+	out := new(MintAssetBatchResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/MintAssetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error) {
+	out := new(ListBatchesResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ListSeedlings(ctx context.Context, in *ListSeedlingsRequest, opts ...grpc.CallOption) (*ListSeedlingsResponse, error) {
+	out := new(ListSeedlingsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListSeedlings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) CancelSeedling(ctx context.Context, in *CancelSeedlingRequest, opts ...grpc.CallOption) (*CancelSeedlingResponse, error) {
+	out := new(CancelSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/CancelSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error) {
+	out := new(CancelBatchResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/CancelBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error) {
+	out := new(FinalizeBatchResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/FinalizeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) ListAssets(ctx context.Context, in *ListAssetRequest, opts ...grpc.CallOption) (*ListAssetResponse, error) {
 	out := new(ListAssetResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListAssets", in, out, opts...)
@@ -101,6 +246,33 @@ func (c *taroClient) ListAssets(ctx context.Context, in *ListAssetRequest, opts
 	return out, nil
 }
 
+func (c *taroClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
+	out := new(ListUtxosResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	out := new(ListLeasesResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error) {
+	out := new(ReleaseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error) {
 	out := new(ListBalancesResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListBalances", in, out, opts...)
@@ -119,6 +291,15 @@ func (c *taroClient) ListTransfers(ctx context.Context, in *ListTransfersRequest
 	return out, nil
 }
 
+func (c *taroClient) AssetHistory(ctx context.Context, in *AssetHistoryRequest, opts ...grpc.CallOption) (*AssetHistoryResponse, error) {
+	out := new(AssetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/AssetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/StopDaemon", in, out, opts...)
@@ -173,6 +354,88 @@ func (c *taroClient) AddrReceives(ctx context.Context, in *AddrReceivesRequest,
 	return out, nil
 }
 
+func (c *taroClient) SubscribeReceiveEvents(ctx context.Context, in *SubscribeReceiveEventsRequest, opts ...grpc.CallOption) (Taro_SubscribeReceiveEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Taro_ServiceDesc.Streams[0], "/tarorpc.Taro/SubscribeReceiveEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taroSubscribeReceiveEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Taro_SubscribeReceiveEventsClient interface {
+	Recv() (*AddrEvent, error)
+	grpc.ClientStream
+}
+
+type taroSubscribeReceiveEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taroSubscribeReceiveEventsClient) Recv() (*AddrEvent, error) {
+	m := new(AddrEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taroClient) ExportAddrs(ctx context.Context, in *ExportAddrsRequest, opts ...grpc.CallOption) (*ExportAddrsResponse, error) {
+	out := new(ExportAddrsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ExportAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ImportAddrs(ctx context.Context, in *ImportAddrsRequest, opts ...grpc.CallOption) (*ImportAddrsResponse, error) {
+	out := new(ImportAddrsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ImportAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) RescanAddrs(ctx context.Context, in *RescanAddrsRequest, opts ...grpc.CallOption) (Taro_RescanAddrsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Taro_ServiceDesc.Streams[1], "/tarorpc.Taro/RescanAddrs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taroRescanAddrsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Taro_RescanAddrsClient interface {
+	Recv() (*RescanAddrsResponse, error)
+	grpc.ClientStream
+}
+
+type taroRescanAddrsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taroRescanAddrsClient) Recv() (*RescanAddrsResponse, error) {
+	m := new(RescanAddrsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *taroClient) VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*ProofVerifyResponse, error) {
 	out := new(ProofVerifyResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/VerifyProof", in, out, opts...)
@@ -200,6 +463,15 @@ func (c *taroClient) ImportProof(ctx context.Context, in *ImportProofRequest, op
 	return out, nil
 }
 
+func (c *taroClient) DebugVerifyTransition(ctx context.Context, in *DebugVerifyTransitionRequest, opts ...grpc.CallOption) (*DebugVerifyTransitionResponse, error) {
+	out := new(DebugVerifyTransitionResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/DebugVerifyTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/SendAsset", in, out, opts...)
@@ -209,6 +481,42 @@ func (c *taroClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts .
 	return out, nil
 }
 
+func (c *taroClient) EstimateSend(ctx context.Context, in *EstimateSendRequest, opts ...grpc.CallOption) (*EstimateSendResponse, error) {
+	out := new(EstimateSendResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/EstimateSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
+	out := new(BurnAssetResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/BurnAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ListBurns(ctx context.Context, in *ListBurnsRequest, opts ...grpc.CallOption) (*ListBurnsResponse, error) {
+	out := new(ListBurnsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error) {
+	out := new(AssetMeta)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/FetchAssetMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaroServer is the server API for Taro service.
 // All implementations must embed UnimplementedTaroServer
 // for forward compatibility
@@ -217,15 +525,60 @@ type TaroServer interface {
 	//MintAsset will attempts to mint the set of assets (async by default to
 	//ensure proper batching) specified in the request.
 	MintAsset(context.Context, *MintAssetRequest) (*MintAssetResponse, error)
+	// tarocli: `assets mint-batch`
+	//MintAssetBatch attempts to atomically queue a set of assets to be minted
+	//in the pending batch. All assets are validated first, if any of them is
+	//invalid then none of them are queued and the reason for each invalid asset
+	//is returned.
+	MintAssetBatch(context.Context, *MintAssetBatchRequest) (*MintAssetBatchResponse, error)
+	// tarocli: `assets batches`
+	//ListBatches lists the minting batches of the target daemon, including the
+	//assets they create and their genesis transaction.
+	ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error)
+	// tarocli: `assets seedlings`
+	//ListSeedlings lists the asset seedlings that are queued for minting, but
+	//aren't yet part of a funded genesis transaction.
+	ListSeedlings(context.Context, *ListSeedlingsRequest) (*ListSeedlingsResponse, error)
+	// tarocli: `assets cancelseedling`
+	//CancelSeedling removes a seedling from the pending minting batch. If it's
+	//the last seedling of the batch, the whole batch is cancelled.
+	CancelSeedling(context.Context, *CancelSeedlingRequest) (*CancelSeedlingResponse, error)
+	// tarocli: `assets cancelbatch`
+	//CancelBatch cancels a minting batch whose genesis transaction hasn't been
+	//broadcast yet, and releases the wallet inputs locked for it.
+	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
+	// tarocli: `assets finalizebatch`
+	//FinalizeBatch freezes the pending minting batch immediately, without
+	//waiting for the next batch tick, and starts to mint its assets.
+	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
 	// tarocli: `assets list`
 	//ListAssets lists the set of assets owned by the target daemon.
 	ListAssets(context.Context, *ListAssetRequest) (*ListAssetResponse, error)
+	// tarocli: `assets utxos`
+	//ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+	//hold.
+	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	// tarocli: `assets leases`
+	//ListLeases lists the UTXOs managed by the target daemon that are currently
+	//leased. The assets anchored at a leased UTXO can't be selected as the input
+	//of a new send.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
+	// tarocli: `assets releaselease`
+	//ReleaseLease releases the lease on a UTXO managed by the target daemon,
+	//which makes the assets anchored at it available for coin selection again.
+	//The lease on the input of a transfer that was already logged or broadcast
+	//can't be released.
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error)
 	// tarocli: `assets balance`
 	//ListBalances lists asset balances
 	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
 	// tarocli: `assets transfers`
 	//ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// tarocli: `assets history`
+	//AssetHistory lists the mints, receives, sends and burns of an asset or an
+	//asset family in the order they happened.
+	AssetHistory(context.Context, *AssetHistoryRequest) (*AssetHistoryResponse, error)
 	// tarocli: `stop`
 	//StopDaemon will send a shutdown request to the interrupt handler, triggering
 	//a graceful shutdown of the daemon.
@@ -250,6 +603,29 @@ type TaroServer interface {
 	//List all receives for incoming asset transfers for addresses that were
 	//created previously.
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
+	// tarocli: `addrs subscribe`
+	//SubscribeReceiveEvents streams status changes of incoming asset transfers
+	//for addresses that were created previously, optionally filtered by address
+	//or status. The same state of a transfer might be sent more than once.
+	SubscribeReceiveEvents(*SubscribeReceiveEventsRequest, Taro_SubscribeReceiveEventsServer) error
+	// tarocli: `addrs export`
+	//ExportAddrs exports all Taro addresses, including the key information
+	//needed to re-derive their keys, as a versioned address export file. The
+	//file can be imported into another tarod instance using the same lnd seed
+	//with ImportAddrs.
+	ExportAddrs(context.Context, *ExportAddrsRequest) (*ExportAddrsResponse, error)
+	// tarocli: `addrs import`
+	//ImportAddrs imports the Taro addresses of an address export file. Each
+	//address that isn't known yet is imported into the wallet again so incoming
+	//asset transfers to it are detected. Known addresses are skipped.
+	ImportAddrs(context.Context, *ImportAddrsRequest) (*ImportAddrsResponse, error)
+	// tarocli: `addrs rescan`
+	//RescanAddrs replays all wallet transactions starting at the given block
+	//height and inspects them for inbound asset transfers to our addresses that
+	//were missed before. Transfers that are already known are left untouched, so
+	//a rescan can safely be repeated. The progress of the rescan is reported over
+	//the stream, which is closed once the rescan is complete.
+	RescanAddrs(*RescanAddrsRequest, Taro_RescanAddrsServer) error
 	// tarocli: `proofs verify`
 	//VerifyProof attempts to verify a given proof file that claims to be anchored
 	//at the specified genesis point.
@@ -263,11 +639,34 @@ type TaroServer interface {
 	//a new asset will be inserted on disk, spendable using the specified target
 	//script key, and internal key.
 	ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error)
+	// tarocli: `proofs debug`
+	//DebugVerifyTransition replays a single state transition of the given proof
+	//file and returns each step the Taro VM took while validating it. This can
+	//be used to find out why a proof fails to verify.
+	DebugVerifyTransition(context.Context, *DebugVerifyTransitionRequest) (*DebugVerifyTransitionResponse, error)
 	// tarocli: `assets send`
 	//SendAsset uses a passed taro address to attempt to complete an asset send.
 	//The method returns information w.r.t the on chain send, as well as the
 	//proof file information the receiver needs to fully receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tarocli: `assets estimatesend`
+	//EstimateSend does a dry run of an asset send to a taro address. It returns
+	//the asset input that would be spent, whether the input needs to be split,
+	//the resulting asset outputs and the estimated on-chain fee, without
+	//committing to the send.
+	EstimateSend(context.Context, *EstimateSendRequest) (*EstimateSendResponse, error)
+	// tarocli: `assets burn`
+	//BurnAsset burns the given number of units of a given asset by sending them
+	//to a provably unspendable script key. Burning means irrevocably destroying
+	//a certain number of assets, reducing the total supply of the asset.
+	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
+	// tarocli: `assets listburns`
+	//ListBurns lists the asset burns that were initiated by the target daemon.
+	ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error)
+	// tarocli: `assets meta`
+	//FetchAssetMeta reveals the metadata committed to in the genesis of an
+	//asset, looked up either by the asset ID or by the hash of the metadata.
+	FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error)
 	mustEmbedUnimplementedTaroServer()
 }
 
@@ -278,15 +677,45 @@ type UnimplementedTaroServer struct {
 func (UnimplementedTaroServer) MintAsset(context.Context, *MintAssetRequest) (*MintAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintAsset not implemented")
 }
+func (UnimplementedTaroServer) MintAssetBatch(context.Context, *MintAssetBatchRequest) (*MintAssetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAssetBatch not implemented")
+}
+func (UnimplementedTaroServer) ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
+}
+func (UnimplementedTaroServer) ListSeedlings(context.Context, *ListSeedlingsRequest) (*ListSeedlingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeedlings not implemented")
+}
+func (UnimplementedTaroServer) CancelSeedling(context.Context, *CancelSeedlingRequest) (*CancelSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSeedling not implemented")
+}
+func (UnimplementedTaroServer) CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatch not implemented")
+}
+func (UnimplementedTaroServer) FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeBatch not implemented")
+}
 func (UnimplementedTaroServer) ListAssets(context.Context, *ListAssetRequest) (*ListAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
 }
+func (UnimplementedTaroServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
+}
+func (UnimplementedTaroServer) ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
+func (UnimplementedTaroServer) ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
 func (UnimplementedTaroServer) ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBalances not implemented")
 }
 func (UnimplementedTaroServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
 }
+func (UnimplementedTaroServer) AssetHistory(context.Context, *AssetHistoryRequest) (*AssetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetHistory not implemented")
+}
 func (UnimplementedTaroServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -305,6 +734,18 @@ func (UnimplementedTaroServer) DecodeAddr(context.Context, *DecodeAddrRequest) (
 func (UnimplementedTaroServer) AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddrReceives not implemented")
 }
+func (UnimplementedTaroServer) SubscribeReceiveEvents(*SubscribeReceiveEventsRequest, Taro_SubscribeReceiveEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeReceiveEvents not implemented")
+}
+func (UnimplementedTaroServer) ExportAddrs(context.Context, *ExportAddrsRequest) (*ExportAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAddrs not implemented")
+}
+func (UnimplementedTaroServer) ImportAddrs(context.Context, *ImportAddrsRequest) (*ImportAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAddrs not implemented")
+}
+func (UnimplementedTaroServer) RescanAddrs(*RescanAddrsRequest, Taro_RescanAddrsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RescanAddrs not implemented")
+}
 func (UnimplementedTaroServer) VerifyProof(context.Context, *ProofFile) (*ProofVerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
 }
@@ -314,9 +755,24 @@ func (UnimplementedTaroServer) ExportProof(context.Context, *ExportProofRequest)
 func (UnimplementedTaroServer) ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportProof not implemented")
 }
+func (UnimplementedTaroServer) DebugVerifyTransition(context.Context, *DebugVerifyTransitionRequest) (*DebugVerifyTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugVerifyTransition not implemented")
+}
 func (UnimplementedTaroServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaroServer) EstimateSend(context.Context, *EstimateSendRequest) (*EstimateSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSend not implemented")
+}
+func (UnimplementedTaroServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
+}
+func (UnimplementedTaroServer) ListBurns(context.Context, *ListBurnsRequest) (*ListBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBurns not implemented")
+}
+func (UnimplementedTaroServer) FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMeta not implemented")
+}
 func (UnimplementedTaroServer) mustEmbedUnimplementedTaroServer() {}
 
 // UnsafeTaroServer may be embedded to opt out of forward compatibility for this service.
@@ -348,6 +804,114 @@ func _Taro_MintAsset_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_MintAssetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintAssetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).MintAssetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/MintAssetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).MintAssetBatch(ctx, req.(*MintAssetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListBatches(ctx, req.(*ListBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ListSeedlings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeedlingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListSeedlings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListSeedlings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListSeedlings(ctx, req.(*ListSeedlingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_CancelSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).CancelSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/CancelSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).CancelSeedling(ctx, req.(*CancelSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).CancelBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/CancelBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).CancelBatch(ctx, req.(*CancelBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_FinalizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).FinalizeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/FinalizeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).FinalizeBatch(ctx, req.(*FinalizeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAssetRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +930,60 @@ func _Taro_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBalancesRequest)
 	if err := dec(in); err != nil {
@@ -402,6 +1020,24 @@ func _Taro_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_AssetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).AssetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/AssetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).AssetHistory(ctx, req.(*AssetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -510,6 +1146,84 @@ func _Taro_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_SubscribeReceiveEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeReceiveEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaroServer).SubscribeReceiveEvents(m, &taroSubscribeReceiveEventsServer{stream})
+}
+
+type Taro_SubscribeReceiveEventsServer interface {
+	Send(*AddrEvent) error
+	grpc.ServerStream
+}
+
+type taroSubscribeReceiveEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taroSubscribeReceiveEventsServer) Send(m *AddrEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Taro_ExportAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ExportAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ExportAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ExportAddrs(ctx, req.(*ExportAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ImportAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ImportAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ImportAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ImportAddrs(ctx, req.(*ImportAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_RescanAddrs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RescanAddrsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaroServer).RescanAddrs(m, &taroRescanAddrsServer{stream})
+}
+
+type Taro_RescanAddrsServer interface {
+	Send(*RescanAddrsResponse) error
+	grpc.ServerStream
+}
+
+type taroRescanAddrsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taroRescanAddrsServer) Send(m *RescanAddrsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Taro_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProofFile)
 	if err := dec(in); err != nil {
@@ -564,6 +1278,24 @@ func _Taro_ImportProof_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_DebugVerifyTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugVerifyTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).DebugVerifyTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/DebugVerifyTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).DebugVerifyTransition(ctx, req.(*DebugVerifyTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1314,78 @@ func _Taro_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_EstimateSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).EstimateSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/EstimateSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).EstimateSend(ctx, req.(*EstimateSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).BurnAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/BurnAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).BurnAsset(ctx, req.(*BurnAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ListBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListBurns(ctx, req.(*ListBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_FetchAssetMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchAssetMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).FetchAssetMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/FetchAssetMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).FetchAssetMeta(ctx, req.(*FetchAssetMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Taro_ServiceDesc is the grpc.ServiceDesc for Taro service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -593,10 +1397,46 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintAsset",
 			Handler:    _Taro_MintAsset_Handler,
 		},
+		{
+			MethodName: "MintAssetBatch",
+			Handler:    _Taro_MintAssetBatch_Handler,
+		},
+		{
+			MethodName: "ListBatches",
+			Handler:    _Taro_ListBatches_Handler,
+		},
+		{
+			MethodName: "ListSeedlings",
+			Handler:    _Taro_ListSeedlings_Handler,
+		},
+		{
+			MethodName: "CancelSeedling",
+			Handler:    _Taro_CancelSeedling_Handler,
+		},
+		{
+			MethodName: "CancelBatch",
+			Handler:    _Taro_CancelBatch_Handler,
+		},
+		{
+			MethodName: "FinalizeBatch",
+			Handler:    _Taro_FinalizeBatch_Handler,
+		},
 		{
 			MethodName: "ListAssets",
 			Handler:    _Taro_ListAssets_Handler,
 		},
+		{
+			MethodName: "ListUtxos",
+			Handler:    _Taro_ListUtxos_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _Taro_ListLeases_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _Taro_ReleaseLease_Handler,
+		},
 		{
 			MethodName: "ListBalances",
 			Handler:    _Taro_ListBalances_Handler,
@@ -605,6 +1445,10 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransfers",
 			Handler:    _Taro_ListTransfers_Handler,
 		},
+		{
+			MethodName: "AssetHistory",
+			Handler:    _Taro_AssetHistory_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _Taro_StopDaemon_Handler,
@@ -629,6 +1473,14 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddrReceives",
 			Handler:    _Taro_AddrReceives_Handler,
 		},
+		{
+			MethodName: "ExportAddrs",
+			Handler:    _Taro_ExportAddrs_Handler,
+		},
+		{
+			MethodName: "ImportAddrs",
+			Handler:    _Taro_ImportAddrs_Handler,
+		},
 		{
 			MethodName: "VerifyProof",
 			Handler:    _Taro_VerifyProof_Handler,
@@ -641,11 +1493,42 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportProof",
 			Handler:    _Taro_ImportProof_Handler,
 		},
+		{
+			MethodName: "DebugVerifyTransition",
+			Handler:    _Taro_DebugVerifyTransition_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _Taro_SendAsset_Handler,
 		},
+		{
+			MethodName: "EstimateSend",
+			Handler:    _Taro_EstimateSend_Handler,
+		},
+		{
+			MethodName: "BurnAsset",
+			Handler:    _Taro_BurnAsset_Handler,
+		},
+		{
+			MethodName: "ListBurns",
+			Handler:    _Taro_ListBurns_Handler,
+		},
+		{
+			MethodName: "FetchAssetMeta",
+			Handler:    _Taro_FetchAssetMeta_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeReceiveEvents",
+			Handler:       _Taro_SubscribeReceiveEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RescanAddrs",
+			Handler:       _Taro_RescanAddrs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "taro.proto",
 }
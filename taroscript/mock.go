@@ -91,7 +91,9 @@ func (m *MockSigner) SignOutputRaw(tx *wire.MsgTx,
 		return nil, err
 	}
 
-	return schnorr.ParseSignature(rawSig)
+	// The raw signature will have the sighash flag appended if it isn't
+	// SIGHASH_DEFAULT, so we only parse the signature itself.
+	return schnorr.ParseSignature(rawSig[:schnorr.SignatureSize])
 }
 
 func (m *MockSigner) SignVirtualTx(signDesc *lndclient.SignDescriptor,
@@ -94,7 +94,9 @@ func randFamilyKey(t *testing.T, genesis asset.Genesis) *asset.FamilyKey {
 	fakeKeyDesc := keychain.KeyDescriptor{
 		PubKey: privKey.PubKey(),
 	}
-	familyKey, err := asset.DeriveFamilyKey(genSigner, fakeKeyDesc, genesis)
+	familyKey, err := asset.DeriveFamilyKey(
+		genSigner, fakeKeyDesc, genesis, nil,
+	)
 	require.NoError(t, err)
 
 	return familyKey
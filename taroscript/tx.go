@@ -254,6 +254,53 @@ func VirtualTxWithInput(virtualTx *wire.MsgTx, input *asset.Asset,
 	return txCopy
 }
 
+// VirtualTxWithAnyoneCanPayInput returns a copy of the `virtualTx` amended to
+// only commit to the given input, as needed for signatures that carry the
+// SIGHASH_ANYONECANPAY flag.
+//
+// The prev out of the virtual input normally commits to the MS-SMT of all
+// inputs, so adding another input would invalidate the signature. Instead, the
+// prev out is derived from an MS-SMT that only contains this input, and the
+// prev index is always zero so the position of the input doesn't matter.
+func VirtualTxWithAnyoneCanPayInput(virtualTx *wire.MsgTx, prevID asset.PrevID,
+	input *asset.Asset, witness wire.TxWitness) (*wire.MsgTx, error) {
+
+	leaf, err := input.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	inputTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	ctx := context.Background()
+	_, err = inputTree.Insert(ctx, prevID.Hash(), leaf)
+	if err != nil {
+		return nil, err
+	}
+	treeRoot, err := inputTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txCopy := VirtualTxWithInput(virtualTx, input, zeroIndex, witness)
+	txCopy.TxIn[zeroIndex].PreviousOutPoint = *virtualTxInPrevOut(treeRoot)
+	return txCopy, nil
+}
+
+// virtualTxForSigHash returns a copy of the `virtualTx` amended to include the
+// details of the given input, following the semantics of the sighash flag.
+func virtualTxForSigHash(virtualTx *wire.MsgTx, prevID asset.PrevID,
+	input *asset.Asset, idx uint32,
+	sigHashType txscript.SigHashType) (*wire.MsgTx, error) {
+
+	if sigHashType&txscript.SigHashAnyOneCanPay != 0 {
+		return VirtualTxWithAnyoneCanPayInput(
+			virtualTx, prevID, input, nil,
+		)
+	}
+
+	return VirtualTxWithInput(virtualTx, input, idx, nil), nil
+}
+
 // InputAssetPrevOut returns a TxOut that represents the input asset in a
 // Taro virtual TX.
 func InputAssetPrevOut(prevAsset asset.Asset) (*wire.TxOut, error) {
@@ -292,46 +339,67 @@ func InputPrevOutFetcher(prevAsset asset.Asset) (*txscript.CannedPrevOutputFetch
 
 // InputKeySpendSigHash returns the signature hash of a virtual transaction for
 // a specific Taro input that can be spent through the key path. This is the
-// message over which signatures are generated over.
-func InputKeySpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32) ([]byte, error) {
-
-	virtualTxCopy := VirtualTxWithInput(virtualTx, input, idx, nil)
+// message over which signatures are generated over. The sighash flag follows
+// the BIP 341 semantics, applied to the single input and output of the virtual
+// transaction. With SIGHASH_ANYONECANPAY, the signature only commits to the
+// given input, identified by its prev ID.
+func InputKeySpendSigHash(virtualTx *wire.MsgTx, prevID asset.PrevID,
+	input *asset.Asset, idx uint32,
+	sigHashType txscript.SigHashType) ([]byte, error) {
+
+	virtualTxCopy, err := virtualTxForSigHash(
+		virtualTx, prevID, input, idx, sigHashType,
+	)
+	if err != nil {
+		return nil, err
+	}
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
 	}
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
 	return txscript.CalcTaprootSignatureHash(
-		sigHashes, txscript.SigHashDefault, virtualTxCopy, zeroIndex,
+		sigHashes, sigHashType, virtualTxCopy, zeroIndex,
 		prevOutFetcher,
 	)
 }
 
 // InputScriptSpendSigHash returns the signature hash of a virtual transaction
 // for a specific Taro input that can be spent through the script path. This is
-// the message over which signatures are generated over.
-func InputScriptSpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32, tapLeaf *txscript.TapLeaf) ([]byte, error) {
-
-	virtualTxCopy := VirtualTxWithInput(virtualTx, input, idx, nil)
+// the message over which signatures are generated over. The sighash flag
+// follows the BIP 341 semantics, applied to the single input and output of the
+// virtual transaction. With SIGHASH_ANYONECANPAY, the signature only commits
+// to the given input, identified by its prev ID.
+func InputScriptSpendSigHash(virtualTx *wire.MsgTx, prevID asset.PrevID,
+	input *asset.Asset, idx uint32, tapLeaf *txscript.TapLeaf,
+	sigHashType txscript.SigHashType) ([]byte, error) {
+
+	virtualTxCopy, err := virtualTxForSigHash(
+		virtualTx, prevID, input, idx, sigHashType,
+	)
+	if err != nil {
+		return nil, err
+	}
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
 	}
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
 	return txscript.CalcTapscriptSignaturehash(
-		sigHashes, txscript.SigHashDefault, virtualTxCopy, zeroIndex,
+		sigHashes, sigHashType, virtualTxCopy, zeroIndex,
 		prevOutFetcher, *tapLeaf,
 	)
 }
 
 // SignTaprootKeySpend computes a signature over a Taro virtual transaction
 // spending a Taro input through the key path, following BIP 86. This signature
-// is attached to a Taro output asset before state transition validation.
+// is attached to a Taro output asset before state transition validation. Any
+// sighash flag other than SIGHASH_DEFAULT is appended to the signature. If the
+// SIGHASH_ANYONECANPAY flag is used, the passed virtual transaction must be
+// created with VirtualTxWithAnyoneCanPayInput.
 func SignTaprootKeySpend(internalKey btcec.PublicKey, virtualTx *wire.MsgTx,
-	inputAsset *asset.Asset, idx int,
-	txSigner Signer) (*wire.TxWitness, error) {
+	inputAsset *asset.Asset, idx int, txSigner Signer,
+	sigHashType txscript.SigHashType) (*wire.TxWitness, error) {
 
 	// Compute a virtual prevOut from the input asset for the signer.
 	prevOut, err := InputAssetPrevOut(*inputAsset)
@@ -348,7 +416,7 @@ func SignTaprootKeySpend(internalKey btcec.PublicKey, virtualTx *wire.MsgTx,
 		},
 		SignMethod: input.TaprootKeySpendBIP0086SignMethod,
 		Output:     prevOut,
-		HashType:   txscript.SigHashDefault,
+		HashType:   sigHashType,
 		InputIndex: idx,
 	}
 
@@ -356,5 +424,37 @@ func SignTaprootKeySpend(internalKey btcec.PublicKey, virtualTx *wire.MsgTx,
 	if err != nil {
 		return nil, err
 	}
-	return &wire.TxWitness{sig.Serialize()}, nil
+
+	return &wire.TxWitness{SerializeSig(sig, sigHashType)}, nil
+}
+
+// SerializeSig serializes a Schnorr signature for use within an asset witness.
+// Following BIP 341, the sighash flag is only appended if it isn't
+// SIGHASH_DEFAULT.
+func SerializeSig(sig *schnorr.Signature,
+	sigHashType txscript.SigHashType) []byte {
+
+	rawSig := sig.Serialize()
+	if sigHashType != txscript.SigHashDefault {
+		rawSig = append(rawSig, byte(sigHashType))
+	}
+
+	return rawSig
+}
+
+// IsValidSigHashType returns true if the passed sighash flag may be explicitly
+// appended to a signature within an asset witness. As in BIP 341,
+// SIGHASH_DEFAULT is only valid when implied by a 64-byte signature.
+func IsValidSigHashType(sigHashType txscript.SigHashType) bool {
+	switch sigHashType {
+	case txscript.SigHashAll, txscript.SigHashNone, txscript.SigHashSingle,
+		txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+		txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+		txscript.SigHashSingle | txscript.SigHashAnyOneCanPay:
+
+		return true
+
+	default:
+		return false
+	}
 }
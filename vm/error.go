@@ -32,8 +32,10 @@ const (
 	ErrAmountMismatch
 
 	// ErrInvalidSigHashFlag represents an error case where an asset witness
-	// contains signatures created with any sighash flag other than
-	// SIGHASH_DEFAULT.
+	// contains a signature with an explicit sighash flag that isn't one of
+	// SIGHASH_ALL, SIGHASH_NONE or SIGHASH_SINGLE, optionally combined with
+	// SIGHASH_ANYONECANPAY, or where only some of its signatures use
+	// SIGHASH_ANYONECANPAY.
 	ErrInvalidSigHashFlag
 
 	// ErrInvalidGenesisStateTransition represents an error case where an
@@ -61,6 +63,11 @@ const (
 	// ErrInvalidRootAsset represents an error case where the root asset
 	// of an asset split has zero value but a spendable script key.
 	ErrInvalidRootAsset
+
+	// ErrInvalidFamilySig represents an error case where an asset claims
+	// membership in an asset family, but the family key signature over
+	// the asset's genesis is invalid.
+	ErrInvalidFamilySig
 )
 
 // Wrap select errors related to virtual TX handling to provide more
@@ -105,6 +112,8 @@ func (k ErrorKind) String() string {
 		return "invalid split commitment proof"
 	case ErrInvalidRootAsset:
 		return "invalid zero-value root asset"
+	case ErrInvalidFamilySig:
+		return "invalid family key signature over asset genesis"
 	default:
 		return "unknown"
 	}
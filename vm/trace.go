@@ -0,0 +1,120 @@
+package vm
+
+import (
+	"fmt"
+)
+
+// StepType identifies the kind of step recorded while executing a state
+// transition.
+type StepType uint8
+
+const (
+	// StepGenesisValidation is a step that validates a genesis asset,
+	// which must not have any inputs or splits.
+	StepGenesisValidation StepType = iota
+
+	// StepSplitValidation is a step that validates an asset split against
+	// the split commitment root of the new asset.
+	StepSplitValidation
+
+	// StepInflationCheck is a step that ensures the sum of the inputs
+	// matches the total amount of the new asset(s).
+	StepInflationCheck
+
+	// StepGenesisMatch is a step that ensures the new asset continues to
+	// hold the genesis (and type) of the input being spent.
+	StepGenesisMatch
+
+	// StepWitnessCheck is a step that performs the static checks on an
+	// input witness before it is executed, such as validating the sighash
+	// flags of any signatures.
+	StepWitnessCheck
+
+	// StepScriptOpcode is a step that executes a single opcode within the
+	// txscript engine used to validate an input witness.
+	StepScriptOpcode
+
+	// StepScriptResult is the final step of the txscript engine used to
+	// validate an input witness, which checks the final stack.
+	StepScriptResult
+)
+
+// String returns a human readable version of the step type.
+func (s StepType) String() string {
+	switch s {
+	case StepGenesisValidation:
+		return "genesis_validation"
+	case StepSplitValidation:
+		return "split_validation"
+	case StepInflationCheck:
+		return "inflation_check"
+	case StepGenesisMatch:
+		return "genesis_match"
+	case StepWitnessCheck:
+		return "witness_check"
+	case StepScriptOpcode:
+		return "script_opcode"
+	case StepScriptResult:
+		return "script_result"
+	default:
+		return fmt.Sprintf("<unknown step type %d>", s)
+	}
+}
+
+// Step describes a single step taken by the Engine while executing a state
+// transition.
+type Step struct {
+	// Type is the kind of step that was executed.
+	Type StepType
+
+	// InputIndex is the index of the input witness being validated. This
+	// is only set for the steps that relate to a specific input.
+	InputIndex uint32
+
+	// Description is a human readable description of the step.
+	Description string
+
+	// Opcode is the disassembled opcode that was executed. This is only
+	// set for StepScriptOpcode steps.
+	Opcode string
+
+	// Stack is the main stack of the txscript engine after the step was
+	// executed. This is only set for script steps.
+	Stack [][]byte
+
+	// AltStack is the alternative stack of the txscript engine after the
+	// step was executed. This is only set for script steps.
+	AltStack [][]byte
+
+	// Err is the error encountered during this step, if any.
+	Err error
+}
+
+// Tracer is an optional hook that's notified of each step the Engine takes
+// while executing a state transition.
+type Tracer interface {
+	// TraceStep is called after each step executed by the Engine.
+	TraceStep(step Step)
+}
+
+// StepRecorder is a Tracer that keeps all the steps in memory, in the order
+// they were executed.
+type StepRecorder struct {
+	// Steps is the list of steps recorded so far.
+	Steps []Step
+}
+
+// NewStepRecorder creates a new, empty step recorder.
+func NewStepRecorder() *StepRecorder {
+	return &StepRecorder{}
+}
+
+// TraceStep records the passed step.
+//
+// NOTE: This is part of the Tracer interface.
+func (s *StepRecorder) TraceStep(step Step) {
+	s.Steps = append(s.Steps, step)
+}
+
+// A compile time assertion to ensure StepRecorder meets the Tracer interface.
+var _ Tracer = (*StepRecorder)(nil)
@@ -3,6 +3,7 @@ package vm
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/txscript"
@@ -27,6 +28,10 @@ type Engine struct {
 	// prevAssets maps newAsset's inputs by the hash of their PrevID to
 	// their asset.
 	prevAssets commitment.InputSet
+
+	// tracer is an optional hook that's notified of each step taken
+	// while executing the state transition.
+	tracer Tracer
 }
 
 // New returns a new virtual machine capable of executing and verifying Taro
@@ -34,45 +39,82 @@ type Engine struct {
 func New(newAsset *asset.Asset, splitAsset *commitment.SplitAsset,
 	prevAssets commitment.InputSet) (*Engine, error) {
 
+	return NewWithTracer(newAsset, splitAsset, prevAssets, nil)
+}
+
+// NewWithTracer returns a new virtual machine capable of executing and
+// verifying Taro asset state transitions, which reports each step it takes to
+// the passed tracer.
+func NewWithTracer(newAsset *asset.Asset, splitAsset *commitment.SplitAsset,
+	prevAssets commitment.InputSet, tracer Tracer) (*Engine, error) {
+
 	return &Engine{
 		newAsset:   newAsset,
 		splitAsset: splitAsset,
 		prevAssets: prevAssets,
+		tracer:     tracer,
 	}, nil
 }
 
-// matchesPrevGenesis determines whether certain key parameters of the new
-// asset continue to hold its previous genesis.
-func matchesPrevGenesis(prevID asset.ID, familyKey *asset.FamilyKey,
-	tag string, prevAsset *asset.Asset) bool {
+// trace reports the passed step to the tracer, if one is set.
+func (vm *Engine) trace(step Step) {
+	if vm.tracer == nil {
+		return
+	}
+
+	vm.tracer.TraceStep(step)
+}
+
+// hasValidFamilySig returns true if the asset either doesn't belong to an asset
+// family, or if its family key carries a valid signature over its genesis.
+func hasValidFamilySig(a *asset.Asset) bool {
+	if a.FamilyKey == nil {
+		return true
+	}
 
+	return a.Genesis.VerifySignature(&a.FamilyKey.Sig, &a.FamilyKey.FamKey)
+}
+
+// matchesPrevGenesis determines whether the new asset continues to hold the
+// genesis of its previous asset. Assets with a distinct genesis may only be
+// merged if they belong to the same asset family and share the same tag. Both
+// of them need to prove their membership in the family with a valid family
+// key signature over their genesis.
+func matchesPrevGenesis(prevID asset.ID, newAsset,
+	prevAsset *asset.Asset) error {
+
+	// The input referenced by the witness must actually be the previous
+	// asset.
+	if prevID != prevAsset.Genesis.ID() {
+		return newErrKind(ErrIDMismatch)
+	}
+
+	familyKey := newAsset.FamilyKey
 	switch {
 	// Matched genesis ID, gg.
-	case prevID == prevAsset.Genesis.ID():
-		return true
+	case newAsset.Genesis.ID() == prevID:
+		return nil
 
 	// Mismatched ID and nil FamilyKey, ouch.
-	case familyKey == nil && prevAsset.FamilyKey == nil:
-		fallthrough
-	case familyKey == nil && prevAsset.FamilyKey != nil:
-		fallthrough
-	case familyKey != nil && prevAsset.FamilyKey == nil:
-		return false
-
-	// Mismatched ID and non-nil FamilyKey, there's hope!
-	case familyKey != nil && prevAsset.FamilyKey != nil:
-		// Mismatched ID and FamilyKey, sigh.
-		if !familyKey.IsEqual(prevAsset.FamilyKey) {
-			return false
-		}
+	case familyKey == nil || prevAsset.FamilyKey == nil:
+		return newErrKind(ErrIDMismatch)
 
-		// Matched ID and FamilyKey, there's still hope!
-		return tag == prevAsset.Genesis.Tag
+	// Mismatched ID and FamilyKey, sigh.
+	case !familyKey.IsSameFamily(prevAsset.FamilyKey):
+		return newErrKind(ErrIDMismatch)
+
+	// Matched FamilyKey, but mismatched tag, so the assets aren't
+	// fungible.
+	case newAsset.Genesis.Tag != prevAsset.Genesis.Tag:
+		return newErrKind(ErrIDMismatch)
+
+	// Mismatched ID but matching FamilyKey, there's still hope! Both
+	// assets need to prove that they were issued into the family.
+	case !hasValidFamilySig(newAsset) || !hasValidFamilySig(prevAsset):
+		return newErrKind(ErrInvalidFamilySig)
 
-	// How did we get here?
 	default:
-		// TODO(roasbeef): actually make into an error?
-		panic("unreachable")
+		return nil
 	}
 }
 
@@ -86,12 +128,11 @@ func matchesAssetParams(newAsset, prevAsset *asset.Asset,
 		return newErrKind(ErrScriptKeyMismatch)
 	}
 
-	if !matchesPrevGenesis(
-		prevAssetWitness.PrevID.ID, newAsset.FamilyKey,
-		newAsset.Genesis.Tag, prevAsset,
-	) {
-
-		return newErrKind(ErrIDMismatch)
+	err := matchesPrevGenesis(
+		prevAssetWitness.PrevID.ID, newAsset, prevAsset,
+	)
+	if err != nil {
+		return err
 	}
 
 	if newAsset.Type != prevAsset.Type {
@@ -199,33 +240,44 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 
 	// The parameters of the new and old asset much match exactly.
 	err := matchesAssetParams(vm.newAsset, prevAsset, witness)
+	vm.trace(Step{
+		Type:       StepGenesisMatch,
+		InputIndex: inputIdx,
+		Description: fmt.Sprintf("new asset matches genesis of input "+
+			"%x", witness.PrevID.ID[:]),
+		Err: err,
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, witnessItem := range witness.TxWitness {
-		// Signatures can either be 64, with SIGHASH_DEFAULT, or 65
-		// bytes otherwise.
-		//
-		// TODO(roasbeef): remove? will go thru normal sig parse
-		// checks, untested as is
-		// TODO: This is wrong
-		if len(witnessItem) == 65 {
-			_, err = schnorr.ParseSignature(witnessItem[1:])
-			if err != nil {
-				// Not a valid signature, so it must be some
-				// arbitrary data push.
-				continue
-			}
-			return newErrKind(ErrInvalidSigHashFlag)
-		}
+	anyoneCanPay, err := checkWitnessSigHashes(witness)
+	vm.trace(Step{
+		Type:        StepWitnessCheck,
+		InputIndex:  inputIdx,
+		Description: "witness signatures have valid sighash flags",
+		Err:         err,
+	})
+	if err != nil {
+		return err
 	}
 
 	// Update the virtual transaction input with details for the specific
-	// Taro input and proceed to validate its witness.
+	// Taro input and proceed to validate its witness. Signatures with the
+	// SIGHASH_ANYONECANPAY flag only commit to this input, so the other
+	// inputs are left out of the virtual transaction.
 	virtualTxCopy := taroscript.VirtualTxWithInput(
 		virtualTx, prevAsset, inputIdx, witness.TxWitness,
 	)
+	if anyoneCanPay {
+		virtualTxCopy, err = taroscript.VirtualTxWithAnyoneCanPayInput(
+			virtualTx, *witness.PrevID, prevAsset,
+			witness.TxWitness,
+		)
+		if err != nil {
+			return err
+		}
+	}
 
 	prevOutFetcher, err := taroscript.InputPrevOutFetcher(*prevAsset)
 	if err != nil {
@@ -252,13 +304,106 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	if err != nil {
 		return newErrInner(ErrInvalidTransferWitness, err)
 	}
-	if err := engine.Execute(); err != nil {
+	if err := vm.executeScript(engine, inputIdx); err != nil {
 		return newErrInner(ErrInvalidTransferWitness, err)
 	}
 
 	return nil
 }
 
+// checkWitnessSigHashes ensures that all signatures within the passed witness
+// that carry an explicit sighash flag use one that is supported. As the witness
+// is validated against a single virtual transaction, either all or none of
+// these signatures must have the SIGHASH_ANYONECANPAY flag, which is returned.
+func checkWitnessSigHashes(witness *asset.Witness) (bool, error) {
+	var numSigs, numAnyoneCanPay int
+	for _, witnessItem := range witness.TxWitness {
+		// Signatures can either be 64 bytes, with SIGHASH_DEFAULT, or
+		// 65 bytes with an explicit sighash flag appended.
+		if len(witnessItem) != schnorr.SignatureSize+1 {
+			continue
+		}
+
+		_, err := schnorr.ParseSignature(
+			witnessItem[:schnorr.SignatureSize],
+		)
+		if err != nil {
+			// Not a valid signature, so it must be some arbitrary
+			// data push.
+			continue
+		}
+
+		sigHashType := txscript.SigHashType(
+			witnessItem[schnorr.SignatureSize],
+		)
+		if !taroscript.IsValidSigHashType(sigHashType) {
+			return false, newErrKind(ErrInvalidSigHashFlag)
+		}
+
+		numSigs++
+		if sigHashType&txscript.SigHashAnyOneCanPay != 0 {
+			numAnyoneCanPay++
+		}
+	}
+
+	if numAnyoneCanPay != 0 && numAnyoneCanPay != numSigs {
+		return false, newErrKind(ErrInvalidSigHashFlag)
+	}
+
+	return numAnyoneCanPay != 0, nil
+}
+
+// executeScript executes the passed txscript engine. If a tracer is set, then
+// the engine is stepped through one opcode at a time so each step can be
+// reported along with the resulting stacks.
+func (vm *Engine) executeScript(engine *txscript.Engine,
+	inputIdx uint32) error {
+
+	if vm.tracer == nil {
+		return engine.Execute()
+	}
+
+	for done := false; !done; {
+		// The PC can't be disassembled for a key spend, as there's no
+		// script to execute, so we'll just leave the opcode blank.
+		opcode, _ := engine.DisasmPC()
+
+		var err error
+		done, err = engine.Step()
+		vm.trace(Step{
+			Type:        StepScriptOpcode,
+			InputIndex:  inputIdx,
+			Description: "executed opcode",
+			Opcode:      opcode,
+			Stack:       engine.GetStack(),
+			AltStack:    engine.GetAltStack(),
+			Err:         err,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// The final stack is checked even if all opcodes were executed
+	// successfully, so we'll describe why the script failed, if it did.
+	err := engine.CheckErrorCondition(true)
+	description := "script terminated with a true stack"
+	if err != nil {
+		description = fmt.Sprintf("script failed final stack check: %v",
+			err)
+	}
+	vm.trace(Step{
+		Type:        StepScriptResult,
+		InputIndex:  inputIdx,
+		Description: description,
+		Stack:       engine.GetStack(),
+		AltStack:    engine.GetAltStack(),
+		Err:         err,
+	})
+
+	return err
+}
+
 // validateStateTransition attempts to validate a normal state transition where
 // an asset (normal or collectible) is fully consumed without splits. This is
 // done by verifying each input has a valid witness generated over the virtual
@@ -303,17 +448,48 @@ func (vm *Engine) Execute() error {
 	// A genesis asset should have a single witness and a PrevID of all
 	// zeros and empty witness and split commitment proof.
 	if vm.newAsset.HasGenesisWitness() {
+		var err error
 		if vm.splitAsset != nil || len(vm.prevAssets) > 0 {
-			return newErrKind(ErrInvalidGenesisStateTransition)
+			err = newErrKind(ErrInvalidGenesisStateTransition)
 		}
-		return nil
+
+		vm.trace(Step{
+			Type:        StepGenesisValidation,
+			Description: "genesis asset has no inputs or splits",
+			Err:         err,
+		})
+		if err != nil {
+			return err
+		}
+
+		// If the newly created asset claims membership in an asset
+		// family, then its genesis must be signed by the family key.
+		if !hasValidFamilySig(vm.newAsset) {
+			err = newErrKind(ErrInvalidFamilySig)
+		}
+
+		vm.trace(Step{
+			Type:        StepGenesisValidation,
+			Description: "genesis asset has valid family key signature",
+			Err:         err,
+		})
+
+		return err
 	}
 
 	// If we have an asset split, then we need to validate the state
 	// transition by verifying the split commitment proof before verify the
 	// final asset witness.
 	if vm.splitAsset != nil {
-		if err := vm.validateSplit(); err != nil {
+		err := vm.validateSplit()
+		vm.trace(Step{
+			Type: StepSplitValidation,
+			Description: fmt.Sprintf("split asset at output %d "+
+				"committed to by split commitment root",
+				vm.splitAsset.OutputIndex),
+			Err: err,
+		})
+		if err != nil {
 			return err
 		}
 	}
@@ -339,8 +515,18 @@ func (vm *Engine) Execute() error {
 	if err != nil {
 		return err
 	}
+	var inflationErr error
 	if treeRoot.NodeSum() != uint64(virtualTx.TxOut[0].Value) {
-		return newErrKind(ErrAmountMismatch)
+		inflationErr = newErrKind(ErrAmountMismatch)
+	}
+	vm.trace(Step{
+		Type: StepInflationCheck,
+		Description: fmt.Sprintf("input sum %d, output sum %d",
+			treeRoot.NodeSum(), virtualTx.TxOut[0].Value),
+		Err: inflationErr,
+	})
+	if inflationErr != nil {
+		return inflationErr
 	}
 
 	// Finally, we'll validate the asset witness.
@@ -3,6 +3,7 @@ package vm
 import (
 	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -40,13 +41,56 @@ func randFamilyKey(t *testing.T, genesis asset.Genesis) *asset.FamilyKey {
 	genSigner := asset.NewRawKeyGenesisSigner(privKey)
 
 	familyKey, err := asset.DeriveFamilyKey(
-		genSigner, toKeyDesc(privKey.PubKey()), genesis,
+		genSigner, toKeyDesc(privKey.PubKey()), genesis, nil,
 	)
 	require.NoError(t, err)
 
 	return familyKey
 }
 
+// randFamilyAssets creates two random normal assets with a distinct genesis
+// that belong to the same asset family, with the second one being issued into
+// the family of the first one. If sameTag is true, then both assets share the
+// same tag.
+func randFamilyAssets(t *testing.T, scriptKey1, scriptKey2 btcec.PublicKey,
+	sameTag bool) (*asset.Asset, *asset.Asset) {
+
+	t.Helper()
+
+	privKey := randKey(t)
+	genSigner := asset.NewRawKeyGenesisSigner(privKey)
+	keyDesc := toKeyDesc(privKey.PubKey())
+
+	genesis1 := randGenesis(t, asset.Normal)
+	familyKey1, err := asset.DeriveFamilyKey(
+		genSigner, keyDesc, genesis1, nil,
+	)
+	require.NoError(t, err)
+
+	genesis2 := randGenesis(t, asset.Normal)
+	if !sameTag {
+		genesis2.Tag = genesis1.Tag + "-other"
+	}
+	familyKey2, err := asset.DeriveFamilyKey(
+		genSigner, keyDesc, genesis1, &genesis2,
+	)
+	require.NoError(t, err)
+
+	asset1, err := asset.New(
+		genesis1, uint64(rand.Uint32())+1, 0, 0,
+		asset.NewScriptKey(&scriptKey1), familyKey1,
+	)
+	require.NoError(t, err)
+
+	asset2, err := asset.New(
+		genesis2, uint64(rand.Uint32())+1, 0, 0,
+		asset.NewScriptKey(&scriptKey2), familyKey2,
+	)
+	require.NoError(t, err)
+
+	return asset1, asset2
+}
+
 func toKeyDesc(p *btcec.PublicKey) keychain.KeyDescriptor {
 	return keychain.KeyDescriptor{
 		PubKey: p,
@@ -82,29 +126,28 @@ func randAsset(t *testing.T, assetType asset.Type,
 }
 
 func genTaprootKeySpend(t *testing.T, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32) wire.TxWitness {
+	virtualTx *wire.MsgTx, prevID *asset.PrevID, input *asset.Asset,
+	idx uint32, sigHashType txscript.SigHashType) wire.TxWitness {
 
 	t.Helper()
 
-	virtualTxCopy := taroscript.VirtualTxWithInput(
-		virtualTx, input, idx, nil,
-	)
 	sigHash, err := taroscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx,
+		virtualTx, *prevID, input, idx, sigHashType,
 	)
 	require.NoError(t, err)
 
-	taprootPrivKey := txscript.TweakTaprootPrivKey(&privKey, nil)
+	taprootPrivKey := txscript.TweakTaprootPrivKey(privKey, nil)
 	sig, err := schnorr.Sign(taprootPrivKey, sigHash)
 	require.NoError(t, err)
 
-	return wire.TxWitness{sig.Serialize()}
+	return wire.TxWitness{taroscript.SerializeSig(sig, sigHashType)}
 }
 
 func genTaprootScriptSpend(t *testing.T, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32,
-	tapTree *txscript.IndexedTapScriptTree,
-	tapLeaf *txscript.TapLeaf) wire.TxWitness {
+	virtualTx *wire.MsgTx, prevID *asset.PrevID, input *asset.Asset,
+	idx uint32, tapTree *txscript.IndexedTapScriptTree,
+	tapLeaf *txscript.TapLeaf,
+	sigHashType txscript.SigHashType) wire.TxWitness {
 
 	t.Helper()
 
@@ -114,15 +157,17 @@ func genTaprootScriptSpend(t *testing.T, privKey btcec.PrivateKey,
 	controlBlockBytes, err := controlBlock.ToBytes()
 	require.NoError(t, err)
 
-	virtualTxCopy := taroscript.VirtualTxWithInput(virtualTx, input, idx, nil)
 	sigHash, err := taroscript.InputScriptSpendSigHash(
-		virtualTxCopy, input, idx, tapLeaf,
+		virtualTx, *prevID, input, idx, tapLeaf, sigHashType,
 	)
 	require.NoError(t, err)
 	sig, err := schnorr.Sign(&privKey, sigHash)
 	require.NoError(t, err)
 
-	return wire.TxWitness{sig.Serialize(), tapLeaf.Script, controlBlockBytes}
+	return wire.TxWitness{
+		taroscript.SerializeSig(sig, sigHashType), tapLeaf.Script,
+		controlBlockBytes,
+	}
 }
 
 type stateTransitionFunc = func(t *testing.T) (*asset.Asset,
@@ -153,6 +198,21 @@ func genesisStateTransition(t *testing.T, assetType asset.Type,
 	}
 }
 
+// invalidFamilySigGenesis returns a genesis state transition for an asset that
+// claims membership in a family with a signature over another genesis.
+func invalidFamilySigGenesis(t *testing.T) (*asset.Asset, commitment.SplitSet,
+	commitment.InputSet) {
+
+	scriptKey := randKey(t).PubKey()
+	a1, a2 := randFamilyAssets(t, *scriptKey, *scriptKey, true)
+
+	familyKey := *a1.FamilyKey
+	familyKey.Sig = a2.FamilyKey.Sig
+	a1.FamilyKey = &familyKey
+
+	return a1, nil, nil
+}
+
 func collectibleStateTransition(t *testing.T) (*asset.Asset,
 	commitment.SplitSet, commitment.InputSet) {
 
@@ -179,7 +239,8 @@ func collectibleStateTransition(t *testing.T) (*asset.Asset,
 	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *privKey, virtualTx, genesisAsset, 0,
+		t, *privKey, virtualTx, prevID, genesisAsset, 0,
+		txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 	newAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -188,73 +249,272 @@ func collectibleStateTransition(t *testing.T) (*asset.Asset,
 }
 
 // TODO(roasbeef): need to add 1:1 spend
-func normalStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
-	commitment.InputSet) {
+func normalStateTransition(
+	sigHashType txscript.SigHashType) stateTransitionFunc {
 
-	privKey1 := randKey(t)
-	scriptKey1 := txscript.ComputeTaprootKeyNoScript(privKey1.PubKey())
-
-	const csv = 6
-	privKey2 := randKey(t)
-	leafScript, err := txscript.NewScriptBuilder().
-		AddData(schnorr.SerializePubKey(privKey2.PubKey())).
-		AddOp(txscript.OP_CHECKSIGVERIFY).
-		AddInt64(csv).
-		AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
-		Script()
-	require.NoError(t, err)
-	tapLeaf := txscript.NewBaseTapLeaf(leafScript)
-	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
-	tapTreeRoot := tapTree.RootNode.TapHash()
-	scriptKey2 := txscript.ComputeTaprootOutputKey(
-		privKey2.PubKey(), tapTreeRoot[:],
-	)
+	return familyStateTransition(sigHashType, true, nil)
+}
 
-	genesisOutPoint := wire.OutPoint{}
-	genesisAsset1 := randAsset(t, asset.Normal, *scriptKey1)
-	genesisAsset2 := randAsset(t, asset.Normal, *scriptKey2)
-	genesisAsset2.RelativeLockTime = csv
+// familyStateTransition returns a normal state transition that merges two
+// assets of the same family, which only share the same tag if sameTag is true.
+// If non-nil, the passed closure is able to modify the second input before the
+// witnesses are created.
+func familyStateTransition(sigHashType txscript.SigHashType, sameTag bool,
+	modifyInput func(*testing.T, *asset.Asset,
+		*asset.Asset)) stateTransitionFunc {
 
-	prevID1 := &asset.PrevID{
-		OutPoint:  genesisOutPoint,
-		ID:        genesisAsset1.Genesis.ID(),
-		ScriptKey: asset.ToSerialized(genesisAsset1.ScriptKey.PubKey),
-	}
-	prevID2 := &asset.PrevID{
-		OutPoint:  genesisOutPoint,
-		ID:        genesisAsset2.Genesis.ID(),
-		ScriptKey: asset.ToSerialized(genesisAsset2.ScriptKey.PubKey),
+	return func(t *testing.T) (*asset.Asset, commitment.SplitSet,
+		commitment.InputSet) {
+
+		privKey1 := randKey(t)
+		scriptKey1 := txscript.ComputeTaprootKeyNoScript(
+			privKey1.PubKey(),
+		)
+
+		const csv = 6
+		privKey2 := randKey(t)
+		leafScript, err := txscript.NewScriptBuilder().
+			AddData(schnorr.SerializePubKey(privKey2.PubKey())).
+			AddOp(txscript.OP_CHECKSIGVERIFY).
+			AddInt64(csv).
+			AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+			Script()
+		require.NoError(t, err)
+		tapLeaf := txscript.NewBaseTapLeaf(leafScript)
+		tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+		tapTreeRoot := tapTree.RootNode.TapHash()
+		scriptKey2 := txscript.ComputeTaprootOutputKey(
+			privKey2.PubKey(), tapTreeRoot[:],
+		)
+
+		// The two inputs have a distinct genesis, but belong to the
+		// same asset family, so they can be merged if they share the
+		// same tag.
+		genesisOutPoint := wire.OutPoint{}
+		genesisAsset1, genesisAsset2 := randFamilyAssets(
+			t, *scriptKey1, *scriptKey2, sameTag,
+		)
+		genesisAsset2.RelativeLockTime = csv
+		if modifyInput != nil {
+			modifyInput(t, genesisAsset1, genesisAsset2)
+		}
+
+		prevID1 := &asset.PrevID{
+			OutPoint:  genesisOutPoint,
+			ID:        genesisAsset1.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(genesisAsset1.ScriptKey.PubKey),
+		}
+		prevID2 := &asset.PrevID{
+			OutPoint:  genesisOutPoint,
+			ID:        genesisAsset2.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(genesisAsset2.ScriptKey.PubKey),
+		}
+
+		newAsset := genesisAsset1.Copy()
+		newAsset.Amount = genesisAsset1.Amount + genesisAsset2.Amount
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID:          prevID1,
+			TxWitness:       nil,
+			SplitCommitment: nil,
+		}, {
+			PrevID:          prevID2,
+			TxWitness:       nil,
+			SplitCommitment: nil,
+		}}
+
+		inputs := commitment.InputSet{
+			*prevID1: genesisAsset1,
+			*prevID2: genesisAsset2,
+		}
+		virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+		newWitness := genTaprootKeySpend(
+			t, *privKey1, virtualTx, prevID1, genesisAsset1, 0,
+			sigHashType,
+		)
+		require.NoError(t, err)
+		newAsset.PrevWitnesses[0].TxWitness = newWitness
+		newAsset.PrevWitnesses[1].TxWitness = genTaprootScriptSpend(
+			t, *privKey2, virtualTx, prevID2, genesisAsset2, 1,
+			tapTree, &tapLeaf, sigHashType,
+		)
+
+		return newAsset, nil, inputs
 	}
+}
 
-	newAsset := genesisAsset1.Copy()
-	newAsset.Amount = genesisAsset1.Amount + genesisAsset2.Amount
-	newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
-	newAsset.PrevWitnesses = []asset.Witness{{
-		PrevID:          prevID1,
-		TxWitness:       nil,
-		SplitCommitment: nil,
-	}, {
-		PrevID:          prevID2,
-		TxWitness:       nil,
-		SplitCommitment: nil,
-	}}
+// invalidFamilyStateTransition returns a normal state transition that merges
+// two assets with a distinct genesis, where the second input either belongs to
+// another family or has an invalid signature for the family.
+func invalidFamilyStateTransition(forgeSig bool) stateTransitionFunc {
+	return familyStateTransition(
+		txscript.SigHashDefault, true,
+		func(t *testing.T, input1, input2 *asset.Asset) {
+			// Re-using the signature of the first input results
+			// in a signature that doesn't cover the genesis of the
+			// second input.
+			familyKey := *input2.FamilyKey
+			if forgeSig {
+				familyKey.Sig = input1.FamilyKey.Sig
+			} else {
+				familyKey = *randFamilyKey(t, input2.Genesis)
+			}
+			input2.FamilyKey = &familyKey
+		},
+	)
+}
 
-	inputs := commitment.InputSet{
-		*prevID1: genesisAsset1,
-		*prevID2: genesisAsset2,
+// invalidSigHashStateTransition returns a collectible state transition where
+// the witness signature has the given, invalid, sighash flag appended.
+func invalidSigHashStateTransition(sigHashFlag byte) stateTransitionFunc {
+	return func(t *testing.T) (*asset.Asset, commitment.SplitSet,
+		commitment.InputSet) {
+
+		newAsset, splitSet, inputs := collectibleStateTransition(t)
+
+		sig := newAsset.PrevWitnesses[0].TxWitness[0]
+		newAsset.PrevWitnesses[0].TxWitness[0] = append(
+			sig, sigHashFlag,
+		)
+
+		return newAsset, splitSet, inputs
 	}
-	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
-	require.NoError(t, err)
-	newWitness := genTaprootKeySpend(
-		t, *privKey1, virtualTx, genesisAsset1, 0,
+}
+
+// mixedSigHashStateTransition returns a normal state transition where the
+// witness of the second input contains both a signature with and without the
+// SIGHASH_ANYONECANPAY flag.
+func mixedSigHashStateTransition(t *testing.T) (*asset.Asset,
+	commitment.SplitSet, commitment.InputSet) {
+
+	newAsset, splitSet, inputs := normalStateTransition(
+		txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+	)(t)
+
+	sig := newAsset.PrevWitnesses[0].TxWitness[0]
+	mixedSig := append(
+		sig[:schnorr.SignatureSize:schnorr.SignatureSize],
+		byte(txscript.SigHashAll),
 	)
-	require.NoError(t, err)
-	newAsset.PrevWitnesses[0].TxWitness = newWitness
-	newAsset.PrevWitnesses[1].TxWitness = genTaprootScriptSpend(
-		t, *privKey2, virtualTx, genesisAsset2, 1, tapTree, &tapLeaf,
+	witness := newAsset.PrevWitnesses[1].TxWitness
+	newAsset.PrevWitnesses[1].TxWitness = append(
+		wire.TxWitness{mixedSig}, witness...,
 	)
 
-	return newAsset, nil, inputs
+	return newAsset, splitSet, inputs
+}
+
+// sigHashInputAddedStateTransition returns a normal state transition where the
+// first input is signed with the given sighash flag before a second input is
+// added in front of it.
+func sigHashInputAddedStateTransition(
+	sigHashType txscript.SigHashType) stateTransitionFunc {
+
+	return func(t *testing.T) (*asset.Asset, commitment.SplitSet,
+		commitment.InputSet) {
+
+		privKey1 := randKey(t)
+		scriptKey1 := txscript.ComputeTaprootKeyNoScript(
+			privKey1.PubKey(),
+		)
+		privKey2 := randKey(t)
+		scriptKey2 := txscript.ComputeTaprootKeyNoScript(
+			privKey2.PubKey(),
+		)
+
+		genesisOutPoint := wire.OutPoint{}
+		genesisAsset1, genesisAsset2 := randFamilyAssets(
+			t, *scriptKey1, *scriptKey2, true,
+		)
+		prevID1 := &asset.PrevID{
+			OutPoint:  genesisOutPoint,
+			ID:        genesisAsset1.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(genesisAsset1.ScriptKey.PubKey),
+		}
+		prevID2 := &asset.PrevID{
+			OutPoint:  genesisOutPoint,
+			ID:        genesisAsset2.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(genesisAsset2.ScriptKey.PubKey),
+		}
+
+		// We first sign a transfer that only spends the first input.
+		newAsset := genesisAsset1.Copy()
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: prevID1,
+		}}
+
+		inputs := commitment.InputSet{
+			*prevID1: genesisAsset1,
+		}
+		virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+		witness1 := genTaprootKeySpend(
+			t, *privKey1, virtualTx, prevID1, genesisAsset1, 0,
+			sigHashType,
+		)
+
+		// Adding the second input in front of the first one changes
+		// both the input MS-SMT and the index of the first input.
+		newAsset.Amount += genesisAsset2.Amount
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: prevID2,
+		}, {
+			PrevID:    prevID1,
+			TxWitness: witness1,
+		}}
+
+		inputs[*prevID2] = genesisAsset2
+		virtualTx, _, err = taroscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+		newAsset.PrevWitnesses[0].TxWitness = genTaprootKeySpend(
+			t, *privKey2, virtualTx, prevID2, genesisAsset2, 0,
+			txscript.SigHashDefault,
+		)
+
+		return newAsset, nil, inputs
+	}
+}
+
+// sigHashOutputChangedStateTransition returns a collectible state transition
+// where the script key of the new asset is changed after the input is signed
+// with the given sighash flag.
+func sigHashOutputChangedStateTransition(
+	sigHashType txscript.SigHashType) stateTransitionFunc {
+
+	return func(t *testing.T) (*asset.Asset, commitment.SplitSet,
+		commitment.InputSet) {
+
+		privKey := randKey(t)
+		scriptKey := txscript.ComputeTaprootKeyNoScript(
+			privKey.PubKey(),
+		)
+
+		genesisAsset := randAsset(t, asset.Collectible, *scriptKey)
+		prevID := &asset.PrevID{
+			OutPoint:  wire.OutPoint{},
+			ID:        genesisAsset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(genesisAsset.ScriptKey.PubKey),
+		}
+		newAsset := genesisAsset.Copy()
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: prevID,
+		}}
+
+		inputs := commitment.InputSet{*prevID: genesisAsset}
+		virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+		newAsset.PrevWitnesses[0].TxWitness = genTaprootKeySpend(
+			t, *privKey, virtualTx, prevID, genesisAsset, 0,
+			sigHashType,
+		)
+
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+
+		return newAsset, nil, inputs
+	}
 }
 
 func splitStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
@@ -296,7 +556,9 @@ func splitStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
 	)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *privKey, virtualTx, genesisAsset, 0,
+		t, *privKey, virtualTx,
+		splitCommitment.RootAsset.PrevWitnesses[0].PrevID,
+		genesisAsset, 0, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 	splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -352,7 +614,9 @@ func splitFullValueStateTransition(t *testing.T, validRootLocator,
 		)
 		require.NoError(t, err)
 		newWitness := genTaprootKeySpend(
-			t, *privKey, virtualTx, genesisAsset, 0,
+			t, *privKey, virtualTx,
+			splitCommitment.RootAsset.PrevWitnesses[0].PrevID,
+			genesisAsset, 0, txscript.SigHashDefault,
 		)
 		require.NoError(t, err)
 		splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -398,7 +662,9 @@ func splitCollectibleStateTransition(t *testing.T,
 		)
 		require.NoError(t, err)
 		newWitness := genTaprootKeySpend(
-			t, *privKey, virtualTx, genesisAsset, 0,
+			t, *privKey, virtualTx,
+			splitCommitment.RootAsset.PrevWitnesses[0].PrevID,
+			genesisAsset, 0, txscript.SigHashDefault,
 		)
 		require.NoError(t, err)
 		splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -445,6 +711,11 @@ func TestVM(t *testing.T) {
 			f:    genesisStateTransition(t, asset.Normal, false),
 			err:  newErrKind(ErrInvalidGenesisStateTransition),
 		},
+		{
+			name: "invalid family sig genesis",
+			f:    invalidFamilySigGenesis,
+			err:  newErrKind(ErrInvalidFamilySig),
+		},
 		{
 			name: "collectible state transition",
 			f:    collectibleStateTransition,
@@ -452,9 +723,81 @@ func TestVM(t *testing.T) {
 		},
 		{
 			name: "normal state transition",
-			f:    normalStateTransition,
+			f:    normalStateTransition(txscript.SigHashDefault),
 			err:  nil,
 		},
+		{
+			name: "invalid normal state transition other family",
+			f:    invalidFamilyStateTransition(false),
+			err:  newErrKind(ErrIDMismatch),
+		},
+		{
+			name: "invalid normal state transition family sig",
+			f:    invalidFamilyStateTransition(true),
+			err:  newErrKind(ErrInvalidFamilySig),
+		},
+		{
+			name: "invalid normal state transition family tag",
+			f: familyStateTransition(
+				txscript.SigHashDefault, false, nil,
+			),
+			err: newErrKind(ErrIDMismatch),
+		},
+		{
+			name: "normal state transition sighash all",
+			f:    normalStateTransition(txscript.SigHashAll),
+			err:  nil,
+		},
+		{
+			name: "normal state transition sighash none",
+			f:    normalStateTransition(txscript.SigHashNone),
+			err:  nil,
+		},
+		{
+			name: "normal state transition sighash single",
+			f:    normalStateTransition(txscript.SigHashSingle),
+			err:  nil,
+		},
+		{
+			name: "normal state transition sighash all anyonecanpay",
+			f: normalStateTransition(
+				txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+			),
+			err: nil,
+		},
+		{
+			name: "normal state transition sighash none anyonecanpay",
+			f: normalStateTransition(
+				txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+			),
+			err: nil,
+		},
+		{
+			name: "normal state transition sighash single " +
+				"anyonecanpay",
+			f: normalStateTransition(
+				txscript.SigHashSingle |
+					txscript.SigHashAnyOneCanPay,
+			),
+			err: nil,
+		},
+		{
+			name: "invalid explicit sighash default",
+			f: invalidSigHashStateTransition(
+				byte(txscript.SigHashDefault),
+			),
+			err: newErrKind(ErrInvalidSigHashFlag),
+		},
+		{
+			name: "invalid unknown sighash flag",
+			f:    invalidSigHashStateTransition(0x04),
+			err:  newErrKind(ErrInvalidSigHashFlag),
+		},
+		{
+			name: "invalid mixed anyonecanpay sighash flags",
+			f:    mixedSigHashStateTransition,
+			err:  newErrKind(ErrInvalidSigHashFlag),
+		},
 		{
 			name: "split state transition",
 			f:    splitStateTransition,
@@ -524,3 +867,165 @@ func TestVM(t *testing.T) {
 		}
 	}
 }
+
+// TestVMSigHashModifiedTx tests that a witness signature only commits to the
+// parts of the virtual transaction that are covered by its sighash flag.
+func TestVMSigHashModifiedTx(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		f     stateTransitionFunc
+		valid bool
+	}{
+		{
+			name: "input added sighash none anyonecanpay",
+			f: sigHashInputAddedStateTransition(
+				txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+			),
+			valid: true,
+		},
+		{
+			name: "input added sighash all anyonecanpay",
+			f: sigHashInputAddedStateTransition(
+				txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+			),
+			valid: false,
+		},
+		{
+			name: "input added sighash none",
+			f: sigHashInputAddedStateTransition(
+				txscript.SigHashNone,
+			),
+			valid: false,
+		},
+		{
+			name: "output changed sighash none",
+			f: sigHashOutputChangedStateTransition(
+				txscript.SigHashNone,
+			),
+			valid: true,
+		},
+		{
+			name: "output changed sighash none anyonecanpay",
+			f: sigHashOutputChangedStateTransition(
+				txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+			),
+			valid: true,
+		},
+		{
+			name: "output changed sighash all",
+			f: sigHashOutputChangedStateTransition(
+				txscript.SigHashAll,
+			),
+			valid: false,
+		},
+		{
+			name: "output changed sighash single",
+			f: sigHashOutputChangedStateTransition(
+				txscript.SigHashSingle,
+			),
+			valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			newAsset, _, inputSet := testCase.f(t)
+
+			engine, err := New(newAsset, nil, inputSet)
+			require.NoError(t, err)
+
+			err = engine.Execute()
+			if testCase.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			var vmErr Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(t, ErrInvalidTransferWitness, vmErr.Kind)
+		})
+	}
+}
+
+// TestVMTracer tests that the tracer is notified of each step taken by the VM,
+// including the individual opcodes executed for a script path spend.
+func TestVMTracer(t *testing.T) {
+	t.Parallel()
+
+	newAsset, _, inputSet := normalStateTransition(
+		txscript.SigHashDefault,
+	)(t)
+
+	recorder := NewStepRecorder()
+	engine, err := NewWithTracer(newAsset, nil, inputSet, recorder)
+	require.NoError(t, err)
+	require.NoError(t, engine.Execute())
+
+	stepsByType := make(map[StepType][]Step)
+	for _, step := range recorder.Steps {
+		require.NoError(t, step.Err)
+		stepsByType[step.Type] = append(stepsByType[step.Type], step)
+	}
+
+	// We expect one inflation check, then the genesis match, witness
+	// check and final script result for each of the two inputs.
+	require.Len(t, stepsByType[StepInflationCheck], 1)
+	require.Len(t, stepsByType[StepGenesisMatch], 2)
+	require.Len(t, stepsByType[StepWitnessCheck], 2)
+	require.Len(t, stepsByType[StepScriptResult], 2)
+
+	// The second input is a script path spend, so we should've recorded
+	// the opcodes of the leaf script being executed.
+	var foundCheckSig bool
+	for _, step := range stepsByType[StepScriptOpcode] {
+		if step.InputIndex == 1 &&
+			strings.Contains(step.Opcode, "OP_CHECKSIGVERIFY") {
+
+			foundCheckSig = true
+		}
+	}
+	require.True(t, foundCheckSig)
+
+	// Invalidating the witness of the second input should result in a
+	// failed step being recorded for that input.
+	newAsset.PrevWitnesses[1].TxWitness[0][0] ^= 1
+
+	recorder = NewStepRecorder()
+	engine, err = NewWithTracer(newAsset, nil, inputSet, recorder)
+	require.NoError(t, err)
+	require.Error(t, engine.Execute())
+
+	lastStep := recorder.Steps[len(recorder.Steps)-1]
+	require.Error(t, lastStep.Err)
+	require.Equal(t, uint32(1), lastStep.InputIndex)
+}
+
+// TestVMTracerScriptResult tests that a script that executes all of its
+// opcodes but terminates with a false stack is traced as a failed final
+// step, along with a description of the failure.
+func TestVMTracerScriptResult(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{})
+
+	pkScript := []byte{txscript.OP_FALSE}
+	scriptEngine, err := txscript.NewEngine(
+		pkScript, tx, 0, 0, nil, nil, 0,
+		txscript.NewCannedPrevOutputFetcher(pkScript, 0),
+	)
+	require.NoError(t, err)
+
+	recorder := NewStepRecorder()
+	vm := &Engine{tracer: recorder}
+	require.Error(t, vm.executeScript(scriptEngine, 0))
+
+	lastStep := recorder.Steps[len(recorder.Steps)-1]
+	require.Equal(t, StepScriptResult, lastStep.Type)
+	require.Error(t, lastStep.Err)
+	require.Contains(t, lastStep.Description, lastStep.Err.Error())
+}
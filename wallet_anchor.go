@@ -3,6 +3,7 @@ package taro
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/tarofreighter"
 	"github.com/lightninglabs/taro/tarogarden"
@@ -20,6 +22,15 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+var (
+	// lndInternalLockID is the ID lnd uses to lock the wallet inputs of
+	// the PSBTs it funds. It's the SHA256 hash of the string
+	// "lnd-internal-lock-id".
+	lndInternalLockID = wtxmgr.LockID(
+		sha256.Sum256([]byte("lnd-internal-lock-id")),
+	)
+)
+
 // LndRpcWalletAnchor is an implementation of the tarogarden.WalletAnchor
 // interfaced backed by an active remote lnd node.
 type LndRpcWalletAnchor struct {
@@ -123,9 +134,14 @@ func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	return addr, nil
 }
 
-// UnlockInput unlocks the set of target inputs after a batch is abandoned.
-func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context) error {
-	return nil
+// UnlockInput unlocks a wallet input that was locked when funding a PSBT,
+// after the PSBT is abandoned.
+func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context,
+	op wire.OutPoint) error {
+
+	return l.lnd.WalletKit.ReleaseOutput(
+		ctx, lndInternalLockID, op,
+	)
 }
 
 // ListUnspentImportScripts lists all UTXOs of the imported Taproot scripts.